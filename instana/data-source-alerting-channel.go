@@ -33,15 +33,18 @@ func (ds *alertingChannelDataSource) CreateResource() *schema.Resource {
 
 func (ds *alertingChannelDataSource) convertResourceSchema() map[string]*schema.Schema {
 	resourceSchema := NewAlertingChannelResourceHandle().MetaData().Schema
+	//the verification and the secret version are only relevant when the alerting channel is applied
+	delete(resourceSchema, AlertingChannelFieldVerifyOnApply)
+	delete(resourceSchema, AlertingChannelFieldSecretVersion)
 
-	return ds.convertSchemaMap(resourceSchema)
+	return ds.convertSchemaMap(resourceSchema, true)
 }
 
-func (ds *alertingChannelDataSource) convertSchemaMap(schemaMap map[string]*schema.Schema) map[string]*schema.Schema {
+func (ds *alertingChannelDataSource) convertSchemaMap(schemaMap map[string]*schema.Schema, topLevel bool) map[string]*schema.Schema {
 	result := make(map[string]*schema.Schema)
 
 	for k, v := range schemaMap {
-		if k == AlertingChannelFieldName {
+		if topLevel && k == AlertingChannelFieldName {
 			//for the key we assume a simple type. Here we copy the schema including all configuration and make sure
 			//the field is required
 			s := *v
@@ -55,6 +58,7 @@ func (ds *alertingChannelDataSource) convertSchemaMap(schemaMap map[string]*sche
 			s := &schema.Schema{}
 			s.Description = v.Description
 			s.Deprecated = v.Deprecated
+			s.Sensitive = v.Sensitive
 			s.Type = v.Type
 			s.Required = false
 			s.Optional = false
@@ -63,7 +67,7 @@ func (ds *alertingChannelDataSource) convertSchemaMap(schemaMap map[string]*sche
 			if v.Type == schema.TypeList || v.Type == schema.TypeSet || v.Type == schema.TypeMap {
 				if reflect.TypeOf(v.Elem) == reflect.TypeOf(&schema.Resource{}) {
 					nestedSchema := v.Elem.(*schema.Resource).Schema
-					convertedNestedSchema := ds.convertSchemaMap(nestedSchema)
+					convertedNestedSchema := ds.convertSchemaMap(nestedSchema, false)
 					s.Elem = &schema.Resource{
 						Schema: convertedNestedSchema,
 					}
@@ -134,6 +138,9 @@ func (ds *alertingChannelDataSource) mapChannelToState(channel *restapi.Alerting
 	if channel.Kind == restapi.PagerDutyChannelType {
 		return ds.mapPagerDutyChannelToState(channel), nil
 	}
+	if channel.Kind == restapi.ServiceNowChannelType {
+		return ds.mapServiceNowChannelToState(channel), nil
+	}
 	if channel.Kind == restapi.SlackChannelType {
 		return ds.mapSlackChannelToState(channel), nil
 	}
@@ -152,6 +159,21 @@ func (ds *alertingChannelDataSource) mapChannelToState(channel *restapi.Alerting
 	if channel.Kind == restapi.GoogleChatChannelType {
 		return ds.mapGoogleChatChannelToState(channel), nil
 	}
+	if channel.Kind == restapi.PrometheusWebhookChannelType {
+		return ds.mapPrometheusWebhookChannelToState(channel), nil
+	}
+	if channel.Kind == restapi.WebexTeamsWebhookChannelType {
+		return ds.mapWebexTeamsWebhookChannelToState(channel), nil
+	}
+	if channel.Kind == restapi.WatsonAIOpsWebhookChannelType {
+		return ds.mapWatsonAIOpsWebhookChannelToState(channel), nil
+	}
+	if channel.Kind == restapi.SlackAppChannelType {
+		return ds.mapSlackAppChannelToState(channel), nil
+	}
+	if channel.Kind == restapi.MsTeamsAppChannelType {
+		return ds.mapMsTeamsAppChannelToState(channel), nil
+	}
 	return nil, fmt.Errorf("received unsupported alerting channel of type %s", channel.Kind)
 }
 
@@ -200,6 +222,19 @@ func (ds *alertingChannelDataSource) mapPagerDutyChannelToState(channel *restapi
 	}
 }
 
+func (ds *alertingChannelDataSource) mapServiceNowChannelToState(channel *restapi.AlertingChannel) map[string]interface{} {
+	return map[string]interface{}{
+		AlertingChannelFieldName: channel.Name,
+		AlertingChannelFieldChannelServiceNow: []interface{}{
+			map[string]interface{}{
+				AlertingChannelServiceNowFieldServiceNowURL: channel.ServiceNowURL,
+				AlertingChannelServiceNowFieldUsername:      channel.Username,
+				AlertingChannelServiceNowFieldPassword:      channel.Password,
+			},
+		},
+	}
+}
+
 func (ds *alertingChannelDataSource) mapSlackChannelToState(channel *restapi.AlertingChannel) map[string]interface{} {
 	return map[string]interface{}{
 		AlertingChannelFieldName: channel.Name,
@@ -238,7 +273,7 @@ func (ds *alertingChannelDataSource) mapVictorOpsChannelToState(channel *restapi
 }
 
 func (ds *alertingChannelDataSource) mapWebhookChannelToState(channel *restapi.AlertingChannel) map[string]interface{} {
-	headers := ds.createHTTPHeaderMapFromList(channel.Headers)
+	headers := ds.createHTTPHeaderStateFromList(channel.Headers)
 	return map[string]interface{}{
 		AlertingChannelFieldName: channel.Name,
 		AlertingChannelFieldChannelWebhook: []interface{}{
@@ -250,14 +285,19 @@ func (ds *alertingChannelDataSource) mapWebhookChannelToState(channel *restapi.A
 	}
 }
 
-func (ds *alertingChannelDataSource) createHTTPHeaderMapFromList(headers []string) map[string]interface{} {
-	result := make(map[string]interface{})
-	for _, header := range headers {
-		keyValue := strings.Split(header, ":")
-		if len(keyValue) == 2 {
-			result[strings.TrimSpace(keyValue[0])] = strings.TrimSpace(keyValue[1])
-		} else {
-			result[strings.TrimSpace(keyValue[0])] = ""
+func (ds *alertingChannelDataSource) createHTTPHeaderStateFromList(headers []string) []interface{} {
+	result := make([]interface{}, len(headers))
+	for i, header := range headers {
+		//only the first colon separates the name from the value; the value itself may contain colons
+		nameValue := strings.SplitN(header, ":", 2)
+		value := ""
+		if len(nameValue) == 2 {
+			value = strings.TrimSpace(nameValue[1])
+		}
+		result[i] = map[string]interface{}{
+			AlertingChannelHTTPHeaderFieldName:      strings.TrimSpace(nameValue[0]),
+			AlertingChannelHTTPHeaderFieldValue:     value,
+			AlertingChannelHTTPHeaderFieldSensitive: false,
 		}
 	}
 	return result
@@ -284,3 +324,74 @@ func (ds *alertingChannelDataSource) mapGoogleChatChannelToState(channel *restap
 		},
 	}
 }
+
+func (ds *alertingChannelDataSource) mapPrometheusWebhookChannelToState(channel *restapi.AlertingChannel) map[string]interface{} {
+	return map[string]interface{}{
+		AlertingChannelFieldName: channel.Name,
+		AlertingChannelFieldChannelPrometheusWebhook: []interface{}{
+			map[string]interface{}{
+				AlertingChannelWebhookBasedFieldWebhookURL:    channel.WebhookURL,
+				AlertingChannelPrometheusWebhookFieldReceiver: channel.Receiver,
+			},
+		},
+	}
+}
+
+func (ds *alertingChannelDataSource) mapWebexTeamsWebhookChannelToState(channel *restapi.AlertingChannel) map[string]interface{} {
+	return map[string]interface{}{
+		AlertingChannelFieldName: channel.Name,
+		AlertingChannelFieldChannelWebexTeamsWebhook: []interface{}{
+			map[string]interface{}{
+				AlertingChannelWebhookBasedFieldWebhookURL: channel.WebhookURL,
+			},
+		},
+	}
+}
+
+func (ds *alertingChannelDataSource) mapWatsonAIOpsWebhookChannelToState(channel *restapi.AlertingChannel) map[string]interface{} {
+	headers := ds.createHTTPHeaderStateFromList(channel.Headers)
+	return map[string]interface{}{
+		AlertingChannelFieldName: channel.Name,
+		AlertingChannelFieldChannelWatsonAIOpsWebhook: []interface{}{
+			map[string]interface{}{
+				AlertingChannelWebhookBasedFieldWebhookURL: channel.WebhookURL,
+				AlertingChannelWebhookFieldHTTPHeaders:     headers,
+			},
+		},
+	}
+}
+
+func (ds *alertingChannelDataSource) mapSlackAppChannelToState(channel *restapi.AlertingChannel) map[string]interface{} {
+	return map[string]interface{}{
+		AlertingChannelFieldName: channel.Name,
+		AlertingChannelFieldChannelSlackApp: []interface{}{
+			map[string]interface{}{
+				AlertingChannelSlackAppFieldAppID:          channel.AppID,
+				AlertingChannelAppFieldTeamID:              channel.TeamID,
+				AlertingChannelAppFieldTeamName:            channel.TeamName,
+				AlertingChannelAppFieldChannelID:           channel.ChannelID,
+				AlertingChannelAppFieldChannelName:         channel.ChannelName,
+				AlertingChannelSlackAppFieldEmojiRendering: channel.EmojiRendering != nil && *channel.EmojiRendering,
+			},
+		},
+	}
+}
+
+func (ds *alertingChannelDataSource) mapMsTeamsAppChannelToState(channel *restapi.AlertingChannel) map[string]interface{} {
+	return map[string]interface{}{
+		AlertingChannelFieldName: channel.Name,
+		AlertingChannelFieldChannelMsTeamsApp: []interface{}{
+			map[string]interface{}{
+				AlertingChannelMsTeamsAppFieldAPITokenID: channel.APITokenID,
+				AlertingChannelAppFieldTeamID:            channel.TeamID,
+				AlertingChannelAppFieldTeamName:          channel.TeamName,
+				AlertingChannelAppFieldChannelID:         channel.ChannelID,
+				AlertingChannelAppFieldChannelName:       channel.ChannelName,
+				AlertingChannelMsTeamsAppFieldTenantID:   channel.TenantID,
+				AlertingChannelMsTeamsAppFieldTenantName: channel.TenantName,
+				AlertingChannelMsTeamsAppFieldServiceURL: channel.ServiceURL,
+				AlertingChannelMsTeamsAppFieldInstanaURL: channel.InstanaURL,
+			},
+		},
+	}
+}
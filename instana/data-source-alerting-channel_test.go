@@ -19,14 +19,20 @@ func TestAlertingChannelDataSource(t *testing.T) {
 	t.Run("integration test read of email alerting channel", alertingChannelEmailDataSourceIntegrationTest().testRead)
 	t.Run("integration test read of ops genie alerting channel", alertingChannelOpsGenieDataSourceIntegrationTest().testRead)
 	t.Run("integration test read of pager duty alerting channel", alertingChannelPagerDutyDataSourceIntegrationTest().testRead)
+	t.Run("integration test read of service now alerting channel", alertingChannelServiceNowDataSourceIntegrationTest().testRead)
 	t.Run("integration test read of slack 365 alerting channel", alertingChannelSlackDataSourceIntegrationTest().testRead)
 	t.Run("integration test read of splunk alerting channel", alertingChannelSplunkDataSourceIntegrationTest().testRead)
 	t.Run("integration test read of victor ops alerting channel", alertingChannelVictorOpsDataSourceIntegrationTest().testRead)
 	t.Run("integration test read of webhook alerting channel", alertingChannelWebhookDataSourceIntegrationTest().testRead)
 	t.Run("integration test read of office 365 alerting channel", alertingChannelOffice365DataSourceIntegrationTest().testRead)
 	t.Run("integration test read of google chat alerting channel", alertingChannelGoogleChatDataSourceIntegrationTest().testRead)
+	t.Run("integration test read of prometheus webhook alerting channel", alertingChannelPrometheusWebhookDataSourceIntegrationTest().testRead)
+	t.Run("integration test read of webex teams webhook alerting channel", alertingChannelWebexTeamsWebhookDataSourceIntegrationTest().testRead)
+	t.Run("integration test read of watson aiops webhook alerting channel", alertingChannelWatsonAIOpsWebhookDataSourceIntegrationTest().testRead)
+	t.Run("integration test read of slack app alerting channel", alertingChannelSlackAppDataSourceIntegrationTest().testRead)
+	t.Run("integration test read of ms teams app alerting channel", alertingChannelMsTeamsAppDataSourceIntegrationTest().testRead)
 	t.Run("schema should be valid", unitTest.schemaShouldBeValid)
-	t.Run("schema version should be 0", unitTest.shouldHaveSchemaVersion0)
+	t.Run("schema version should be 1", unitTest.shouldHaveSchemaVersion1)
 	t.Run("should successfully read channel", unitTest.shouldSuccessfullyReadChannel)
 	t.Run("should fail to read channel when api call fails", unitTest.shouldFailToReadChannelWhenApiCallFails)
 	t.Run("should fail to read channel when no channel is found for the given name", unitTest.shouldFailToReadChannelWhenNoChannelIsFoundForTheGivenName)
@@ -70,6 +76,18 @@ func alertingChannelPagerDutyDataSourceIntegrationTest() *dataSourceAlertingChan
 	)
 }
 
+func alertingChannelServiceNowDataSourceIntegrationTest() *dataSourceAlertingChannelIntegrationTest {
+	return newDataSourceAlertingChannelIntegrationTest(
+		"666670",
+		"my-service-now-channel",
+		[]resource.TestCheckFunc{
+			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelServiceNow, AlertingChannelServiceNowFieldServiceNowURL), "service-now-url"),
+			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelServiceNow, AlertingChannelServiceNowFieldUsername), "username"),
+			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelServiceNow, AlertingChannelServiceNowFieldPassword), "password"),
+		},
+	)
+}
+
 func alertingChannelSlackDataSourceIntegrationTest() *dataSourceAlertingChannelIntegrationTest {
 	return newDataSourceAlertingChannelIntegrationTest(
 		"666664",
@@ -111,9 +129,14 @@ func alertingChannelWebhookDataSourceIntegrationTest() *dataSourceAlertingChanne
 		[]resource.TestCheckFunc{
 			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf("%s.%d", fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelWebhook, AlertingChannelWebhookFieldWebhookURLs), 0), "url1"),
 			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf("%s.%d", fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelWebhook, AlertingChannelWebhookFieldWebhookURLs), 1), "url2"),
-			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf("%s.%s", fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelWebhook, AlertingChannelWebhookFieldHTTPHeaders), "key1"), "value1"),
-			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf("%s.%s", fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelWebhook, AlertingChannelWebhookFieldHTTPHeaders), "key2"), "value2"),
-			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf("%s.%s", fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelWebhook, AlertingChannelWebhookFieldHTTPHeaders), "key3"), ""),
+			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf("%s.%d.%s", fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelWebhook, AlertingChannelWebhookFieldHTTPHeaders), 0, AlertingChannelHTTPHeaderFieldName), "key1"),
+			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf("%s.%d.%s", fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelWebhook, AlertingChannelWebhookFieldHTTPHeaders), 0, AlertingChannelHTTPHeaderFieldValue), "value1"),
+			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf("%s.%d.%s", fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelWebhook, AlertingChannelWebhookFieldHTTPHeaders), 1, AlertingChannelHTTPHeaderFieldName), "key2"),
+			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf("%s.%d.%s", fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelWebhook, AlertingChannelWebhookFieldHTTPHeaders), 1, AlertingChannelHTTPHeaderFieldValue), "value2"),
+			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf("%s.%d.%s", fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelWebhook, AlertingChannelWebhookFieldHTTPHeaders), 2, AlertingChannelHTTPHeaderFieldName), "key3"),
+			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf("%s.%d.%s", fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelWebhook, AlertingChannelWebhookFieldHTTPHeaders), 2, AlertingChannelHTTPHeaderFieldValue), ""),
+			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf("%s.%d.%s", fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelWebhook, AlertingChannelWebhookFieldHTTPHeaders), 3, AlertingChannelHTTPHeaderFieldName), "key1"),
+			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf("%s.%d.%s", fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelWebhook, AlertingChannelWebhookFieldHTTPHeaders), 3, AlertingChannelHTTPHeaderFieldValue), "https://example.com:8443"),
 		},
 	)
 }
@@ -138,6 +161,62 @@ func alertingChannelGoogleChatDataSourceIntegrationTest() *dataSourceAlertingCha
 	)
 }
 
+func alertingChannelPrometheusWebhookDataSourceIntegrationTest() *dataSourceAlertingChannelIntegrationTest {
+	return newDataSourceAlertingChannelIntegrationTest(
+		"666671",
+		"my-prometheus-webhook-channel",
+		[]resource.TestCheckFunc{
+			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelPrometheusWebhook, AlertingChannelWebhookBasedFieldWebhookURL), "webhook-url-prometheus"),
+			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelPrometheusWebhook, AlertingChannelPrometheusWebhookFieldReceiver), "receiver"),
+		},
+	)
+}
+
+func alertingChannelWebexTeamsWebhookDataSourceIntegrationTest() *dataSourceAlertingChannelIntegrationTest {
+	return newDataSourceAlertingChannelIntegrationTest(
+		"666672",
+		"my-webex-teams-webhook-channel",
+		[]resource.TestCheckFunc{
+			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelWebexTeamsWebhook, AlertingChannelWebhookBasedFieldWebhookURL), "webhook-url-webex-teams"),
+		},
+	)
+}
+
+func alertingChannelWatsonAIOpsWebhookDataSourceIntegrationTest() *dataSourceAlertingChannelIntegrationTest {
+	return newDataSourceAlertingChannelIntegrationTest(
+		"666673",
+		"my-watson-aiops-webhook-channel",
+		[]resource.TestCheckFunc{
+			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelWatsonAIOpsWebhook, AlertingChannelWebhookBasedFieldWebhookURL), "webhook-url-watson-aiops"),
+			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf("%s.%d.%s", fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelWatsonAIOpsWebhook, AlertingChannelWebhookFieldHTTPHeaders), 0, AlertingChannelHTTPHeaderFieldName), "key1"),
+			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf("%s.%d.%s", fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelWatsonAIOpsWebhook, AlertingChannelWebhookFieldHTTPHeaders), 0, AlertingChannelHTTPHeaderFieldValue), "value1"),
+		},
+	)
+}
+
+func alertingChannelSlackAppDataSourceIntegrationTest() *dataSourceAlertingChannelIntegrationTest {
+	return newDataSourceAlertingChannelIntegrationTest(
+		"666674",
+		"my-slack-app-channel",
+		[]resource.TestCheckFunc{
+			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelSlackApp, AlertingChannelSlackAppFieldAppID), "app-id"),
+			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelSlackApp, AlertingChannelAppFieldChannelName), "channel-name"),
+			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelSlackApp, AlertingChannelSlackAppFieldEmojiRendering), trueAsString),
+		},
+	)
+}
+
+func alertingChannelMsTeamsAppDataSourceIntegrationTest() *dataSourceAlertingChannelIntegrationTest {
+	return newDataSourceAlertingChannelIntegrationTest(
+		"666675",
+		"my-ms-teams-app-channel",
+		[]resource.TestCheckFunc{
+			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelMsTeamsApp, AlertingChannelMsTeamsAppFieldAPITokenID), "api-token-id"),
+			resource.TestCheckResourceAttr(dataSourceAlertingChannelDefinitionPath, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelMsTeamsApp, AlertingChannelMsTeamsAppFieldTenantID), "tenant-id"),
+		},
+	)
+}
+
 func newDataSourceAlertingChannelIntegrationTest(id, channelName string, additionalChecks []resource.TestCheckFunc) *dataSourceAlertingChannelIntegrationTest {
 	return &dataSourceAlertingChannelIntegrationTest{
 		id:               id,
@@ -195,7 +274,7 @@ func (r *dataSourceAlertingChannelIntegrationTest) testRead(t *testing.T) {
 	"name": "my-webhook-channel",
 	"kind": "WEB_HOOK",
 	"webhookUrls": [ "url1", "url2" ],
-	"headers": [ "key1: value1", "key2: value2", "key3" ]
+	"headers": [ "key1: value1", "key2: value2", "key3", "key1: https://example.com:8443" ]
 },{
 	"id"     	 : "666668",
 	"name"   	 : "my-office-356-channel",
@@ -206,6 +285,52 @@ func (r *dataSourceAlertingChannelIntegrationTest) testRead(t *testing.T) {
 	"name"   	 : "my-google-chat-channel",
 	"kind"   	 : "GOOGLE_CHAT",
 	"webhookUrl" : "webhook-url-google-chat"
+},{
+	"id": "666670",
+	"name": "my-service-now-channel",
+	"kind": "SERVICE_NOW_WEBHOOK",
+	"serviceNowUrl": "service-now-url",
+	"username": "username",
+	"password": "password"
+},{
+	"id": "666671",
+	"name": "my-prometheus-webhook-channel",
+	"kind": "PROMETHEUS_WEBHOOK",
+	"webhookUrl": "webhook-url-prometheus",
+	"receiver": "receiver"
+},{
+	"id": "666672",
+	"name": "my-webex-teams-webhook-channel",
+	"kind": "WEBEX_TEAMS_WEBHOOK",
+	"webhookUrl": "webhook-url-webex-teams"
+},{
+	"id": "666673",
+	"name": "my-watson-aiops-webhook-channel",
+	"kind": "WATSON_AIOPS_WEBHOOK",
+	"webhookUrl": "webhook-url-watson-aiops",
+	"headers": [ "key1: value1" ]
+},{
+	"id": "666674",
+	"name": "my-slack-app-channel",
+	"kind": "BIDIRECTIONAL_SLACK",
+	"appId": "app-id",
+	"teamId": "team-id",
+	"teamName": "team-name",
+	"channelId": "channel-id",
+	"channelName": "channel-name",
+	"emojiRendering": true
+},{
+	"id": "666675",
+	"name": "my-ms-teams-app-channel",
+	"kind": "BIDIRECTIONAL_MS_TEAMS",
+	"apiTokenId": "api-token-id",
+	"teamId": "team-id",
+	"teamName": "team-name",
+	"channelId": "channel-id",
+	"channelName": "channel-name",
+	"tenantId": "tenant-id",
+	"tenantName": "tenant-name",
+	"serviceUrl": "service-url"
 }]
 `
 	httpServer := createMockHttpServerForDataSource(restapi.AlertingChannelsResourcePath, newStringContentResponseProvider(serverResponse))
@@ -243,28 +368,40 @@ func (r *dataSourceAlertingChannelUnitTest) schemaShouldBeValid(t *testing.T) {
 	schemaData := NewAlertingChannelDataSource().CreateResource().Schema
 
 	schemaAssert := testutils.NewTerraformSchemaAssert(schemaData, t)
-	require.Len(t, schemaData, 10)
+	require.Len(t, schemaData, 16)
 	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelFieldName)
 
 	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(AlertingChannelFieldChannelEmail)
 	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(AlertingChannelFieldChannelOpsGenie)
 	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(AlertingChannelFieldChannelPageDuty)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(AlertingChannelFieldChannelServiceNow)
 	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(AlertingChannelFieldChannelSlack)
 	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(AlertingChannelFieldChannelSplunk)
 	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(AlertingChannelFieldChannelVictorOps)
 	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(AlertingChannelFieldChannelWebhook)
 	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(AlertingChannelFieldChannelOffice365)
 	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(AlertingChannelFieldChannelGoogleChat)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(AlertingChannelFieldChannelPrometheusWebhook)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(AlertingChannelFieldChannelWebexTeamsWebhook)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(AlertingChannelFieldChannelWatsonAIOpsWebhook)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(AlertingChannelFieldChannelSlackApp)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(AlertingChannelFieldChannelMsTeamsApp)
 
 	r.validateEmailChannelSchema(t, schemaData[AlertingChannelFieldChannelEmail].Elem.(*schema.Resource).Schema)
 	r.validateOpsGenieChannelSchema(t, schemaData[AlertingChannelFieldChannelOpsGenie].Elem.(*schema.Resource).Schema)
 	r.validatePagerDutyChannelSchema(t, schemaData[AlertingChannelFieldChannelPageDuty].Elem.(*schema.Resource).Schema)
+	r.validateServiceNowChannelSchema(t, schemaData[AlertingChannelFieldChannelServiceNow].Elem.(*schema.Resource).Schema)
 	r.validateSlackChannelSchema(t, schemaData[AlertingChannelFieldChannelSlack].Elem.(*schema.Resource).Schema)
 	r.validateSplunkChannelSchema(t, schemaData[AlertingChannelFieldChannelSplunk].Elem.(*schema.Resource).Schema)
 	r.validateVictorOpsChannelSchema(t, schemaData[AlertingChannelFieldChannelVictorOps].Elem.(*schema.Resource).Schema)
 	r.validateWebhookChannelSchema(t, schemaData[AlertingChannelFieldChannelWebhook].Elem.(*schema.Resource).Schema)
 	r.validateWebhookBasedChannelSchema(t, schemaData[AlertingChannelFieldChannelOffice365].Elem.(*schema.Resource).Schema)
 	r.validateWebhookBasedChannelSchema(t, schemaData[AlertingChannelFieldChannelGoogleChat].Elem.(*schema.Resource).Schema)
+	r.validatePrometheusWebhookChannelSchema(t, schemaData[AlertingChannelFieldChannelPrometheusWebhook].Elem.(*schema.Resource).Schema)
+	r.validateWebhookBasedChannelSchema(t, schemaData[AlertingChannelFieldChannelWebexTeamsWebhook].Elem.(*schema.Resource).Schema)
+	r.validateWatsonAIOpsWebhookChannelSchema(t, schemaData[AlertingChannelFieldChannelWatsonAIOpsWebhook].Elem.(*schema.Resource).Schema)
+	r.validateSlackAppChannelSchema(t, schemaData[AlertingChannelFieldChannelSlackApp].Elem.(*schema.Resource).Schema)
+	r.validateMsTeamsAppChannelSchema(t, schemaData[AlertingChannelFieldChannelMsTeamsApp].Elem.(*schema.Resource).Schema)
 }
 
 func (r *dataSourceAlertingChannelUnitTest) validateEmailChannelSchema(t *testing.T, channelSchema map[string]*schema.Schema) {
@@ -287,6 +424,15 @@ func (r *dataSourceAlertingChannelUnitTest) validatePagerDutyChannelSchema(t *te
 	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelPagerDutyFieldServiceIntegrationKey)
 }
 
+func (r *dataSourceAlertingChannelUnitTest) validateServiceNowChannelSchema(t *testing.T, channelSchema map[string]*schema.Schema) {
+	require.Len(t, channelSchema, 3)
+	schemaAssert := testutils.NewTerraformSchemaAssert(channelSchema, t)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelServiceNowFieldServiceNowURL)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelServiceNowFieldUsername)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelServiceNowFieldPassword)
+	require.True(t, channelSchema[AlertingChannelServiceNowFieldPassword].Sensitive)
+}
+
 func (r *dataSourceAlertingChannelUnitTest) validateSlackChannelSchema(t *testing.T, channelSchema map[string]*schema.Schema) {
 	require.Len(t, channelSchema, 3)
 	schemaAssert := testutils.NewTerraformSchemaAssert(channelSchema, t)
@@ -300,6 +446,7 @@ func (r *dataSourceAlertingChannelUnitTest) validateSplunkChannelSchema(t *testi
 	schemaAssert := testutils.NewTerraformSchemaAssert(channelSchema, t)
 	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelSplunkFieldURL)
 	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelSplunkFieldToken)
+	require.True(t, channelSchema[AlertingChannelSplunkFieldToken].Sensitive)
 }
 
 func (r *dataSourceAlertingChannelUnitTest) validateVictorOpsChannelSchema(t *testing.T, channelSchema map[string]*schema.Schema) {
@@ -313,7 +460,8 @@ func (r *dataSourceAlertingChannelUnitTest) validateWebhookChannelSchema(t *test
 	require.Len(t, channelSchema, 2)
 	schemaAssert := testutils.NewTerraformSchemaAssert(channelSchema, t)
 	schemaAssert.AssertSchemaIsComputedAndOfTypeSetOfStrings(AlertingChannelWebhookFieldWebhookURLs)
-	schemaAssert.AssertSchemaIsComputedAndOfTypeMapOfStrings(AlertingChannelWebhookFieldHTTPHeaders)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(AlertingChannelWebhookFieldHTTPHeaders)
+	r.validateHTTPHeaderSchema(t, channelSchema[AlertingChannelWebhookFieldHTTPHeaders].Elem.(*schema.Resource).Schema)
 }
 
 func (r *dataSourceAlertingChannelUnitTest) validateWebhookBasedChannelSchema(t *testing.T, channelSchema map[string]*schema.Schema) {
@@ -322,8 +470,57 @@ func (r *dataSourceAlertingChannelUnitTest) validateWebhookBasedChannelSchema(t
 	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelWebhookBasedFieldWebhookURL)
 }
 
-func (r *dataSourceAlertingChannelUnitTest) shouldHaveSchemaVersion0(t *testing.T) {
-	require.Equal(t, 0, NewAlertingChannelResourceHandle().MetaData().SchemaVersion)
+func (r *dataSourceAlertingChannelUnitTest) validatePrometheusWebhookChannelSchema(t *testing.T, channelSchema map[string]*schema.Schema) {
+	require.Len(t, channelSchema, 2)
+	schemaAssert := testutils.NewTerraformSchemaAssert(channelSchema, t)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelWebhookBasedFieldWebhookURL)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelPrometheusWebhookFieldReceiver)
+}
+
+func (r *dataSourceAlertingChannelUnitTest) validateWatsonAIOpsWebhookChannelSchema(t *testing.T, channelSchema map[string]*schema.Schema) {
+	require.Len(t, channelSchema, 2)
+	schemaAssert := testutils.NewTerraformSchemaAssert(channelSchema, t)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelWebhookBasedFieldWebhookURL)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(AlertingChannelWebhookFieldHTTPHeaders)
+	r.validateHTTPHeaderSchema(t, channelSchema[AlertingChannelWebhookFieldHTTPHeaders].Elem.(*schema.Resource).Schema)
+}
+
+func (r *dataSourceAlertingChannelUnitTest) validateHTTPHeaderSchema(t *testing.T, headerSchema map[string]*schema.Schema) {
+	require.Len(t, headerSchema, 3)
+	schemaAssert := testutils.NewTerraformSchemaAssert(headerSchema, t)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelHTTPHeaderFieldName)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelHTTPHeaderFieldValue)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeBool(AlertingChannelHTTPHeaderFieldSensitive)
+	require.True(t, headerSchema[AlertingChannelHTTPHeaderFieldValue].Sensitive)
+}
+
+func (r *dataSourceAlertingChannelUnitTest) validateSlackAppChannelSchema(t *testing.T, channelSchema map[string]*schema.Schema) {
+	require.Len(t, channelSchema, 6)
+	schemaAssert := testutils.NewTerraformSchemaAssert(channelSchema, t)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelSlackAppFieldAppID)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelAppFieldTeamID)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelAppFieldTeamName)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelAppFieldChannelID)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelAppFieldChannelName)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeBool(AlertingChannelSlackAppFieldEmojiRendering)
+}
+
+func (r *dataSourceAlertingChannelUnitTest) validateMsTeamsAppChannelSchema(t *testing.T, channelSchema map[string]*schema.Schema) {
+	require.Len(t, channelSchema, 9)
+	schemaAssert := testutils.NewTerraformSchemaAssert(channelSchema, t)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelMsTeamsAppFieldAPITokenID)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelAppFieldTeamID)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelAppFieldTeamName)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelAppFieldChannelID)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelAppFieldChannelName)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelMsTeamsAppFieldTenantID)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelMsTeamsAppFieldTenantName)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelMsTeamsAppFieldServiceURL)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelMsTeamsAppFieldInstanaURL)
+}
+
+func (r *dataSourceAlertingChannelUnitTest) shouldHaveSchemaVersion1(t *testing.T) {
+	require.Equal(t, 1, NewAlertingChannelResourceHandle().MetaData().SchemaVersion)
 }
 
 func (r *dataSourceAlertingChannelUnitTest) shouldSuccessfullyReadChannel(t *testing.T) {
@@ -0,0 +1,161 @@
+package instana
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// NewApdexReportDataSource creates a new DataSource for apdex reports
+func NewApdexReportDataSource() DataSource {
+	return &apdexReportDataSource{}
+}
+
+const (
+	//DataSourceApdexReport the name of the terraform-provider-instana data source for apdex reports
+	DataSourceApdexReport = "instana_apdex_report"
+
+	//ApdexReportFieldApdexID constant value for the schema field apdex_id
+	ApdexReportFieldApdexID = "apdex_id"
+	//ApdexReportFieldFrom constant value for the schema field from
+	ApdexReportFieldFrom = "from"
+	//ApdexReportFieldTo constant value for the schema field to
+	ApdexReportFieldTo = "to"
+	//ApdexReportFieldReports constant value for the computed schema field reports
+	ApdexReportFieldReports = "reports"
+	//ApdexReportFieldScore constant value for the computed schema field reports.score
+	ApdexReportFieldScore = "score"
+	//ApdexReportFieldScoreTimestamp constant value for the computed schema field reports.score.timestamp
+	ApdexReportFieldScoreTimestamp = "timestamp"
+	//ApdexReportFieldScoreValue constant value for the computed schema field reports.score.value
+	ApdexReportFieldScoreValue = "value"
+)
+
+type apdexReportDataSource struct{}
+
+// CreateResource creates the resource for the apdex report data source
+func (ds *apdexReportDataSource) CreateResource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ds.read,
+		Schema: map[string]*schema.Schema{
+			ApdexReportFieldApdexID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "The ID of the apdex config",
+			},
+			ApdexReportFieldFrom: {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The start of the time window of the report as unix timestamp in milliseconds",
+			},
+			ApdexReportFieldTo: {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The end of the time window of the report as unix timestamp in milliseconds",
+			},
+			ApdexReportFieldReports: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The apdex reports of the time window as returned by the Instana API",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						ApdexReportFieldFrom: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The start of the time window of the report as unix timestamp in milliseconds",
+						},
+						ApdexReportFieldTo: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The end of the time window of the report as unix timestamp in milliseconds",
+						},
+						ApdexReportFieldScore: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The apdex scores of the report",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									ApdexReportFieldScoreTimestamp: {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The timestamp of the apdex score as unix timestamp in milliseconds",
+									},
+									ApdexReportFieldScoreValue: {
+										Type:        schema.TypeFloat,
+										Computed:    true,
+										Description: "The apdex score",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (ds *apdexReportDataSource) read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta := meta.(*ProviderMeta)
+	instanaAPI := providerMeta.InstanaAPI
+
+	apdexID := d.Get(ApdexReportFieldApdexID).(string)
+	from := int64(d.Get(ApdexReportFieldFrom).(int))
+	to := int64(d.Get(ApdexReportFieldTo).(int))
+	if from >= to {
+		return diag.FromErr(fmt.Errorf("%s must be before %s", ApdexReportFieldFrom, ApdexReportFieldTo))
+	}
+
+	reports, err := instanaAPI.ApdexReports().GetReport(apdexID, from, to)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = ds.updateState(d, apdexID, *reports)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func (ds *apdexReportDataSource) updateState(d *schema.ResourceData, apdexID string, reports []*restapi.ApdexReport) error {
+	reportValues := make([]interface{}, len(reports))
+	for i, report := range reports {
+		scores, err := ds.mapScores(report)
+		if err != nil {
+			return err
+		}
+		reportValues[i] = map[string]interface{}{
+			ApdexReportFieldFrom:  int(report.From),
+			ApdexReportFieldTo:    int(report.To),
+			ApdexReportFieldScore: scores,
+		}
+	}
+
+	d.SetId(apdexID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		ApdexReportFieldReports: reportValues,
+	})
+}
+
+func (ds *apdexReportDataSource) mapScores(report *restapi.ApdexReport) ([]interface{}, error) {
+	scores := make([]interface{}, 0, len(report.ApdexScore))
+	for _, s := range report.ApdexScore {
+		if len(s) != 2 {
+			return nil, fmt.Errorf("unexpected apdex score data point %v; expected a pair of timestamp and score", s)
+		}
+		scores = append(scores, map[string]interface{}{
+			ApdexReportFieldScoreTimestamp: int(s[0]),
+			ApdexReportFieldScoreValue:     s[1],
+		})
+	}
+	return scores, nil
+}
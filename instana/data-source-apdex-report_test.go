@@ -0,0 +1,122 @@
+package instana_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+func TestDataSourceApdexReportDefinition(t *testing.T) {
+	sut := NewApdexReportDataSource().CreateResource()
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(sut.Schema, t)
+
+	require.Equal(t, 0, sut.SchemaVersion)
+	require.Equal(t, 4, len(sut.Schema))
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(ApdexReportFieldApdexID)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeInt(ApdexReportFieldFrom)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeInt(ApdexReportFieldTo)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(ApdexReportFieldReports)
+}
+
+func TestShouldSuccessfullyReadApdexReport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reports := []*restapi.ApdexReport{
+		{ApdexID: "apdex-id", ApdexScore: [][]float64{{1000, 0.9}, {2000, 0.75}}, From: 1000, To: 2000},
+		{ApdexID: "apdex-id", ApdexScore: [][]float64{{2000, 0.5}}, From: 2000, To: 3000},
+	}
+	apdexReportAPI := mocks.NewMockApdexReportRestResource(ctrl)
+	apdexReportAPI.EXPECT().GetReport("apdex-id", int64(1000), int64(3000)).Times(1).Return(&reports, nil)
+	mockInstanaAPI := mocks.NewMockInstanaAPI(ctrl)
+	mockInstanaAPI.EXPECT().ApdexReports().Times(1).Return(apdexReportAPI)
+
+	resourceData, diagnostics := executeApdexReportRead(t, mockInstanaAPI, 1000, 3000)
+
+	require.Nil(t, diagnostics)
+	require.Equal(t, "apdex-id", resourceData.Id())
+	require.Equal(t, []interface{}{
+		map[string]interface{}{
+			ApdexReportFieldFrom: 1000,
+			ApdexReportFieldTo:   2000,
+			ApdexReportFieldScore: []interface{}{
+				map[string]interface{}{ApdexReportFieldScoreTimestamp: 1000, ApdexReportFieldScoreValue: 0.9},
+				map[string]interface{}{ApdexReportFieldScoreTimestamp: 2000, ApdexReportFieldScoreValue: 0.75},
+			},
+		},
+		map[string]interface{}{
+			ApdexReportFieldFrom: 2000,
+			ApdexReportFieldTo:   3000,
+			ApdexReportFieldScore: []interface{}{
+				map[string]interface{}{ApdexReportFieldScoreTimestamp: 2000, ApdexReportFieldScoreValue: 0.5},
+			},
+		},
+	}, resourceData.Get(ApdexReportFieldReports))
+}
+
+func TestShouldFailToReadApdexReportWhenTimeWindowIsInvalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockInstanaAPI := mocks.NewMockInstanaAPI(ctrl)
+
+	_, diagnostics := executeApdexReportRead(t, mockInstanaAPI, 3000, 1000)
+
+	require.True(t, diagnostics.HasError())
+	require.Contains(t, diagnostics[0].Summary, "from must be before to")
+}
+
+func TestShouldFailToReadApdexReportWhenAPIRequestFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedError := errors.New("test")
+	apdexReportAPI := mocks.NewMockApdexReportRestResource(ctrl)
+	apdexReportAPI.EXPECT().GetReport("apdex-id", int64(1000), int64(3000)).Times(1).Return(nil, expectedError)
+	mockInstanaAPI := mocks.NewMockInstanaAPI(ctrl)
+	mockInstanaAPI.EXPECT().ApdexReports().Times(1).Return(apdexReportAPI)
+
+	_, diagnostics := executeApdexReportRead(t, mockInstanaAPI, 1000, 3000)
+
+	require.True(t, diagnostics.HasError())
+	require.Equal(t, expectedError.Error(), diagnostics[0].Summary)
+}
+
+func TestShouldFailToReadApdexReportWhenScoreDataPointIsInvalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reports := []*restapi.ApdexReport{{ApdexID: "apdex-id", ApdexScore: [][]float64{{1000}}}}
+	apdexReportAPI := mocks.NewMockApdexReportRestResource(ctrl)
+	apdexReportAPI.EXPECT().GetReport("apdex-id", int64(1000), int64(3000)).Times(1).Return(&reports, nil)
+	mockInstanaAPI := mocks.NewMockInstanaAPI(ctrl)
+	mockInstanaAPI.EXPECT().ApdexReports().Times(1).Return(apdexReportAPI)
+
+	_, diagnostics := executeApdexReportRead(t, mockInstanaAPI, 1000, 3000)
+
+	require.True(t, diagnostics.HasError())
+	require.Contains(t, diagnostics[0].Summary, "unexpected apdex score data point")
+}
+
+func executeApdexReportRead(t *testing.T, instanaAPI restapi.InstanaAPI, from int, to int) (*schema.ResourceData, diag.Diagnostics) {
+	sut := NewApdexReportDataSource().CreateResource()
+	meta := &ProviderMeta{InstanaAPI: instanaAPI}
+	resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+		ApdexReportFieldApdexID: "apdex-id",
+		ApdexReportFieldFrom:    from,
+		ApdexReportFieldTo:      to,
+	})
+
+	return resourceData, sut.ReadContext(context.TODO(), resourceData, meta)
+}
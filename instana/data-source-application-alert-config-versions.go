@@ -0,0 +1,139 @@
+package instana
+
+import (
+	"context"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// NewApplicationAlertConfigVersionsDataSource creates a new DataSource for the versions of application alert configs
+func NewApplicationAlertConfigVersionsDataSource() DataSource {
+	return &applicationAlertConfigVersionsDataSource{}
+}
+
+const (
+	//DataSourceApplicationAlertConfigVersions the name of the terraform-provider-instana data source for the versions of application alert configs
+	DataSourceApplicationAlertConfigVersions = "instana_application_alert_config_versions"
+
+	//AlertConfigVersionsFieldAlertConfigID constant value for the schema field alert_config_id
+	AlertConfigVersionsFieldAlertConfigID = "alert_config_id"
+	//AlertConfigVersionsFieldVersions constant value for the computed schema field versions
+	AlertConfigVersionsFieldVersions = "versions"
+	//AlertConfigVersionsFieldVersionsCreated constant value for the computed schema field versions.created
+	AlertConfigVersionsFieldVersionsCreated = "created"
+	//AlertConfigVersionsFieldVersionsDeleted constant value for the computed schema field versions.deleted
+	AlertConfigVersionsFieldVersionsDeleted = "deleted"
+	//AlertConfigVersionsFieldVersionsEnabled constant value for the computed schema field versions.enabled
+	AlertConfigVersionsFieldVersionsEnabled = "enabled"
+	//AlertConfigVersionsFieldVersionsChangeType constant value for the computed schema field versions.change_type
+	AlertConfigVersionsFieldVersionsChangeType = "change_type"
+	//AlertConfigVersionsFieldVersionsAuthorID constant value for the computed schema field versions.author_id
+	AlertConfigVersionsFieldVersionsAuthorID = "author_id"
+	//AlertConfigVersionsFieldVersionsAuthorType constant value for the computed schema field versions.author_type
+	AlertConfigVersionsFieldVersionsAuthorType = "author_type"
+)
+
+type applicationAlertConfigVersionsDataSource struct{}
+
+// CreateResource creates the resource for the application alert config versions data source
+func (ds *applicationAlertConfigVersionsDataSource) CreateResource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ds.read,
+		Schema: map[string]*schema.Schema{
+			AlertConfigVersionsFieldAlertConfigID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "The ID of the application alert config",
+			},
+			AlertConfigVersionsFieldVersions: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The versions of the application alert config",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						AlertConfigVersionsFieldVersionsCreated: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The creation time of the version as unix timestamp in milliseconds. This timestamp identifies the version when it is restored",
+						},
+						AlertConfigVersionsFieldVersionsDeleted: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Flag to indicate whether the alert config was deleted with this version",
+						},
+						AlertConfigVersionsFieldVersionsEnabled: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Flag to indicate whether the alert config was enabled in this version",
+						},
+						AlertConfigVersionsFieldVersionsChangeType: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the change which created this version",
+						},
+						AlertConfigVersionsFieldVersionsAuthorID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the author of the change",
+						},
+						AlertConfigVersionsFieldVersionsAuthorType: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the author of the change",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (ds *applicationAlertConfigVersionsDataSource) read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta := meta.(*ProviderMeta)
+	instanaAPI := providerMeta.InstanaAPI
+
+	alertConfigID := d.Get(AlertConfigVersionsFieldAlertConfigID).(string)
+	versions, err := instanaAPI.ApplicationAlertConfigVersions().GetVersions(alertConfigID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = ds.updateState(d, alertConfigID, versions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func (ds *applicationAlertConfigVersionsDataSource) updateState(d *schema.ResourceData, alertConfigID string, versions *[]*restapi.AlertConfigVersion) error {
+	result := make([]interface{}, 0)
+	if versions != nil {
+		for _, v := range *versions {
+			result = append(result, ds.mapVersionToState(v))
+		}
+	}
+
+	d.SetId(alertConfigID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		AlertConfigVersionsFieldVersions: result,
+	})
+}
+
+func (ds *applicationAlertConfigVersionsDataSource) mapVersionToState(version *restapi.AlertConfigVersion) map[string]interface{} {
+	result := map[string]interface{}{
+		AlertConfigVersionsFieldVersionsCreated: int(version.Created),
+		AlertConfigVersionsFieldVersionsDeleted: version.Deleted,
+		AlertConfigVersionsFieldVersionsEnabled: version.Enabled,
+	}
+	if version.ChangeSummary != nil {
+		result[AlertConfigVersionsFieldVersionsChangeType] = version.ChangeSummary.ChangeType
+		result[AlertConfigVersionsFieldVersionsAuthorID] = version.ChangeSummary.Author.ID
+		result[AlertConfigVersionsFieldVersionsAuthorType] = version.ChangeSummary.Author.Type
+	}
+	return result
+}
@@ -0,0 +1,103 @@
+package instana_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+func TestDataSourceApplicationAlertConfigVersionsDefinition(t *testing.T) {
+	sut := NewApplicationAlertConfigVersionsDataSource().CreateResource()
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(sut.Schema, t)
+
+	require.Equal(t, 0, sut.SchemaVersion)
+	require.Equal(t, 2, len(sut.Schema))
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertConfigVersionsFieldAlertConfigID)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(AlertConfigVersionsFieldVersions)
+}
+
+func TestShouldSuccessfullyReadApplicationAlertConfigVersions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	versions := &[]*restapi.AlertConfigVersion{
+		{
+			ID:      "alert-config-id",
+			Created: 1000,
+			Enabled: true,
+			ChangeSummary: &restapi.AlertConfigVersionChangeSummary{
+				Author:     restapi.AlertConfigVersionAuthor{ID: "api-token-id", Type: "API"},
+				ChangeType: "CREATE",
+			},
+		},
+		{
+			ID:      "alert-config-id",
+			Created: 2000,
+			Enabled: false,
+		},
+	}
+	versionsAPI := mocks.NewMockAlertConfigVersionRestResource(ctrl)
+	versionsAPI.EXPECT().GetVersions("alert-config-id").Times(1).Return(versions, nil)
+	mockInstanaAPI := mocks.NewMockInstanaAPI(ctrl)
+	mockInstanaAPI.EXPECT().ApplicationAlertConfigVersions().Times(1).Return(versionsAPI)
+
+	resourceData, diagnostics := executeApplicationAlertConfigVersionsRead(t, mockInstanaAPI)
+
+	require.Nil(t, diagnostics)
+	require.Equal(t, "alert-config-id", resourceData.Id())
+	require.Equal(t, []interface{}{
+		map[string]interface{}{
+			AlertConfigVersionsFieldVersionsCreated:    1000,
+			AlertConfigVersionsFieldVersionsDeleted:    false,
+			AlertConfigVersionsFieldVersionsEnabled:    true,
+			AlertConfigVersionsFieldVersionsChangeType: "CREATE",
+			AlertConfigVersionsFieldVersionsAuthorID:   "api-token-id",
+			AlertConfigVersionsFieldVersionsAuthorType: "API",
+		},
+		map[string]interface{}{
+			AlertConfigVersionsFieldVersionsCreated:    2000,
+			AlertConfigVersionsFieldVersionsDeleted:    false,
+			AlertConfigVersionsFieldVersionsEnabled:    false,
+			AlertConfigVersionsFieldVersionsChangeType: "",
+			AlertConfigVersionsFieldVersionsAuthorID:   "",
+			AlertConfigVersionsFieldVersionsAuthorType: "",
+		},
+	}, resourceData.Get(AlertConfigVersionsFieldVersions))
+}
+
+func TestShouldFailToReadApplicationAlertConfigVersionsWhenAPIRequestFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedError := errors.New("test")
+	versionsAPI := mocks.NewMockAlertConfigVersionRestResource(ctrl)
+	versionsAPI.EXPECT().GetVersions("alert-config-id").Times(1).Return(nil, expectedError)
+	mockInstanaAPI := mocks.NewMockInstanaAPI(ctrl)
+	mockInstanaAPI.EXPECT().ApplicationAlertConfigVersions().Times(1).Return(versionsAPI)
+
+	_, diagnostics := executeApplicationAlertConfigVersionsRead(t, mockInstanaAPI)
+
+	require.True(t, diagnostics.HasError())
+	require.Equal(t, expectedError.Error(), diagnostics[0].Summary)
+}
+
+func executeApplicationAlertConfigVersionsRead(t *testing.T, instanaAPI restapi.InstanaAPI) (*schema.ResourceData, diag.Diagnostics) {
+	sut := NewApplicationAlertConfigVersionsDataSource().CreateResource()
+	meta := &ProviderMeta{InstanaAPI: instanaAPI}
+	resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+		AlertConfigVersionsFieldAlertConfigID: "alert-config-id",
+	})
+
+	return resourceData, sut.ReadContext(context.TODO(), resourceData, meta)
+}
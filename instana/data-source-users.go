@@ -0,0 +1,150 @@
+package instana
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// NewUsersDataSource creates a new DataSource for the users of the Instana tenant
+func NewUsersDataSource() DataSource {
+	return &usersDataSource{}
+}
+
+const (
+	//DataSourceUsers the name of the terraform-provider-instana data source for users
+	DataSourceUsers = "instana_users"
+
+	//UsersFieldEmails constant value for the schema field emails
+	UsersFieldEmails = "emails"
+	//UsersFieldUsers constant value for the computed schema field users
+	UsersFieldUsers = "users"
+	//UsersFieldUserID constant value for the computed schema field users.id
+	UsersFieldUserID = "id"
+	//UsersFieldUserEmail constant value for the computed schema field users.email
+	UsersFieldUserEmail = "email"
+	//UsersFieldUserFullName constant value for the computed schema field users.full_name
+	UsersFieldUserFullName = "full_name"
+	//UsersFieldUserIDs constant value for the computed schema field user_ids
+	UsersFieldUserIDs = "user_ids"
+)
+
+type usersDataSource struct{}
+
+// CreateResource creates the resource for the users data source
+func (ds *usersDataSource) CreateResource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ds.read,
+		Schema: map[string]*schema.Schema{
+			UsersFieldEmails: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Optional list of email addresses to filter the users. When provided, all email addresses must belong to an existing user",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			UsersFieldUsers: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The users of the Instana tenant",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						UsersFieldUserID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the user",
+						},
+						UsersFieldUserEmail: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The email address of the user",
+						},
+						UsersFieldUserFullName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The full name of the user",
+						},
+					},
+				},
+			},
+			UsersFieldUserIDs: {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The IDs of the users by their email address",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func (ds *usersDataSource) read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta := meta.(*ProviderMeta)
+	instanaAPI := providerMeta.InstanaAPI
+
+	users, err := instanaAPI.Users().GetAll()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	emails := ConvertInterfaceSlice[string](d.Get(UsersFieldEmails).(*schema.Set).List())
+	if len(emails) > 0 {
+		users, err = ds.filterUsersByEmail(users, emails)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	err = ds.updateState(d, users)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func (ds *usersDataSource) filterUsersByEmail(users *[]*restapi.User, emails []string) (*[]*restapi.User, error) {
+	result := make([]*restapi.User, 0, len(emails))
+	for _, email := range emails {
+		user, ok := ds.findUserByEmail(users, email)
+		if !ok {
+			return nil, fmt.Errorf("no user found with email %s", email)
+		}
+		result = append(result, user)
+	}
+	return &result, nil
+}
+
+func (ds *usersDataSource) findUserByEmail(users *[]*restapi.User, email string) (*restapi.User, bool) {
+	for _, u := range *users {
+		if strings.EqualFold(u.Email, email) {
+			return u, true
+		}
+	}
+	return nil, false
+}
+
+func (ds *usersDataSource) updateState(d *schema.ResourceData, users *[]*restapi.User) error {
+	userList := make([]interface{}, len(*users))
+	userIDs := make(map[string]interface{}, len(*users))
+	for i, u := range *users {
+		userList[i] = map[string]interface{}{
+			UsersFieldUserID:       u.ID,
+			UsersFieldUserEmail:    u.Email,
+			UsersFieldUserFullName: u.FullName,
+		}
+		userIDs[u.Email] = u.ID
+	}
+
+	d.SetId(DataSourceUsers)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		UsersFieldUsers:   userList,
+		UsersFieldUserIDs: userIDs,
+	})
+}
@@ -0,0 +1,109 @@
+package instana_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+var testUsers = []*restapi.User{
+	{ID: "user-1", Email: "john.doe@example.com", FullName: "John Doe"},
+	{ID: "user-2", Email: "jane.doe@example.com", FullName: "Jane Doe"},
+}
+
+func TestDataSourceUsersDefinition(t *testing.T) {
+	sut := NewUsersDataSource().CreateResource()
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(sut.Schema, t)
+
+	require.Equal(t, 0, sut.SchemaVersion)
+	require.Equal(t, 3, len(sut.Schema))
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeSetOfStrings(UsersFieldEmails)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeListOfResource(UsersFieldUsers)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeMapOfStrings(UsersFieldUserIDs)
+}
+
+func TestShouldReadAllUsersWhenNoEmailsAreProvided(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockInstanaAPI := createMockInstanaAPIForUsers(ctrl, &testUsers, nil)
+
+	resourceData, diagnostics := executeUsersRead(t, mockInstanaAPI, []interface{}{})
+
+	require.Nil(t, diagnostics)
+	require.Equal(t, DataSourceUsers, resourceData.Id())
+	require.Equal(t, []interface{}{
+		map[string]interface{}{UsersFieldUserID: "user-1", UsersFieldUserEmail: "john.doe@example.com", UsersFieldUserFullName: "John Doe"},
+		map[string]interface{}{UsersFieldUserID: "user-2", UsersFieldUserEmail: "jane.doe@example.com", UsersFieldUserFullName: "Jane Doe"},
+	}, resourceData.Get(UsersFieldUsers))
+	require.Equal(t, map[string]interface{}{"john.doe@example.com": "user-1", "jane.doe@example.com": "user-2"}, resourceData.Get(UsersFieldUserIDs))
+}
+
+func TestShouldReadUsersFilteredByEmailIgnoringCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockInstanaAPI := createMockInstanaAPIForUsers(ctrl, &testUsers, nil)
+
+	resourceData, diagnostics := executeUsersRead(t, mockInstanaAPI, []interface{}{"Jane.Doe@example.com"})
+
+	require.Nil(t, diagnostics)
+	require.Equal(t, []interface{}{
+		map[string]interface{}{UsersFieldUserID: "user-2", UsersFieldUserEmail: "jane.doe@example.com", UsersFieldUserFullName: "Jane Doe"},
+	}, resourceData.Get(UsersFieldUsers))
+	require.Equal(t, map[string]interface{}{"jane.doe@example.com": "user-2"}, resourceData.Get(UsersFieldUserIDs))
+}
+
+func TestShouldFailToReadUsersWhenEmailDoesNotBelongToAnyUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockInstanaAPI := createMockInstanaAPIForUsers(ctrl, &testUsers, nil)
+
+	_, diagnostics := executeUsersRead(t, mockInstanaAPI, []interface{}{"unknown@example.com"})
+
+	require.True(t, diagnostics.HasError())
+	require.Contains(t, diagnostics[0].Summary, "no user found with email unknown@example.com")
+}
+
+func TestShouldFailToReadUsersWhenAPIRequestFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedError := errors.New("test")
+	mockInstanaAPI := createMockInstanaAPIForUsers(ctrl, nil, expectedError)
+
+	_, diagnostics := executeUsersRead(t, mockInstanaAPI, []interface{}{})
+
+	require.True(t, diagnostics.HasError())
+	require.Equal(t, expectedError.Error(), diagnostics[0].Summary)
+}
+
+func createMockInstanaAPIForUsers(ctrl *gomock.Controller, users *[]*restapi.User, err error) restapi.InstanaAPI {
+	usersAPI := mocks.NewMockReadOnlyRestResource[*restapi.User](ctrl)
+	usersAPI.EXPECT().GetAll().Times(1).Return(users, err)
+	mockInstanaAPI := mocks.NewMockInstanaAPI(ctrl)
+	mockInstanaAPI.EXPECT().Users().Times(1).Return(usersAPI)
+	return mockInstanaAPI
+}
+
+func executeUsersRead(t *testing.T, instanaAPI restapi.InstanaAPI, emails []interface{}) (*schema.ResourceData, diag.Diagnostics) {
+	sut := NewUsersDataSource().CreateResource()
+	meta := &ProviderMeta{InstanaAPI: instanaAPI}
+	resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+		UsersFieldEmails: emails,
+	})
+
+	return resourceData, sut.ReadContext(context.TODO(), resourceData, meta)
+}
@@ -18,9 +18,13 @@ const SchemaFieldEndpoint = "endpoint"
 // SchemaFieldTlsSkipVerify flag to deactivate skip tls verification
 const SchemaFieldTlsSkipVerify = "tls_skip_verify"
 
+// SchemaFieldTagCatalogValidation flag to activate the validation of tag filters against the Instana tag catalog
+const SchemaFieldTagCatalogValidation = "tag_catalog_validation"
+
 // ProviderMeta data structure for the metadata which is configured and provided to the resources by this provider
 type ProviderMeta struct {
-	InstanaAPI restapi.InstanaAPI
+	InstanaAPI  restapi.InstanaAPI
+	TagCatalogs TagCatalogs
 }
 
 // Provider interface implementation of hashicorp terraform provider
@@ -54,6 +58,12 @@ func providerSchema() map[string]*schema.Schema {
 			Default:     false,
 			Description: "If set to true, TLS verification will be skipped when calling Instana API",
 		},
+		SchemaFieldTagCatalogValidation: {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "If set to true, tag filters are validated against the tag catalog of the Instana API at plan time",
+		},
 	}
 }
 
@@ -70,8 +80,23 @@ func providerResources() map[string]*schema.Resource {
 	bindResourceHandle(resources, NewWebsiteMonitoringConfigResourceHandle())
 	bindResourceHandle(resources, NewWebsiteAlertConfigResourceHandle())
 	bindResourceHandle(resources, NewGroupResourceHandle())
+	bindResourceHandle(resources, NewGroupMappingResourceHandle())
+	bindResourceHandle(resources, NewUserInvitationResourceHandle())
+	bindResourceHandle(resources, NewGroupMembershipResourceHandle())
+	bindResourceHandle(resources, NewGroupPermissionsResourceHandle())
+	bindResourceHandle(resources, NewHostAgentConfigurationResourceHandle())
+	bindResourceHandle(resources, NewWebsiteIPMaskingConfigResourceHandle())
+	bindResourceHandle(resources, NewWebsiteGeoLocationConfigResourceHandle())
+	bindResourceHandle(resources, NewReleaseResourceHandle())
+	bindResourceHandle(resources, NewBuiltinEventSpecificationStateResourceHandle())
+	bindResourceHandle(resources, NewApplicationAlertConfigRestoreResourceHandle())
 	bindResourceHandle(resources, NewCustomDashboardResourceHandle())
 	bindResourceHandle(resources, NewSyntheticTestResourceHandle())
+	bindResourceHandle(resources, NewServiceConfigResourceHandle())
+	bindResourceHandle(resources, NewServiceConfigOrderResourceHandle())
+	bindResourceHandle(resources, NewManualServiceConfigResourceHandle())
+	bindResourceHandle(resources, NewHttpEndpointConfigResourceHandle())
+	bindResourceHandle(resources, NewApdexConfigResourceHandle())
 	return resources
 }
 
@@ -84,8 +109,13 @@ func providerConfigure(_ context.Context, d *schema.ResourceData) (interface{},
 	endpoint := strings.TrimSpace(d.Get(SchemaFieldEndpoint).(string))
 	skipTlsVerify := d.Get(SchemaFieldTlsSkipVerify).(bool)
 	instanaAPI := restapi.NewInstanaAPI(apiToken, endpoint, skipTlsVerify)
+	var tagCatalogs TagCatalogs
+	if d.Get(SchemaFieldTagCatalogValidation).(bool) {
+		tagCatalogs = NewTagCatalogs(instanaAPI)
+	}
 	return &ProviderMeta{
-		InstanaAPI: instanaAPI,
+		InstanaAPI:  instanaAPI,
+		TagCatalogs: tagCatalogs,
 	}, nil
 }
 
@@ -94,5 +124,8 @@ func providerDataSources() map[string]*schema.Resource {
 	dataSources[DataSourceBuiltinEvent] = NewBuiltinEventDataSource().CreateResource()
 	dataSources[DataSourceSyntheticLocation] = NewSyntheticLocationDataSource().CreateResource()
 	dataSources[DataSourceAlertingChannel] = NewAlertingChannelDataSource().CreateResource()
+	dataSources[DataSourceApdexReport] = NewApdexReportDataSource().CreateResource()
+	dataSources[DataSourceUsers] = NewUsersDataSource().CreateResource()
+	dataSources[DataSourceApplicationAlertConfigVersions] = NewApplicationAlertConfigVersionsDataSource().CreateResource()
 	return dataSources
 }
@@ -18,18 +18,19 @@ func TestProviderShouldContainValidSchemaDefinition(t *testing.T) {
 	config := Provider()
 
 	assert.NotNil(t, config.Schema)
-	assert.Equal(t, 3, len(config.Schema))
+	assert.Equal(t, 4, len(config.Schema))
 
 	schemaAssert := testutils.NewTerraformSchemaAssert(config.Schema, t)
 	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(SchemaFieldAPIToken)
 	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(SchemaFieldEndpoint)
 	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(SchemaFieldTlsSkipVerify, false)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(SchemaFieldTagCatalogValidation, false)
 }
 
 func TestProviderShouldContainValidResourceDefinitions(t *testing.T) {
 	config := Provider()
 
-	assert.Equal(t, 13, len(config.ResourcesMap))
+	assert.Equal(t, 28, len(config.ResourcesMap))
 
 	assert.NotNil(t, config.ResourcesMap[ResourceInstanaAPIToken])
 	assert.NotNil(t, config.ResourcesMap[ResourceInstanaApplicationConfig])
@@ -44,15 +45,33 @@ func TestProviderShouldContainValidResourceDefinitions(t *testing.T) {
 	assert.NotNil(t, config.ResourcesMap[ResourceInstanaCustomEventSpecification])
 	assert.NotNil(t, config.ResourcesMap[ResourceInstanaAlertingChannel])
 	assert.NotNil(t, config.ResourcesMap[ResourceInstanaAlertingConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaServiceConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaServiceConfigOrder])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaManualServiceConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaHttpEndpointConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaApdexConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaGroupMapping])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaUserInvitation])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaGroupMembership])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaGroupPermissions])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaHostAgentConfiguration])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaWebsiteIPMaskingConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaWebsiteGeoLocationConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaRelease])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaBuiltinEventSpecificationState])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaApplicationAlertConfigRestore])
 }
 
 func TestProviderShouldContainValidDataSourceDefinitions(t *testing.T) {
 	config := Provider()
 
-	assert.Equal(t, 3, len(config.DataSourcesMap))
+	assert.Equal(t, 6, len(config.DataSourcesMap))
 
 	assert.NotNil(t, config.DataSourcesMap[DataSourceBuiltinEvent])
 	assert.NotNil(t, config.DataSourcesMap[DataSourceSyntheticLocation])
 	assert.NotNil(t, config.DataSourcesMap[DataSourceAlertingChannel])
+	assert.NotNil(t, config.DataSourcesMap[DataSourceApdexReport])
+	assert.NotNil(t, config.DataSourcesMap[DataSourceUsers])
+	assert.NotNil(t, config.DataSourcesMap[DataSourceApplicationAlertConfigVersions])
 
 }
@@ -1,9 +1,11 @@
 package instana
 
 import (
+	"context"
 	"fmt"
 	"github.com/gessnerfl/terraform-provider-instana/tfutils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"sort"
 	"strings"
 
 	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
@@ -16,6 +18,10 @@ const (
 
 	//AlertingChannelFieldName constant value for the schema field name
 	AlertingChannelFieldName = "name"
+	//AlertingChannelFieldVerifyOnApply constant value for the schema field verify_on_apply
+	AlertingChannelFieldVerifyOnApply = "verify_on_apply"
+	//AlertingChannelFieldSecretVersion constant value for the schema field secret_version
+	AlertingChannelFieldSecretVersion = "secret_version"
 
 	//AlertingChannelFieldChannelEmail const for schema field of the email channel
 	AlertingChannelFieldChannelEmail = "email"
@@ -36,6 +42,15 @@ const (
 	//AlertingChannelPagerDutyFieldServiceIntegrationKey const for the emails field of the alerting channel
 	AlertingChannelPagerDutyFieldServiceIntegrationKey = "service_integration_key"
 
+	//AlertingChannelFieldChannelServiceNow const for schema field of the ServiceNow webhook channel
+	AlertingChannelFieldChannelServiceNow = "service_now"
+	//AlertingChannelServiceNowFieldServiceNowURL const for the serviceNowUrl field of the ServiceNow alerting channel
+	AlertingChannelServiceNowFieldServiceNowURL = "service_now_url"
+	//AlertingChannelServiceNowFieldUsername const for the username field of the ServiceNow alerting channel
+	AlertingChannelServiceNowFieldUsername = "username"
+	//AlertingChannelServiceNowFieldPassword const for the password field of the ServiceNow alerting channel
+	AlertingChannelServiceNowFieldPassword = "password"
+
 	//AlertingChannelFieldChannelSlack const for schema field of the Slack channel
 	AlertingChannelFieldChannelSlack = "slack"
 	//AlertingChannelSlackFieldWebhookURL const for the webhookUrl field of the Slack alerting channel
@@ -65,6 +80,12 @@ const (
 	AlertingChannelWebhookFieldWebhookURLs = "webhook_urls"
 	//AlertingChannelWebhookFieldHTTPHeaders const for the http headers field of the Webhook alerting channel
 	AlertingChannelWebhookFieldHTTPHeaders = "http_headers"
+	//AlertingChannelHTTPHeaderFieldName const for the name field of the http headers of the webhook based alerting channels
+	AlertingChannelHTTPHeaderFieldName = "name"
+	//AlertingChannelHTTPHeaderFieldValue const for the value field of the http headers of the webhook based alerting channels
+	AlertingChannelHTTPHeaderFieldValue = "value"
+	//AlertingChannelHTTPHeaderFieldSensitive const for the sensitive field of the http headers of the webhook based alerting channels which defines whether the configured value is kept when the Instana API masks the header value
+	AlertingChannelHTTPHeaderFieldSensitive = "sensitive"
 
 	//AlertingChannelFieldChannelOffice365 const for schema field of the Office 365 channel
 	AlertingChannelFieldChannelOffice365 = "office_365"
@@ -72,18 +93,60 @@ const (
 	AlertingChannelFieldChannelGoogleChat = "google_chat"
 	//AlertingChannelWebhookBasedFieldWebhookURL const for the webhookUrl field of the alerting channel
 	AlertingChannelWebhookBasedFieldWebhookURL = "webhook_url"
+
+	//AlertingChannelFieldChannelPrometheusWebhook const for schema field of the Prometheus webhook channel
+	AlertingChannelFieldChannelPrometheusWebhook = "prometheus_webhook"
+	//AlertingChannelPrometheusWebhookFieldReceiver const for the receiver field of the Prometheus webhook alerting channel
+	AlertingChannelPrometheusWebhookFieldReceiver = "receiver"
+	//AlertingChannelFieldChannelWebexTeamsWebhook const for schema field of the Webex Teams webhook channel
+	AlertingChannelFieldChannelWebexTeamsWebhook = "webex_teams_webhook"
+	//AlertingChannelFieldChannelWatsonAIOpsWebhook const for schema field of the Watson AIOps webhook channel
+	AlertingChannelFieldChannelWatsonAIOpsWebhook = "watson_aiops_webhook"
+
+	//AlertingChannelFieldChannelSlackApp const for schema field of the bidirectional Slack app channel
+	AlertingChannelFieldChannelSlackApp = "slack_app"
+	//AlertingChannelFieldChannelMsTeamsApp const for schema field of the bidirectional MS Teams app channel
+	AlertingChannelFieldChannelMsTeamsApp = "ms_teams_app"
+	//AlertingChannelAppFieldTeamID const for the teamId field of the bidirectional app alerting channels
+	AlertingChannelAppFieldTeamID = "team_id"
+	//AlertingChannelAppFieldTeamName const for the teamName field of the bidirectional app alerting channels
+	AlertingChannelAppFieldTeamName = "team_name"
+	//AlertingChannelAppFieldChannelID const for the channelId field of the bidirectional app alerting channels
+	AlertingChannelAppFieldChannelID = "channel_id"
+	//AlertingChannelAppFieldChannelName const for the channelName field of the bidirectional app alerting channels
+	AlertingChannelAppFieldChannelName = "channel_name"
+	//AlertingChannelSlackAppFieldAppID const for the appId field of the bidirectional Slack app alerting channel
+	AlertingChannelSlackAppFieldAppID = "app_id"
+	//AlertingChannelSlackAppFieldEmojiRendering const for the emojiRendering field of the bidirectional Slack app alerting channel
+	AlertingChannelSlackAppFieldEmojiRendering = "emoji_rendering"
+	//AlertingChannelMsTeamsAppFieldAPITokenID const for the apiTokenId field of the bidirectional MS Teams app alerting channel
+	AlertingChannelMsTeamsAppFieldAPITokenID = "api_token_id"
+	//AlertingChannelMsTeamsAppFieldTenantID const for the tenantId field of the bidirectional MS Teams app alerting channel
+	AlertingChannelMsTeamsAppFieldTenantID = "tenant_id"
+	//AlertingChannelMsTeamsAppFieldTenantName const for the tenantName field of the bidirectional MS Teams app alerting channel
+	AlertingChannelMsTeamsAppFieldTenantName = "tenant_name"
+	//AlertingChannelMsTeamsAppFieldServiceURL const for the serviceUrl field of the bidirectional MS Teams app alerting channel
+	AlertingChannelMsTeamsAppFieldServiceURL = "service_url"
+	//AlertingChannelMsTeamsAppFieldInstanaURL const for the instanaUrl field of the bidirectional MS Teams app alerting channel
+	AlertingChannelMsTeamsAppFieldInstanaURL = "instana_url"
 )
 
 var AlertingChannelTypeFields = []string{
 	AlertingChannelFieldChannelEmail,
 	AlertingChannelFieldChannelOpsGenie,
 	AlertingChannelFieldChannelPageDuty,
+	AlertingChannelFieldChannelServiceNow,
 	AlertingChannelFieldChannelSlack,
 	AlertingChannelFieldChannelSplunk,
 	AlertingChannelFieldChannelVictorOps,
 	AlertingChannelFieldChannelWebhook,
 	AlertingChannelFieldChannelOffice365,
 	AlertingChannelFieldChannelGoogleChat,
+	AlertingChannelFieldChannelPrometheusWebhook,
+	AlertingChannelFieldChannelWebexTeamsWebhook,
+	AlertingChannelFieldChannelWatsonAIOpsWebhook,
+	AlertingChannelFieldChannelSlackApp,
+	AlertingChannelFieldChannelMsTeamsApp,
 }
 
 // NewAlertingChannelResourceHandle creates the resource handle for Alerting Channels
@@ -98,6 +161,17 @@ func NewAlertingChannelResourceHandle() ResourceHandle[*restapi.AlertingChannel]
 					Required:    true,
 					Description: "Configures the name of the alerting channel",
 				},
+				AlertingChannelFieldVerifyOnApply: {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Flag to indicate whether a test notification is sent through the alerting channel before it is created or updated. The apply fails when the test notification cannot be delivered",
+				},
+				AlertingChannelFieldSecretVersion: {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "Arbitrary version of the secrets of the alerting channel. Changing the version forces the configured secrets to be sent to Instana again, e.g. after a rotation",
+				},
 				AlertingChannelFieldChannelEmail: {
 					Type:         schema.TypeList,
 					Optional:     true,
@@ -131,6 +205,7 @@ func NewAlertingChannelResourceHandle() ResourceHandle[*restapi.AlertingChannel]
 							AlertingChannelOpsGenieFieldAPIKey: {
 								Type:        schema.TypeString,
 								Required:    true,
+								Sensitive:   true,
 								Description: "The OpsGenie API Key of the OpsGenie alerting channel",
 							},
 							AlertingChannelOpsGenieFieldTags: {
@@ -163,11 +238,40 @@ func NewAlertingChannelResourceHandle() ResourceHandle[*restapi.AlertingChannel]
 							AlertingChannelPagerDutyFieldServiceIntegrationKey: {
 								Type:        schema.TypeString,
 								Required:    true,
+								Sensitive:   true,
 								Description: "The Service Integration Key of the PagerDuty alerting channel",
 							},
 						},
 					},
 				},
+				AlertingChannelFieldChannelServiceNow: {
+					Type:         schema.TypeList,
+					Optional:     true,
+					MinItems:     1,
+					MaxItems:     1,
+					Description:  "The configuration of the ServiceNow webhook channel",
+					ExactlyOneOf: AlertingChannelTypeFields,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							AlertingChannelServiceNowFieldServiceNowURL: {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The URL of the ServiceNow instance of the ServiceNow alerting channel",
+							},
+							AlertingChannelServiceNowFieldUsername: {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The username used to authenticate at the ServiceNow instance",
+							},
+							AlertingChannelServiceNowFieldPassword: {
+								Type:        schema.TypeString,
+								Required:    true,
+								Sensitive:   true,
+								Description: "The password used to authenticate at the ServiceNow instance",
+							},
+						},
+					},
+				},
 				AlertingChannelFieldChannelSlack: {
 					Type:         schema.TypeList,
 					Optional:     true,
@@ -180,6 +284,7 @@ func NewAlertingChannelResourceHandle() ResourceHandle[*restapi.AlertingChannel]
 							AlertingChannelSlackFieldWebhookURL: {
 								Type:        schema.TypeString,
 								Required:    true,
+								Sensitive:   true,
 								Description: "The webhook URL of the Slack alerting channel",
 							},
 							AlertingChannelSlackFieldIconURL: {
@@ -212,6 +317,7 @@ func NewAlertingChannelResourceHandle() ResourceHandle[*restapi.AlertingChannel]
 							AlertingChannelSplunkFieldToken: {
 								Type:        schema.TypeString,
 								Required:    true,
+								Sensitive:   true,
 								Description: "The token of the Splunk alerting channel",
 							},
 						},
@@ -229,6 +335,7 @@ func NewAlertingChannelResourceHandle() ResourceHandle[*restapi.AlertingChannel]
 							AlertingChannelVictorOpsFieldAPIKey: {
 								Type:        schema.TypeString,
 								Required:    true,
+								Sensitive:   true,
 								Description: "The API Key of the VictorOps alerting channel",
 							},
 							AlertingChannelVictorOpsFieldRoutingKey: {
@@ -257,14 +364,7 @@ func NewAlertingChannelResourceHandle() ResourceHandle[*restapi.AlertingChannel]
 								Required:    true,
 								Description: "The list of webhook urls of the Webhook alerting channel",
 							},
-							AlertingChannelWebhookFieldHTTPHeaders: {
-								Type: schema.TypeMap,
-								Elem: &schema.Schema{
-									Type: schema.TypeString,
-								},
-								Optional:    true,
-								Description: "The optional map of HTTP headers of the Webhook alerting channel",
-							},
+							AlertingChannelWebhookFieldHTTPHeaders: alertingChannelHTTPHeadersSchema("Webhook"),
 						},
 					},
 				},
@@ -280,6 +380,7 @@ func NewAlertingChannelResourceHandle() ResourceHandle[*restapi.AlertingChannel]
 							AlertingChannelWebhookBasedFieldWebhookURL: {
 								Type:        schema.TypeString,
 								Required:    true,
+								Sensitive:   true,
 								Description: "The webhook URL of the Office 365 alerting channel",
 							},
 						},
@@ -297,13 +398,206 @@ func NewAlertingChannelResourceHandle() ResourceHandle[*restapi.AlertingChannel]
 							AlertingChannelWebhookBasedFieldWebhookURL: {
 								Type:        schema.TypeString,
 								Required:    true,
+								Sensitive:   true,
 								Description: "The webhook URL of the Google Chat alerting channel",
 							},
 						},
 					},
 				},
+				AlertingChannelFieldChannelPrometheusWebhook: {
+					Type:         schema.TypeList,
+					Optional:     true,
+					MinItems:     1,
+					MaxItems:     1,
+					Description:  "The configuration of the Prometheus webhook channel",
+					ExactlyOneOf: AlertingChannelTypeFields,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							AlertingChannelWebhookBasedFieldWebhookURL: {
+								Type:        schema.TypeString,
+								Required:    true,
+								Sensitive:   true,
+								Description: "The webhook URL of the Prometheus Alertmanager of the Prometheus webhook alerting channel",
+							},
+							AlertingChannelPrometheusWebhookFieldReceiver: {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The name of the Alertmanager receiver of the Prometheus webhook alerting channel",
+							},
+						},
+					},
+				},
+				AlertingChannelFieldChannelWebexTeamsWebhook: {
+					Type:         schema.TypeList,
+					Optional:     true,
+					MinItems:     1,
+					MaxItems:     1,
+					Description:  "The configuration of the Webex Teams webhook channel",
+					ExactlyOneOf: AlertingChannelTypeFields,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							AlertingChannelWebhookBasedFieldWebhookURL: {
+								Type:        schema.TypeString,
+								Required:    true,
+								Sensitive:   true,
+								Description: "The webhook URL of the Webex Teams webhook alerting channel",
+							},
+						},
+					},
+				},
+				AlertingChannelFieldChannelWatsonAIOpsWebhook: {
+					Type:         schema.TypeList,
+					Optional:     true,
+					MinItems:     1,
+					MaxItems:     1,
+					Description:  "The configuration of the Watson AIOps webhook channel",
+					ExactlyOneOf: AlertingChannelTypeFields,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							AlertingChannelWebhookBasedFieldWebhookURL: {
+								Type:        schema.TypeString,
+								Required:    true,
+								Sensitive:   true,
+								Description: "The webhook URL of the Watson AIOps webhook alerting channel",
+							},
+							AlertingChannelWebhookFieldHTTPHeaders: alertingChannelHTTPHeadersSchema("Watson AIOps webhook"),
+						},
+					},
+				},
+				AlertingChannelFieldChannelSlackApp: {
+					Type:         schema.TypeList,
+					Optional:     true,
+					MinItems:     1,
+					MaxItems:     1,
+					Description:  "The configuration of the bidirectional Slack app channel. The channel is provisioned through an interactive OAuth authorization in Instana and can only be imported",
+					ExactlyOneOf: AlertingChannelTypeFields,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							AlertingChannelSlackAppFieldAppID: {
+								Type:        schema.TypeString,
+								Computed:    true,
+								Description: "The ID of the Slack app of the bidirectional Slack app alerting channel",
+							},
+							AlertingChannelAppFieldTeamID: {
+								Type:        schema.TypeString,
+								Computed:    true,
+								Description: "The ID of the Slack team of the bidirectional Slack app alerting channel",
+							},
+							AlertingChannelAppFieldTeamName: {
+								Type:        schema.TypeString,
+								Computed:    true,
+								Description: "The name of the Slack team of the bidirectional Slack app alerting channel",
+							},
+							AlertingChannelAppFieldChannelID: {
+								Type:        schema.TypeString,
+								Computed:    true,
+								Description: "The ID of the Slack channel of the bidirectional Slack app alerting channel",
+							},
+							AlertingChannelAppFieldChannelName: {
+								Type:        schema.TypeString,
+								Computed:    true,
+								Description: "The name of the Slack channel of the bidirectional Slack app alerting channel",
+							},
+							AlertingChannelSlackAppFieldEmojiRendering: {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Default:     false,
+								Description: "Flag to indicate whether emojis are rendered in the messages of the bidirectional Slack app alerting channel",
+							},
+						},
+					},
+				},
+				AlertingChannelFieldChannelMsTeamsApp: {
+					Type:         schema.TypeList,
+					Optional:     true,
+					MinItems:     1,
+					MaxItems:     1,
+					Description:  "The configuration of the bidirectional MS Teams app channel. The channel is provisioned through an interactive OAuth authorization in Instana and can only be imported",
+					ExactlyOneOf: AlertingChannelTypeFields,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							AlertingChannelMsTeamsAppFieldAPITokenID: {
+								Type:        schema.TypeString,
+								Computed:    true,
+								Description: "The ID of the API token of the bidirectional MS Teams app alerting channel",
+							},
+							AlertingChannelAppFieldTeamID: {
+								Type:        schema.TypeString,
+								Computed:    true,
+								Description: "The ID of the MS Teams team of the bidirectional MS Teams app alerting channel",
+							},
+							AlertingChannelAppFieldTeamName: {
+								Type:        schema.TypeString,
+								Computed:    true,
+								Description: "The name of the MS Teams team of the bidirectional MS Teams app alerting channel",
+							},
+							AlertingChannelAppFieldChannelID: {
+								Type:        schema.TypeString,
+								Computed:    true,
+								Description: "The ID of the MS Teams channel of the bidirectional MS Teams app alerting channel",
+							},
+							AlertingChannelAppFieldChannelName: {
+								Type:        schema.TypeString,
+								Computed:    true,
+								Description: "The name of the MS Teams channel of the bidirectional MS Teams app alerting channel",
+							},
+							AlertingChannelMsTeamsAppFieldTenantID: {
+								Type:        schema.TypeString,
+								Computed:    true,
+								Description: "The ID of the Microsoft tenant of the bidirectional MS Teams app alerting channel",
+							},
+							AlertingChannelMsTeamsAppFieldTenantName: {
+								Type:        schema.TypeString,
+								Computed:    true,
+								Description: "The name of the Microsoft tenant of the bidirectional MS Teams app alerting channel",
+							},
+							AlertingChannelMsTeamsAppFieldServiceURL: {
+								Type:        schema.TypeString,
+								Computed:    true,
+								Description: "The service URL of the bot of the bidirectional MS Teams app alerting channel",
+							},
+							AlertingChannelMsTeamsAppFieldInstanaURL: {
+								Type:        schema.TypeString,
+								Computed:    true,
+								Description: "The URL of the Instana instance linked from the messages of the bidirectional MS Teams app alerting channel",
+							},
+						},
+					},
+				},
+			},
+			SchemaVersion: 1,
+		},
+	}
+}
+
+// alertingChannelHTTPHeadersSchema creates the schema of the http headers of the webhook based alerting channels. Headers
+// are modeled as an ordered list so that header values containing colons and duplicate header names are retained.
+func alertingChannelHTTPHeadersSchema(channelName string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: fmt.Sprintf("The optional list of HTTP headers of the %s alerting channel", channelName),
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				AlertingChannelHTTPHeaderFieldName: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+					Description:  "The name of the HTTP header",
+				},
+				AlertingChannelHTTPHeaderFieldValue: {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					Description: "The value of the HTTP header. Header values are always redacted from the plan output",
+				},
+				AlertingChannelHTTPHeaderFieldSensitive: {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Flag to indicate that the Instana API masks the value of the HTTP header. When set, the configured value is kept in the state instead of the masked value returned by the API. Header values are redacted from the plan output independently of this flag",
+				},
 			},
-			SchemaVersion: 0,
 		},
 	}
 }
@@ -317,7 +611,73 @@ func (r *alertingChannelResource) MetaData() *ResourceMetaData {
 }
 
 func (r *alertingChannelResource) StateUpgraders() []schema.StateUpgrader {
-	return []schema.StateUpgrader{}
+	return []schema.StateUpgrader{
+		{
+			Type:    r.schemaV0().CoreConfigSchema().ImpliedType(),
+			Upgrade: r.stateUpgradeV0,
+			Version: 0,
+		},
+	}
+}
+
+// schemaV0 returns the schema of version 0 where the http headers of the webhook based alerting channels were modeled as map
+func (r *alertingChannelResource) schemaV0() *schema.Resource {
+	schemaV0 := make(map[string]*schema.Schema, len(r.metaData.Schema))
+	for key, value := range r.metaData.Schema {
+		schemaV0[key] = value
+	}
+	for _, channelField := range []string{AlertingChannelFieldChannelWebhook, AlertingChannelFieldChannelWatsonAIOpsWebhook} {
+		channelSchema := *schemaV0[channelField]
+		nestedSchema := make(map[string]*schema.Schema)
+		for key, value := range channelSchema.Elem.(*schema.Resource).Schema {
+			nestedSchema[key] = value
+		}
+		nestedSchema[AlertingChannelWebhookFieldHTTPHeaders] = &schema.Schema{
+			Type: schema.TypeMap,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Optional: true,
+		}
+		channelSchema.Elem = &schema.Resource{Schema: nestedSchema}
+		schemaV0[channelField] = &channelSchema
+	}
+	return &schema.Resource{
+		Schema: schemaV0,
+	}
+}
+
+// stateUpgradeV0 converts the http headers of the webhook based alerting channels from a map to a list of headers
+func (r *alertingChannelResource) stateUpgradeV0(_ context.Context, state map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	for _, channelField := range []string{AlertingChannelFieldChannelWebhook, AlertingChannelFieldChannelWatsonAIOpsWebhook} {
+		channels, ok := state[channelField].([]interface{})
+		if !ok || len(channels) == 0 {
+			continue
+		}
+		channelState, ok := channels[0].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		headerMap, ok := channelState[AlertingChannelWebhookFieldHTTPHeaders].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		names := make([]string, 0, len(headerMap))
+		for name := range headerMap {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		headers := make([]interface{}, len(names))
+		for i, name := range names {
+			headers[i] = map[string]interface{}{
+				AlertingChannelHTTPHeaderFieldName:      name,
+				AlertingChannelHTTPHeaderFieldValue:     headerMap[name],
+				AlertingChannelHTTPHeaderFieldSensitive: false,
+			}
+		}
+		channelState[AlertingChannelWebhookFieldHTTPHeaders] = headers
+	}
+	return state, nil
 }
 
 func (r *alertingChannelResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.AlertingChannel] {
@@ -334,10 +694,74 @@ func (r *alertingChannelResource) UpdateState(d *schema.ResourceData, alertingCh
 		return err
 	}
 
+	r.keepConfiguredSecretsWhenMasked(d, data)
+
 	d.SetId(alertingChannel.ID)
 	return tfutils.UpdateState(d, data)
 }
 
+// keepConfiguredSecretsWhenMasked replaces secrets of the alerting channel which are masked or omitted by the Instana API
+// with the value of the current state. Otherwise, the masked value would result in a permanent diff.
+func (r *alertingChannelResource) keepConfiguredSecretsWhenMasked(d *schema.ResourceData, data map[string]interface{}) {
+	for _, channelField := range AlertingChannelTypeFields {
+		channelData, ok := data[channelField]
+		if !ok {
+			continue
+		}
+		channelState := channelData.([]interface{})[0].(map[string]interface{})
+		channelSchema := r.metaData.Schema[channelField].Elem.(*schema.Resource).Schema
+		for key, value := range channelState {
+			if channelSchema[key].Sensitive && r.isMaskedSecret(value) {
+				channelState[key] = d.Get(fmt.Sprintf("%s.0.%s", channelField, key))
+			}
+		}
+		if headers, ok := channelState[AlertingChannelWebhookFieldHTTPHeaders]; ok {
+			r.keepConfiguredHTTPHeaderSecrets(d, channelField, headers.([]interface{}))
+		}
+	}
+}
+
+// keepConfiguredHTTPHeaderSecrets restores the sensitive flag of the http headers, which is not known by the Instana API,
+// from the current state and keeps the configured value of sensitive headers which are masked by the Instana API. Headers
+// are matched by position and name.
+func (r *alertingChannelResource) keepConfiguredHTTPHeaderSecrets(d *schema.ResourceData, channelField string, headers []interface{}) {
+	configuredHeaders, ok := d.Get(fmt.Sprintf("%s.0.%s", channelField, AlertingChannelWebhookFieldHTTPHeaders)).([]interface{})
+	if !ok {
+		return
+	}
+	for i, h := range headers {
+		if i >= len(configuredHeaders) || configuredHeaders[i] == nil {
+			return
+		}
+		header := h.(map[string]interface{})
+		configuredHeader := configuredHeaders[i].(map[string]interface{})
+		if header[AlertingChannelHTTPHeaderFieldName] != configuredHeader[AlertingChannelHTTPHeaderFieldName] {
+			continue
+		}
+		sensitive := configuredHeader[AlertingChannelHTTPHeaderFieldSensitive].(bool)
+		header[AlertingChannelHTTPHeaderFieldSensitive] = sensitive
+		if sensitive && r.isMaskedSecret(header[AlertingChannelHTTPHeaderFieldValue]) {
+			header[AlertingChannelHTTPHeaderFieldValue] = configuredHeader[AlertingChannelHTTPHeaderFieldValue]
+		}
+	}
+}
+
+func (r *alertingChannelResource) isMaskedSecret(value interface{}) bool {
+	var secret string
+	switch v := value.(type) {
+	case *string:
+		if v == nil {
+			return true
+		}
+		secret = *v
+	case string:
+		secret = v
+	default:
+		return false
+	}
+	return len(strings.Trim(secret, "*")) == 0
+}
+
 func (r *alertingChannelResource) mapChannelToState(channel *restapi.AlertingChannel) (map[string]interface{}, error) {
 	if channel.Kind == restapi.EmailChannelType {
 		return r.mapEmailChannelToState(channel), nil
@@ -348,6 +772,9 @@ func (r *alertingChannelResource) mapChannelToState(channel *restapi.AlertingCha
 	if channel.Kind == restapi.PagerDutyChannelType {
 		return r.mapPagerDutyChannelToState(channel), nil
 	}
+	if channel.Kind == restapi.ServiceNowChannelType {
+		return r.mapServiceNowChannelToState(channel), nil
+	}
 	if channel.Kind == restapi.SlackChannelType {
 		return r.mapSlackChannelToState(channel), nil
 	}
@@ -366,6 +793,21 @@ func (r *alertingChannelResource) mapChannelToState(channel *restapi.AlertingCha
 	if channel.Kind == restapi.GoogleChatChannelType {
 		return r.mapGoogleChatChannelToState(channel), nil
 	}
+	if channel.Kind == restapi.PrometheusWebhookChannelType {
+		return r.mapPrometheusWebhookChannelToState(channel), nil
+	}
+	if channel.Kind == restapi.WebexTeamsWebhookChannelType {
+		return r.mapWebexTeamsWebhookChannelToState(channel), nil
+	}
+	if channel.Kind == restapi.WatsonAIOpsWebhookChannelType {
+		return r.mapWatsonAIOpsWebhookChannelToState(channel), nil
+	}
+	if channel.Kind == restapi.SlackAppChannelType {
+		return r.mapSlackAppChannelToState(channel), nil
+	}
+	if channel.Kind == restapi.MsTeamsAppChannelType {
+		return r.mapMsTeamsAppChannelToState(channel), nil
+	}
 	return nil, fmt.Errorf("received unsupported alerting channel of type %s", channel.Kind)
 }
 
@@ -414,6 +856,19 @@ func (r *alertingChannelResource) mapPagerDutyChannelToState(channel *restapi.Al
 	}
 }
 
+func (r *alertingChannelResource) mapServiceNowChannelToState(channel *restapi.AlertingChannel) map[string]interface{} {
+	return map[string]interface{}{
+		AlertingChannelFieldName: channel.Name,
+		AlertingChannelFieldChannelServiceNow: []interface{}{
+			map[string]interface{}{
+				AlertingChannelServiceNowFieldServiceNowURL: channel.ServiceNowURL,
+				AlertingChannelServiceNowFieldUsername:      channel.Username,
+				AlertingChannelServiceNowFieldPassword:      channel.Password,
+			},
+		},
+	}
+}
+
 func (r *alertingChannelResource) mapSlackChannelToState(channel *restapi.AlertingChannel) map[string]interface{} {
 	return map[string]interface{}{
 		AlertingChannelFieldName: channel.Name,
@@ -452,7 +907,7 @@ func (r *alertingChannelResource) mapVictorOpsChannelToState(channel *restapi.Al
 }
 
 func (r *alertingChannelResource) mapWebhookChannelToState(channel *restapi.AlertingChannel) map[string]interface{} {
-	headers := r.createHTTPHeaderMapFromList(channel.Headers)
+	headers := r.createHTTPHeaderStateFromList(channel.Headers)
 	return map[string]interface{}{
 		AlertingChannelFieldName: channel.Name,
 		AlertingChannelFieldChannelWebhook: []interface{}{
@@ -464,14 +919,19 @@ func (r *alertingChannelResource) mapWebhookChannelToState(channel *restapi.Aler
 	}
 }
 
-func (r *alertingChannelResource) createHTTPHeaderMapFromList(headers []string) map[string]interface{} {
-	result := make(map[string]interface{})
-	for _, header := range headers {
-		keyValue := strings.Split(header, ":")
-		if len(keyValue) == 2 {
-			result[strings.TrimSpace(keyValue[0])] = strings.TrimSpace(keyValue[1])
-		} else {
-			result[strings.TrimSpace(keyValue[0])] = ""
+func (r *alertingChannelResource) createHTTPHeaderStateFromList(headers []string) []interface{} {
+	result := make([]interface{}, len(headers))
+	for i, header := range headers {
+		//only the first colon separates the name from the value; the value itself may contain colons
+		nameValue := strings.SplitN(header, ":", 2)
+		value := ""
+		if len(nameValue) == 2 {
+			value = strings.TrimSpace(nameValue[1])
+		}
+		result[i] = map[string]interface{}{
+			AlertingChannelHTTPHeaderFieldName:      strings.TrimSpace(nameValue[0]),
+			AlertingChannelHTTPHeaderFieldValue:     value,
+			AlertingChannelHTTPHeaderFieldSensitive: false,
 		}
 	}
 	return result
@@ -499,7 +959,90 @@ func (r *alertingChannelResource) mapGoogleChatChannelToState(channel *restapi.A
 	}
 }
 
+func (r *alertingChannelResource) mapPrometheusWebhookChannelToState(channel *restapi.AlertingChannel) map[string]interface{} {
+	return map[string]interface{}{
+		AlertingChannelFieldName: channel.Name,
+		AlertingChannelFieldChannelPrometheusWebhook: []interface{}{
+			map[string]interface{}{
+				AlertingChannelWebhookBasedFieldWebhookURL:    channel.WebhookURL,
+				AlertingChannelPrometheusWebhookFieldReceiver: channel.Receiver,
+			},
+		},
+	}
+}
+
+func (r *alertingChannelResource) mapWebexTeamsWebhookChannelToState(channel *restapi.AlertingChannel) map[string]interface{} {
+	return map[string]interface{}{
+		AlertingChannelFieldName: channel.Name,
+		AlertingChannelFieldChannelWebexTeamsWebhook: []interface{}{
+			map[string]interface{}{
+				AlertingChannelWebhookBasedFieldWebhookURL: channel.WebhookURL,
+			},
+		},
+	}
+}
+
+func (r *alertingChannelResource) mapWatsonAIOpsWebhookChannelToState(channel *restapi.AlertingChannel) map[string]interface{} {
+	headers := r.createHTTPHeaderStateFromList(channel.Headers)
+	return map[string]interface{}{
+		AlertingChannelFieldName: channel.Name,
+		AlertingChannelFieldChannelWatsonAIOpsWebhook: []interface{}{
+			map[string]interface{}{
+				AlertingChannelWebhookBasedFieldWebhookURL: channel.WebhookURL,
+				AlertingChannelWebhookFieldHTTPHeaders:     headers,
+			},
+		},
+	}
+}
+
+func (r *alertingChannelResource) mapSlackAppChannelToState(channel *restapi.AlertingChannel) map[string]interface{} {
+	return map[string]interface{}{
+		AlertingChannelFieldName: channel.Name,
+		AlertingChannelFieldChannelSlackApp: []interface{}{
+			map[string]interface{}{
+				AlertingChannelSlackAppFieldAppID:          channel.AppID,
+				AlertingChannelAppFieldTeamID:              channel.TeamID,
+				AlertingChannelAppFieldTeamName:            channel.TeamName,
+				AlertingChannelAppFieldChannelID:           channel.ChannelID,
+				AlertingChannelAppFieldChannelName:         channel.ChannelName,
+				AlertingChannelSlackAppFieldEmojiRendering: channel.EmojiRendering != nil && *channel.EmojiRendering,
+			},
+		},
+	}
+}
+
+func (r *alertingChannelResource) mapMsTeamsAppChannelToState(channel *restapi.AlertingChannel) map[string]interface{} {
+	return map[string]interface{}{
+		AlertingChannelFieldName: channel.Name,
+		AlertingChannelFieldChannelMsTeamsApp: []interface{}{
+			map[string]interface{}{
+				AlertingChannelMsTeamsAppFieldAPITokenID: channel.APITokenID,
+				AlertingChannelAppFieldTeamID:            channel.TeamID,
+				AlertingChannelAppFieldTeamName:          channel.TeamName,
+				AlertingChannelAppFieldChannelID:         channel.ChannelID,
+				AlertingChannelAppFieldChannelName:       channel.ChannelName,
+				AlertingChannelMsTeamsAppFieldTenantID:   channel.TenantID,
+				AlertingChannelMsTeamsAppFieldTenantName: channel.TenantName,
+				AlertingChannelMsTeamsAppFieldServiceURL: channel.ServiceURL,
+				AlertingChannelMsTeamsAppFieldInstanaURL: channel.InstanaURL,
+			},
+		},
+	}
+}
+
 func (r *alertingChannelResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.AlertingChannel, error) {
+	channel, err := r.mapStateToChannel(d)
+	if err != nil {
+		return nil, err
+	}
+	if d.IsNewResource() && (channel.Kind == restapi.SlackAppChannelType || channel.Kind == restapi.MsTeamsAppChannelType) {
+		return nil, fmt.Errorf("alerting channels of type %s require an interactive OAuth authorization and cannot be created by terraform; create the channel in Instana and import it", channel.Kind)
+	}
+	channel.VerifyOnApply = d.Get(AlertingChannelFieldVerifyOnApply).(bool)
+	return channel, nil
+}
+
+func (r *alertingChannelResource) mapStateToChannel(d *schema.ResourceData) (*restapi.AlertingChannel, error) {
 	if channel, ok := d.GetOk(AlertingChannelFieldChannelEmail); ok && len(channel.([]interface{})) == 1 {
 		return r.mapStateToEmailObject(d, channel.([]interface{})[0].(map[string]interface{})), nil
 	}
@@ -509,6 +1052,9 @@ func (r *alertingChannelResource) MapStateToDataObject(d *schema.ResourceData) (
 	if channel, ok := d.GetOk(AlertingChannelFieldChannelPageDuty); ok && len(channel.([]interface{})) == 1 {
 		return r.mapStateToPagerDutyObject(d, channel.([]interface{})[0].(map[string]interface{})), nil
 	}
+	if channel, ok := d.GetOk(AlertingChannelFieldChannelServiceNow); ok && len(channel.([]interface{})) == 1 {
+		return r.mapStateToServiceNowObject(d, channel.([]interface{})[0].(map[string]interface{})), nil
+	}
 	if channel, ok := d.GetOk(AlertingChannelFieldChannelSlack); ok && len(channel.([]interface{})) == 1 {
 		return r.mapStateToSlackObject(d, channel.([]interface{})[0].(map[string]interface{})), nil
 	}
@@ -527,6 +1073,21 @@ func (r *alertingChannelResource) MapStateToDataObject(d *schema.ResourceData) (
 	if channel, ok := d.GetOk(AlertingChannelFieldChannelGoogleChat); ok && len(channel.([]interface{})) == 1 {
 		return r.mapStateToWebhookBasedObject(restapi.GoogleChatChannelType, d, channel.([]interface{})[0].(map[string]interface{})), nil
 	}
+	if channel, ok := d.GetOk(AlertingChannelFieldChannelPrometheusWebhook); ok && len(channel.([]interface{})) == 1 {
+		return r.mapStateToPrometheusWebhookObject(d, channel.([]interface{})[0].(map[string]interface{})), nil
+	}
+	if channel, ok := d.GetOk(AlertingChannelFieldChannelWebexTeamsWebhook); ok && len(channel.([]interface{})) == 1 {
+		return r.mapStateToWebhookBasedObject(restapi.WebexTeamsWebhookChannelType, d, channel.([]interface{})[0].(map[string]interface{})), nil
+	}
+	if channel, ok := d.GetOk(AlertingChannelFieldChannelWatsonAIOpsWebhook); ok && len(channel.([]interface{})) == 1 {
+		return r.mapStateToWatsonAIOpsWebhookObject(d, channel.([]interface{})[0].(map[string]interface{})), nil
+	}
+	if channel, ok := d.GetOk(AlertingChannelFieldChannelSlackApp); ok && len(channel.([]interface{})) == 1 {
+		return r.mapStateToSlackAppObject(d, r.getAppChannelState(channel)), nil
+	}
+	if channel, ok := d.GetOk(AlertingChannelFieldChannelMsTeamsApp); ok && len(channel.([]interface{})) == 1 {
+		return r.mapStateToMsTeamsAppObject(d, r.getAppChannelState(channel)), nil
+	}
 	return nil, fmt.Errorf("no supported alerting channel defined")
 }
 
@@ -564,6 +1125,20 @@ func (r *alertingChannelResource) mapStateToPagerDutyObject(d *schema.ResourceDa
 	}
 }
 
+func (r *alertingChannelResource) mapStateToServiceNowObject(d *schema.ResourceData, channelState map[string]interface{}) *restapi.AlertingChannel {
+	serviceNowURL := channelState[AlertingChannelServiceNowFieldServiceNowURL].(string)
+	username := channelState[AlertingChannelServiceNowFieldUsername].(string)
+	password := channelState[AlertingChannelServiceNowFieldPassword].(string)
+	return &restapi.AlertingChannel{
+		ID:            d.Id(),
+		Name:          d.Get(AlertingChannelFieldName).(string),
+		Kind:          restapi.ServiceNowChannelType,
+		ServiceNowURL: &serviceNowURL,
+		Username:      &username,
+		Password:      &password,
+	}
+}
+
 func (r *alertingChannelResource) mapStateToSlackObject(d *schema.ResourceData, channelState map[string]interface{}) *restapi.AlertingChannel {
 	webhookURL := channelState[AlertingChannelSlackFieldWebhookURL].(string)
 	iconURL := channelState[AlertingChannelSlackFieldIconURL].(string)
@@ -603,7 +1178,7 @@ func (r *alertingChannelResource) mapStateToVictorOpsObject(d *schema.ResourceDa
 }
 
 func (r *alertingChannelResource) mapStateToWebhookObject(d *schema.ResourceData, channelState map[string]interface{}) *restapi.AlertingChannel {
-	headers := r.createHTTPHeaderListFromMap(channelState)
+	headers := r.createHTTPHeaderListFromState(channelState)
 	return &restapi.AlertingChannel{
 		ID:          d.Id(),
 		Name:        d.Get(AlertingChannelFieldName).(string),
@@ -613,17 +1188,17 @@ func (r *alertingChannelResource) mapStateToWebhookObject(d *schema.ResourceData
 	}
 }
 
-func (r *alertingChannelResource) createHTTPHeaderListFromMap(channelState map[string]interface{}) []string {
-	if attr, ok := channelState[AlertingChannelWebhookFieldHTTPHeaders]; ok {
-		headerMap := attr.(map[string]interface{})
-		result := make([]string, len(headerMap))
-		i := 0
-		for key, value := range headerMap {
-			header := fmt.Sprintf("%s: %s", key, value)
-			result[i] = header
-			i++
+func (r *alertingChannelResource) createHTTPHeaderListFromState(channelState map[string]interface{}) []string {
+	if attr, ok := channelState[AlertingChannelWebhookFieldHTTPHeaders]; ok && attr != nil {
+		headers := attr.([]interface{})
+		result := make([]string, 0, len(headers))
+		for _, h := range headers {
+			if h == nil {
+				continue
+			}
+			header := h.(map[string]interface{})
+			result = append(result, fmt.Sprintf("%s: %s", header[AlertingChannelHTTPHeaderFieldName], header[AlertingChannelHTTPHeaderFieldValue]))
 		}
-
 		return result
 	}
 	return []string{}
@@ -638,3 +1213,82 @@ func (r *alertingChannelResource) mapStateToWebhookBasedObject(channelType resta
 		WebhookURL: &webhookURL,
 	}
 }
+
+func (r *alertingChannelResource) mapStateToPrometheusWebhookObject(d *schema.ResourceData, channelState map[string]interface{}) *restapi.AlertingChannel {
+	webhookURL := channelState[AlertingChannelWebhookBasedFieldWebhookURL].(string)
+	var receiver *string
+	if value, ok := channelState[AlertingChannelPrometheusWebhookFieldReceiver]; ok && len(value.(string)) > 0 {
+		v := value.(string)
+		receiver = &v
+	}
+	return &restapi.AlertingChannel{
+		ID:         d.Id(),
+		Name:       d.Get(AlertingChannelFieldName).(string),
+		Kind:       restapi.PrometheusWebhookChannelType,
+		WebhookURL: &webhookURL,
+		Receiver:   receiver,
+	}
+}
+
+func (r *alertingChannelResource) mapStateToWatsonAIOpsWebhookObject(d *schema.ResourceData, channelState map[string]interface{}) *restapi.AlertingChannel {
+	webhookURL := channelState[AlertingChannelWebhookBasedFieldWebhookURL].(string)
+	return &restapi.AlertingChannel{
+		ID:         d.Id(),
+		Name:       d.Get(AlertingChannelFieldName).(string),
+		Kind:       restapi.WatsonAIOpsWebhookChannelType,
+		WebhookURL: &webhookURL,
+		Headers:    r.createHTTPHeaderListFromState(channelState),
+	}
+}
+
+// getAppChannelState returns the state of the bidirectional app channels. The block of these channels may be configured
+// without any attribute as all attributes are provisioned through the OAuth authorization in Instana.
+func (r *alertingChannelResource) getAppChannelState(channel interface{}) map[string]interface{} {
+	if channelState, ok := channel.([]interface{})[0].(map[string]interface{}); ok {
+		return channelState
+	}
+	return map[string]interface{}{}
+}
+
+func (r *alertingChannelResource) mapStateToSlackAppObject(d *schema.ResourceData, channelState map[string]interface{}) *restapi.AlertingChannel {
+	emojiRendering := false
+	if value, ok := channelState[AlertingChannelSlackAppFieldEmojiRendering]; ok {
+		emojiRendering = value.(bool)
+	}
+	return &restapi.AlertingChannel{
+		ID:             d.Id(),
+		Name:           d.Get(AlertingChannelFieldName).(string),
+		Kind:           restapi.SlackAppChannelType,
+		AppID:          r.readOptionalStringFromChannelState(channelState, AlertingChannelSlackAppFieldAppID),
+		TeamID:         r.readOptionalStringFromChannelState(channelState, AlertingChannelAppFieldTeamID),
+		TeamName:       r.readOptionalStringFromChannelState(channelState, AlertingChannelAppFieldTeamName),
+		ChannelID:      r.readOptionalStringFromChannelState(channelState, AlertingChannelAppFieldChannelID),
+		ChannelName:    r.readOptionalStringFromChannelState(channelState, AlertingChannelAppFieldChannelName),
+		EmojiRendering: &emojiRendering,
+	}
+}
+
+func (r *alertingChannelResource) mapStateToMsTeamsAppObject(d *schema.ResourceData, channelState map[string]interface{}) *restapi.AlertingChannel {
+	return &restapi.AlertingChannel{
+		ID:          d.Id(),
+		Name:        d.Get(AlertingChannelFieldName).(string),
+		Kind:        restapi.MsTeamsAppChannelType,
+		APITokenID:  r.readOptionalStringFromChannelState(channelState, AlertingChannelMsTeamsAppFieldAPITokenID),
+		TeamID:      r.readOptionalStringFromChannelState(channelState, AlertingChannelAppFieldTeamID),
+		TeamName:    r.readOptionalStringFromChannelState(channelState, AlertingChannelAppFieldTeamName),
+		ChannelID:   r.readOptionalStringFromChannelState(channelState, AlertingChannelAppFieldChannelID),
+		ChannelName: r.readOptionalStringFromChannelState(channelState, AlertingChannelAppFieldChannelName),
+		TenantID:    r.readOptionalStringFromChannelState(channelState, AlertingChannelMsTeamsAppFieldTenantID),
+		TenantName:  r.readOptionalStringFromChannelState(channelState, AlertingChannelMsTeamsAppFieldTenantName),
+		ServiceURL:  r.readOptionalStringFromChannelState(channelState, AlertingChannelMsTeamsAppFieldServiceURL),
+		InstanaURL:  r.readOptionalStringFromChannelState(channelState, AlertingChannelMsTeamsAppFieldInstanaURL),
+	}
+}
+
+func (r *alertingChannelResource) readOptionalStringFromChannelState(channelState map[string]interface{}, key string) *string {
+	if value, ok := channelState[key]; ok && len(value.(string)) > 0 {
+		v := value.(string)
+		return &v
+	}
+	return nil
+}
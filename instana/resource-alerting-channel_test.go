@@ -11,6 +11,7 @@ import (
 
 	. "github.com/gessnerfl/terraform-provider-instana/instana"
 	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
 )
 
 func TestAlertingChannelResource(t *testing.T) {
@@ -18,29 +19,42 @@ func TestAlertingChannelResource(t *testing.T) {
 	t.Run("CRUD integration test of with Email Channel", alertingChannelEmailIntegrationTest().testCrud)
 	t.Run("CRUD integration test of with OpsGenie Channel", alertingChannelOpsGenieIntegrationTest().testCrud)
 	t.Run("CRUD integration test of with PagerDuty Channel", alertingChannelPagerDutyIntegrationTest().testCrud)
+	t.Run("CRUD integration test of with ServiceNow Channel", alertingChannelServiceNowIntegrationTest().testCrud)
 	t.Run("CRUD integration test of with Slack Channel", alertingChannelSlackIntegrationTest().testCrud)
 	t.Run("CRUD integration test of with Splunk Channel", alertingChannelSplunkIntegrationTest().testCrud)
 	t.Run("CRUD integration test of with VictorOps Channel", alertingChannelVictorOpsIntegrationTest().testCrud)
 	t.Run("CRUD integration test of with Webhook Channel", alertingChannelWebhookIntegrationTest().testCrud)
 	t.Run("CRUD integration test of with Office 365 Channel", alertingChannelOffice365IntegrationTest().testCrud)
 	t.Run("CRUD integration test of with Google Chat Channel", alertingChannelGoogleChatIntegrationTest().testCrud)
+	t.Run("CRUD integration test of with Prometheus Webhook Channel", alertingChannelPrometheusWebhookIntegrationTest().testCrud)
+	t.Run("CRUD integration test of with Webex Teams Webhook Channel", alertingChannelWebexTeamsWebhookIntegrationTest().testCrud)
+	t.Run("CRUD integration test of with Watson AIOps Webhook Channel", alertingChannelWatsonAIOpsWebhookIntegrationTest().testCrud)
 	t.Run("schema should be valid", unitTest.schemaShouldBeValid)
-	t.Run("should have schema version 0", unitTest.shouldHaveSchemaVersion0)
-	t.Run("should have no state upgrader", unitTest.shouldHaveNoStateUpgraders)
+	t.Run("should have schema version 1", unitTest.shouldHaveSchemaVersion1)
+	t.Run("should have one state upgrader", unitTest.shouldHaveOneStateUpgrader)
+	t.Run("should migrate http headers from map to list when executing first state upgrader", unitTest.shouldMigrateHTTPHeadersFromMapToListWhenExecutingFirstStateUpgrader)
+	t.Run("should do nothing when executing first state upgrader and no http headers are defined", unitTest.shouldDoNothingWhenExecutingFirstStateUpgraderAndNoHTTPHeadersAreDefined)
 	t.Run("should have correct resource name", unitTest.shouldHaveCorrectResourceName)
 	t.Run("should map email channel to state", unitTest.shouldMapEmailChannelToState)
 	t.Run("should map OpsGenie channel to state", unitTest.shouldMapOpsGenieChannelToState)
 	t.Run("should map PagerDuty channel to state", unitTest.shouldMapPagerDutyChannelToState)
+	t.Run("should map ServiceNow channel to state", unitTest.shouldMapServiceNowChannelToState)
 	t.Run("should map Slack channel to state", unitTest.shouldMapSlackChannelToState)
 	t.Run("should map Splunk channel to state", unitTest.shouldMapSplunkChannelToState)
 	t.Run("should map VictorOps channel to state", unitTest.shouldMapVictorOpsChannelToState)
 	t.Run("should map Webhook channel to state", unitTest.shouldMapWebhookChannelToState)
 	t.Run("should map Office 365 channel to state", unitTest.shouldMapOffice365ChannelToState)
 	t.Run("should map Google Chat channel to state", unitTest.shouldMapGoogleChatChannelToState)
+	t.Run("should map Prometheus Webhook channel to state", unitTest.shouldMapPrometheusWebhookChannelToState)
+	t.Run("should map Webex Teams Webhook channel to state", unitTest.shouldMapWebexTeamsWebhookChannelToState)
+	t.Run("should map Watson AIOps Webhook channel to state", unitTest.shouldMapWatsonAIOpsWebhookChannelToState)
+	t.Run("should map Slack App channel to state", unitTest.shouldMapSlackAppChannelToState)
+	t.Run("should map MS Teams App channel to state", unitTest.shouldMapMsTeamsAppChannelToState)
 	t.Run("should fail to map when channel type is not valid", unitTest.shouldFailToMapChannelWhenTypeIsNotValid)
 	t.Run("should map state of Email channel to data model", unitTest.shouldMapStateOfEmailChannelToDataModel)
 	t.Run("should map state of OpsGenie channel to data model", unitTest.shouldMapStateOfOpsGenieChannelToDataModel)
 	t.Run("should map state of PagerDuty channel to data model", unitTest.shouldMapStateOfPagerDutyChannelToDataModel)
+	t.Run("should map state of ServiceNow channel to data model", unitTest.shouldMapStateOfServiceNowChannelToDataModel)
 	t.Run("should map state of Slack channel to data model", unitTest.shouldMapStateOfSlackChannelToDataModel)
 	t.Run("should map state of Splunk channel to data model", unitTest.shouldMapStateOfSplunkChannelToDataModel)
 	t.Run("should map state of VictorOps channel to data model", unitTest.shouldMapStateOfVictorOpsChannelToDataModel)
@@ -48,7 +62,21 @@ func TestAlertingChannelResource(t *testing.T) {
 	t.Run("should map state of Webhook channel with headers to data model", unitTest.shouldMapStateOfWebhookChannelWithHeadersToDataModel)
 	t.Run("should map state of Office 365 channel to data model", unitTest.shouldMapStateOfOffice365ChannelToDataModel)
 	t.Run("should map state of Google Chat channel to data model", unitTest.shouldMapStateOfGoogleChatChannelToDataModel)
+	t.Run("should map state of Prometheus Webhook channel to data model", unitTest.shouldMapStateOfPrometheusWebhookChannelToDataModel)
+	t.Run("should map state of Prometheus Webhook channel without receiver to data model", unitTest.shouldMapStateOfPrometheusWebhookChannelWithoutReceiverToDataModel)
+	t.Run("should map state of Webex Teams Webhook channel to data model", unitTest.shouldMapStateOfWebexTeamsWebhookChannelToDataModel)
+	t.Run("should map state of Watson AIOps Webhook channel to data model", unitTest.shouldMapStateOfWatsonAIOpsWebhookChannelToDataModel)
+	t.Run("should map state of Slack App channel to data model", unitTest.shouldMapStateOfSlackAppChannelToDataModel)
+	t.Run("should map state of MS Teams App channel to data model", unitTest.shouldMapStateOfMsTeamsAppChannelToDataModel)
+	t.Run("should fail to map state of new Slack App channel to data model", unitTest.shouldFailToMapStateOfNewSlackAppChannelToDataModel)
+	t.Run("should fail to map state of new MS Teams App channel to data model", unitTest.shouldFailToMapStateOfNewMsTeamsAppChannelToDataModel)
 	t.Run("should fail to map state when no channel is provided", unitTest.shouldFailToMapStateWhenNoChannelIsProvided)
+	t.Run("should map verify on apply flag to data model", unitTest.shouldMapVerifyOnApplyFlagToDataModel)
+	t.Run("should keep configured secret when secret is masked by the API", unitTest.shouldKeepConfiguredSecretWhenSecretIsMaskedByTheAPI)
+	t.Run("should keep configured secret when secret is omitted by the API", unitTest.shouldKeepConfiguredSecretWhenSecretIsOmittedByTheAPI)
+	t.Run("should update secret when secret is provided by the API", unitTest.shouldUpdateSecretWhenSecretIsProvidedByTheAPI)
+	t.Run("should keep configured value of sensitive http header when value is masked by the API", unitTest.shouldKeepConfiguredValueOfSensitiveHTTPHeaderWhenValueIsMaskedByTheAPI)
+	t.Run("should update value of sensitive http header when value is provided by the API", unitTest.shouldUpdateValueOfSensitiveHTTPHeaderWhenValueIsProvidedByTheAPI)
 }
 
 const (
@@ -143,6 +171,39 @@ resource "instana_alerting_channel" "example" {
 	)
 }
 
+func alertingChannelServiceNowIntegrationTest() *alertingChannelIntegrationTest {
+	resourceTemplate := `
+resource "instana_alerting_channel" "example" {
+  name = "name %d"
+  service_now {
+    service_now_url = "service-now-url"
+    username = "username"
+    password = "password"
+  }
+}`
+
+	httpServerResponseTemplate := `
+{
+	"id": "%s",
+	"name": "name %d",
+	"kind": "SERVICE_NOW_WEBHOOK",
+	"serviceNowUrl": "service-now-url",
+	"username": "username",
+	"password": "password"
+}`
+
+	return newAlertingChannelIntegrationTest(
+		resourceTemplate,
+		alertingChannelTestResourceName,
+		httpServerResponseTemplate,
+		[]resource.TestCheckFunc{
+			resource.TestCheckResourceAttr(alertingChannelTestResourceName, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelServiceNow, AlertingChannelServiceNowFieldServiceNowURL), "service-now-url"),
+			resource.TestCheckResourceAttr(alertingChannelTestResourceName, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelServiceNow, AlertingChannelServiceNowFieldUsername), "username"),
+			resource.TestCheckResourceAttr(alertingChannelTestResourceName, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelServiceNow, AlertingChannelServiceNowFieldPassword), "password"),
+		},
+	)
+}
+
 func alertingChannelSlackIntegrationTest() *alertingChannelIntegrationTest {
 	resourceTemplate := `
 resource "instana_alerting_channel" "example" {
@@ -242,10 +303,15 @@ resource "instana_alerting_channel" "example" {
   name = "name %d"
   webhook {
     webhook_urls = [ "url1", "url2" ]
-	http_headers = {
-		  key1 = "value1"
-		  key2 = "value2"
-	}
+    http_headers {
+      name  = "key1"
+      value = "value1"
+    }
+    http_headers {
+      name      = "Authorization"
+      value     = "Bearer token:with:colons"
+      sensitive = true
+    }
   }
 }`
 
@@ -255,7 +321,7 @@ resource "instana_alerting_channel" "example" {
 	"name": "name %d",
 	"kind": "WEB_HOOK",
 	"webhookUrls": [ "url1", "url2" ],
-	"headers": [ "key1: value1", "key2: value2" ]
+	"headers": [ "key1: value1", "Authorization: Bearer token:with:colons" ]
 }`
 
 	return newAlertingChannelIntegrationTest(
@@ -265,8 +331,12 @@ resource "instana_alerting_channel" "example" {
 		[]resource.TestCheckFunc{
 			resource.TestCheckResourceAttr(alertingChannelTestResourceName, fmt.Sprintf("%s.%d", fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelWebhook, AlertingChannelWebhookFieldWebhookURLs), 0), "url1"),
 			resource.TestCheckResourceAttr(alertingChannelTestResourceName, fmt.Sprintf("%s.%d", fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelWebhook, AlertingChannelWebhookFieldWebhookURLs), 1), "url2"),
-			resource.TestCheckResourceAttr(alertingChannelTestResourceName, fmt.Sprintf("%s.%s", fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelWebhook, AlertingChannelWebhookFieldHTTPHeaders), "key1"), "value1"),
-			resource.TestCheckResourceAttr(alertingChannelTestResourceName, fmt.Sprintf("%s.%s", fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelWebhook, AlertingChannelWebhookFieldHTTPHeaders), "key2"), "value2"),
+			resource.TestCheckResourceAttr(alertingChannelTestResourceName, fmt.Sprintf("%s.%d.%s", fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelWebhook, AlertingChannelWebhookFieldHTTPHeaders), 0, AlertingChannelHTTPHeaderFieldName), "key1"),
+			resource.TestCheckResourceAttr(alertingChannelTestResourceName, fmt.Sprintf("%s.%d.%s", fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelWebhook, AlertingChannelWebhookFieldHTTPHeaders), 0, AlertingChannelHTTPHeaderFieldValue), "value1"),
+			resource.TestCheckResourceAttr(alertingChannelTestResourceName, fmt.Sprintf("%s.%d.%s", fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelWebhook, AlertingChannelWebhookFieldHTTPHeaders), 0, AlertingChannelHTTPHeaderFieldSensitive), falseAsString),
+			resource.TestCheckResourceAttr(alertingChannelTestResourceName, fmt.Sprintf("%s.%d.%s", fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelWebhook, AlertingChannelWebhookFieldHTTPHeaders), 1, AlertingChannelHTTPHeaderFieldName), "Authorization"),
+			resource.TestCheckResourceAttr(alertingChannelTestResourceName, fmt.Sprintf("%s.%d.%s", fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelWebhook, AlertingChannelWebhookFieldHTTPHeaders), 1, AlertingChannelHTTPHeaderFieldValue), "Bearer token:with:colons"),
+			resource.TestCheckResourceAttr(alertingChannelTestResourceName, fmt.Sprintf("%s.%d.%s", fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelWebhook, AlertingChannelWebhookFieldHTTPHeaders), 1, AlertingChannelHTTPHeaderFieldSensitive), trueAsString),
 		},
 	)
 }
@@ -325,6 +395,97 @@ resource "instana_alerting_channel" "example" {
 	)
 }
 
+func alertingChannelPrometheusWebhookIntegrationTest() *alertingChannelIntegrationTest {
+	resourceTemplate := `
+resource "instana_alerting_channel" "example" {
+  name = "name %d"
+  prometheus_webhook {
+    webhook_url = "webhook-url"
+    receiver = "receiver"
+  }
+}`
+
+	httpServerResponseTemplate := `
+{
+	"id": "%s",
+	"name": "name %d",
+	"kind": "PROMETHEUS_WEBHOOK",
+	"webhookUrl": "webhook-url",
+	"receiver": "receiver"
+}`
+
+	return newAlertingChannelIntegrationTest(
+		resourceTemplate,
+		alertingChannelTestResourceName,
+		httpServerResponseTemplate,
+		[]resource.TestCheckFunc{
+			resource.TestCheckResourceAttr(alertingChannelTestResourceName, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelPrometheusWebhook, AlertingChannelWebhookBasedFieldWebhookURL), "webhook-url"),
+			resource.TestCheckResourceAttr(alertingChannelTestResourceName, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelPrometheusWebhook, AlertingChannelPrometheusWebhookFieldReceiver), "receiver"),
+		},
+	)
+}
+
+func alertingChannelWebexTeamsWebhookIntegrationTest() *alertingChannelIntegrationTest {
+	resourceTemplate := `
+resource "instana_alerting_channel" "example" {
+  name = "name %d"
+  webex_teams_webhook {
+    webhook_url = "webhook-url"
+  }
+}`
+
+	httpServerResponseTemplate := `
+{
+	"id": "%s",
+	"name": "name %d",
+	"kind": "WEBEX_TEAMS_WEBHOOK",
+	"webhookUrl": "webhook-url"
+}`
+
+	return newAlertingChannelIntegrationTest(
+		resourceTemplate,
+		alertingChannelTestResourceName,
+		httpServerResponseTemplate,
+		[]resource.TestCheckFunc{
+			resource.TestCheckResourceAttr(alertingChannelTestResourceName, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelWebexTeamsWebhook, AlertingChannelWebhookBasedFieldWebhookURL), "webhook-url"),
+		},
+	)
+}
+
+func alertingChannelWatsonAIOpsWebhookIntegrationTest() *alertingChannelIntegrationTest {
+	resourceTemplate := `
+resource "instana_alerting_channel" "example" {
+  name = "name %d"
+  watson_aiops_webhook {
+    webhook_url = "webhook-url"
+    http_headers {
+      name  = "key1"
+      value = "value1"
+    }
+  }
+}`
+
+	httpServerResponseTemplate := `
+{
+	"id": "%s",
+	"name": "name %d",
+	"kind": "WATSON_AIOPS_WEBHOOK",
+	"webhookUrl": "webhook-url",
+	"headers": [ "key1: value1" ]
+}`
+
+	return newAlertingChannelIntegrationTest(
+		resourceTemplate,
+		alertingChannelTestResourceName,
+		httpServerResponseTemplate,
+		[]resource.TestCheckFunc{
+			resource.TestCheckResourceAttr(alertingChannelTestResourceName, fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelWatsonAIOpsWebhook, AlertingChannelWebhookBasedFieldWebhookURL), "webhook-url"),
+			resource.TestCheckResourceAttr(alertingChannelTestResourceName, fmt.Sprintf("%s.%d.%s", fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelWatsonAIOpsWebhook, AlertingChannelWebhookFieldHTTPHeaders), 0, AlertingChannelHTTPHeaderFieldName), "key1"),
+			resource.TestCheckResourceAttr(alertingChannelTestResourceName, fmt.Sprintf("%s.%d.%s", fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelWatsonAIOpsWebhook, AlertingChannelWebhookFieldHTTPHeaders), 0, AlertingChannelHTTPHeaderFieldValue), "value1"),
+		},
+	)
+}
+
 func newAlertingChannelIntegrationTest(resourceTemplate string, resourceName string, serverResponseTemplate string, useCaseSpecificChecks []resource.TestCheckFunc) *alertingChannelIntegrationTest {
 	return &alertingChannelIntegrationTest{
 		resourceTemplate:       resourceTemplate,
@@ -378,28 +539,42 @@ func (r *alertingChannelUnitTest) schemaShouldBeValid(t *testing.T) {
 	schemaData := NewAlertingChannelResourceHandle().MetaData().Schema
 
 	schemaAssert := testutils.NewTerraformSchemaAssert(schemaData, t)
-	require.Len(t, schemaData, 10)
+	require.Len(t, schemaData, 18)
 	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelFieldName)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(AlertingChannelFieldVerifyOnApply, false)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeInt(AlertingChannelFieldSecretVersion)
 
 	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(AlertingChannelFieldChannelEmail)
 	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(AlertingChannelFieldChannelOpsGenie)
 	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(AlertingChannelFieldChannelPageDuty)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(AlertingChannelFieldChannelServiceNow)
 	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(AlertingChannelFieldChannelSlack)
 	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(AlertingChannelFieldChannelSplunk)
 	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(AlertingChannelFieldChannelVictorOps)
 	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(AlertingChannelFieldChannelWebhook)
 	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(AlertingChannelFieldChannelOffice365)
 	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(AlertingChannelFieldChannelGoogleChat)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(AlertingChannelFieldChannelPrometheusWebhook)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(AlertingChannelFieldChannelWebexTeamsWebhook)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(AlertingChannelFieldChannelWatsonAIOpsWebhook)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(AlertingChannelFieldChannelSlackApp)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(AlertingChannelFieldChannelMsTeamsApp)
 
 	r.validateEmailChannelSchema(t, schemaData[AlertingChannelFieldChannelEmail].Elem.(*schema.Resource).Schema)
 	r.validateOpsGenieChannelSchema(t, schemaData[AlertingChannelFieldChannelOpsGenie].Elem.(*schema.Resource).Schema)
 	r.validatePagerDutyChannelSchema(t, schemaData[AlertingChannelFieldChannelPageDuty].Elem.(*schema.Resource).Schema)
+	r.validateServiceNowChannelSchema(t, schemaData[AlertingChannelFieldChannelServiceNow].Elem.(*schema.Resource).Schema)
 	r.validateSlackChannelSchema(t, schemaData[AlertingChannelFieldChannelSlack].Elem.(*schema.Resource).Schema)
 	r.validateSplunkChannelSchema(t, schemaData[AlertingChannelFieldChannelSplunk].Elem.(*schema.Resource).Schema)
 	r.validateVictorOpsChannelSchema(t, schemaData[AlertingChannelFieldChannelVictorOps].Elem.(*schema.Resource).Schema)
 	r.validateWebhookChannelSchema(t, schemaData[AlertingChannelFieldChannelWebhook].Elem.(*schema.Resource).Schema)
 	r.validateWebhookBasedChannelSchema(t, schemaData[AlertingChannelFieldChannelOffice365].Elem.(*schema.Resource).Schema)
 	r.validateWebhookBasedChannelSchema(t, schemaData[AlertingChannelFieldChannelGoogleChat].Elem.(*schema.Resource).Schema)
+	r.validatePrometheusWebhookChannelSchema(t, schemaData[AlertingChannelFieldChannelPrometheusWebhook].Elem.(*schema.Resource).Schema)
+	r.validateWebhookBasedChannelSchema(t, schemaData[AlertingChannelFieldChannelWebexTeamsWebhook].Elem.(*schema.Resource).Schema)
+	r.validateWatsonAIOpsWebhookChannelSchema(t, schemaData[AlertingChannelFieldChannelWatsonAIOpsWebhook].Elem.(*schema.Resource).Schema)
+	r.validateSlackAppChannelSchema(t, schemaData[AlertingChannelFieldChannelSlackApp].Elem.(*schema.Resource).Schema)
+	r.validateMsTeamsAppChannelSchema(t, schemaData[AlertingChannelFieldChannelMsTeamsApp].Elem.(*schema.Resource).Schema)
 }
 
 func (r *alertingChannelUnitTest) validateEmailChannelSchema(t *testing.T, channelSchema map[string]*schema.Schema) {
@@ -412,6 +587,7 @@ func (r *alertingChannelUnitTest) validateOpsGenieChannelSchema(t *testing.T, ch
 	require.Len(t, channelSchema, 3)
 	schemaAssert := testutils.NewTerraformSchemaAssert(channelSchema, t)
 	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelOpsGenieFieldAPIKey)
+	require.True(t, channelSchema[AlertingChannelOpsGenieFieldAPIKey].Sensitive)
 	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelOpsGenieFieldRegion)
 	schemaAssert.AssertSchemaIsRequiredAndOfTypeListOfStrings(AlertingChannelOpsGenieFieldTags)
 }
@@ -420,12 +596,23 @@ func (r *alertingChannelUnitTest) validatePagerDutyChannelSchema(t *testing.T, c
 	require.Len(t, channelSchema, 1)
 	schemaAssert := testutils.NewTerraformSchemaAssert(channelSchema, t)
 	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelPagerDutyFieldServiceIntegrationKey)
+	require.True(t, channelSchema[AlertingChannelPagerDutyFieldServiceIntegrationKey].Sensitive)
+}
+
+func (r *alertingChannelUnitTest) validateServiceNowChannelSchema(t *testing.T, channelSchema map[string]*schema.Schema) {
+	require.Len(t, channelSchema, 3)
+	schemaAssert := testutils.NewTerraformSchemaAssert(channelSchema, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelServiceNowFieldServiceNowURL)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelServiceNowFieldUsername)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelServiceNowFieldPassword)
+	require.True(t, channelSchema[AlertingChannelServiceNowFieldPassword].Sensitive)
 }
 
 func (r *alertingChannelUnitTest) validateSlackChannelSchema(t *testing.T, channelSchema map[string]*schema.Schema) {
 	require.Len(t, channelSchema, 3)
 	schemaAssert := testutils.NewTerraformSchemaAssert(channelSchema, t)
 	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelSlackFieldWebhookURL)
+	require.True(t, channelSchema[AlertingChannelSlackFieldWebhookURL].Sensitive)
 	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(AlertingChannelSlackFieldIconURL)
 	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(AlertingChannelSlackFieldChannel)
 }
@@ -435,12 +622,14 @@ func (r *alertingChannelUnitTest) validateSplunkChannelSchema(t *testing.T, chan
 	schemaAssert := testutils.NewTerraformSchemaAssert(channelSchema, t)
 	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelSplunkFieldURL)
 	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelSplunkFieldToken)
+	require.True(t, channelSchema[AlertingChannelSplunkFieldToken].Sensitive)
 }
 
 func (r *alertingChannelUnitTest) validateVictorOpsChannelSchema(t *testing.T, channelSchema map[string]*schema.Schema) {
 	require.Len(t, channelSchema, 2)
 	schemaAssert := testutils.NewTerraformSchemaAssert(channelSchema, t)
 	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelVictorOpsFieldAPIKey)
+	require.True(t, channelSchema[AlertingChannelVictorOpsFieldAPIKey].Sensitive)
 	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelVictorOpsFieldRoutingKey)
 }
 
@@ -448,23 +637,123 @@ func (r *alertingChannelUnitTest) validateWebhookChannelSchema(t *testing.T, cha
 	require.Len(t, channelSchema, 2)
 	schemaAssert := testutils.NewTerraformSchemaAssert(channelSchema, t)
 	schemaAssert.AssertSchemaIsRequiredAndOfTypeSetOfStrings(AlertingChannelWebhookFieldWebhookURLs)
-	schemaAssert.AssertSchemaIsOptionalAndOfTypeMapOfStrings(AlertingChannelWebhookFieldHTTPHeaders)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(AlertingChannelWebhookFieldHTTPHeaders)
+	r.validateHTTPHeaderSchema(t, channelSchema[AlertingChannelWebhookFieldHTTPHeaders].Elem.(*schema.Resource).Schema)
+}
+
+func (r *alertingChannelUnitTest) validateHTTPHeaderSchema(t *testing.T, headerSchema map[string]*schema.Schema) {
+	require.Len(t, headerSchema, 3)
+	schemaAssert := testutils.NewTerraformSchemaAssert(headerSchema, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelHTTPHeaderFieldName)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(AlertingChannelHTTPHeaderFieldValue)
+	require.True(t, headerSchema[AlertingChannelHTTPHeaderFieldValue].Sensitive)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(AlertingChannelHTTPHeaderFieldSensitive, false)
 }
 
 func (r *alertingChannelUnitTest) validateWebhookBasedChannelSchema(t *testing.T, channelSchema map[string]*schema.Schema) {
 	require.Len(t, channelSchema, 1)
 	schemaAssert := testutils.NewTerraformSchemaAssert(channelSchema, t)
 	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelWebhookBasedFieldWebhookURL)
+	require.True(t, channelSchema[AlertingChannelWebhookBasedFieldWebhookURL].Sensitive)
 }
 
-func (r *alertingChannelUnitTest) shouldHaveSchemaVersion0(t *testing.T) {
-	require.Equal(t, 0, NewAlertingChannelResourceHandle().MetaData().SchemaVersion)
+func (r *alertingChannelUnitTest) validatePrometheusWebhookChannelSchema(t *testing.T, channelSchema map[string]*schema.Schema) {
+	require.Len(t, channelSchema, 2)
+	schemaAssert := testutils.NewTerraformSchemaAssert(channelSchema, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelWebhookBasedFieldWebhookURL)
+	require.True(t, channelSchema[AlertingChannelWebhookBasedFieldWebhookURL].Sensitive)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(AlertingChannelPrometheusWebhookFieldReceiver)
 }
 
-func (r *alertingChannelUnitTest) shouldHaveNoStateUpgraders(t *testing.T) {
+func (r *alertingChannelUnitTest) validateWatsonAIOpsWebhookChannelSchema(t *testing.T, channelSchema map[string]*schema.Schema) {
+	require.Len(t, channelSchema, 2)
+	schemaAssert := testutils.NewTerraformSchemaAssert(channelSchema, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertingChannelWebhookBasedFieldWebhookURL)
+	require.True(t, channelSchema[AlertingChannelWebhookBasedFieldWebhookURL].Sensitive)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(AlertingChannelWebhookFieldHTTPHeaders)
+	r.validateHTTPHeaderSchema(t, channelSchema[AlertingChannelWebhookFieldHTTPHeaders].Elem.(*schema.Resource).Schema)
+}
+
+func (r *alertingChannelUnitTest) validateSlackAppChannelSchema(t *testing.T, channelSchema map[string]*schema.Schema) {
+	require.Len(t, channelSchema, 6)
+	schemaAssert := testutils.NewTerraformSchemaAssert(channelSchema, t)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelSlackAppFieldAppID)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelAppFieldTeamID)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelAppFieldTeamName)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelAppFieldChannelID)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelAppFieldChannelName)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(AlertingChannelSlackAppFieldEmojiRendering, false)
+}
+
+func (r *alertingChannelUnitTest) validateMsTeamsAppChannelSchema(t *testing.T, channelSchema map[string]*schema.Schema) {
+	require.Len(t, channelSchema, 9)
+	schemaAssert := testutils.NewTerraformSchemaAssert(channelSchema, t)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelMsTeamsAppFieldAPITokenID)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelAppFieldTeamID)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelAppFieldTeamName)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelAppFieldChannelID)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelAppFieldChannelName)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelMsTeamsAppFieldTenantID)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelMsTeamsAppFieldTenantName)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelMsTeamsAppFieldServiceURL)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(AlertingChannelMsTeamsAppFieldInstanaURL)
+}
+
+func (r *alertingChannelUnitTest) shouldHaveSchemaVersion1(t *testing.T) {
+	require.Equal(t, 1, NewAlertingChannelResourceHandle().MetaData().SchemaVersion)
+}
+
+func (r *alertingChannelUnitTest) shouldHaveOneStateUpgrader(t *testing.T) {
 	resourceHandler := NewAlertingChannelResourceHandle()
 
-	require.Equal(t, 0, len(resourceHandler.StateUpgraders()))
+	require.Equal(t, 1, len(resourceHandler.StateUpgraders()))
+	require.Equal(t, 0, resourceHandler.StateUpgraders()[0].Version)
+}
+
+func (r *alertingChannelUnitTest) shouldMigrateHTTPHeadersFromMapToListWhenExecutingFirstStateUpgrader(t *testing.T) {
+	input := map[string]interface{}{
+		AlertingChannelFieldName: resourceName,
+		AlertingChannelFieldChannelWebhook: []interface{}{
+			map[string]interface{}{
+				AlertingChannelWebhookFieldWebhookURLs: []interface{}{"url1"},
+				AlertingChannelWebhookFieldHTTPHeaders: map[string]interface{}{"key2": "value2", "key1": "value1"},
+			},
+		},
+	}
+
+	result, err := NewAlertingChannelResourceHandle().StateUpgraders()[0].Upgrade(nil, input, nil)
+
+	require.NoError(t, err)
+	channel := result[AlertingChannelFieldChannelWebhook].([]interface{})[0].(map[string]interface{})
+	require.Equal(t, []interface{}{"url1"}, channel[AlertingChannelWebhookFieldWebhookURLs])
+	require.Equal(t, []interface{}{
+		map[string]interface{}{
+			AlertingChannelHTTPHeaderFieldName:      "key1",
+			AlertingChannelHTTPHeaderFieldValue:     "value1",
+			AlertingChannelHTTPHeaderFieldSensitive: false,
+		},
+		map[string]interface{}{
+			AlertingChannelHTTPHeaderFieldName:      "key2",
+			AlertingChannelHTTPHeaderFieldValue:     "value2",
+			AlertingChannelHTTPHeaderFieldSensitive: false,
+		},
+	}, channel[AlertingChannelWebhookFieldHTTPHeaders])
+}
+
+func (r *alertingChannelUnitTest) shouldDoNothingWhenExecutingFirstStateUpgraderAndNoHTTPHeadersAreDefined(t *testing.T) {
+	input := map[string]interface{}{
+		AlertingChannelFieldName: resourceName,
+		AlertingChannelFieldChannelEmail: []interface{}{
+			map[string]interface{}{
+				AlertingChannelEmailFieldEmails: []interface{}{"email1"},
+			},
+		},
+	}
+
+	result, err := NewAlertingChannelResourceHandle().StateUpgraders()[0].Upgrade(nil, input, nil)
+
+	require.NoError(t, err)
+	require.Equal(t, input, result)
 }
 
 func (r *alertingChannelUnitTest) shouldHaveCorrectResourceName(t *testing.T) {
@@ -568,6 +857,37 @@ func (r *alertingChannelUnitTest) shouldMapPagerDutyChannelToState(t *testing.T)
 	require.Equal(t, integrationKey, channel[AlertingChannelPagerDutyFieldServiceIntegrationKey])
 }
 
+func (r *alertingChannelUnitTest) shouldMapServiceNowChannelToState(t *testing.T) {
+	serviceNowURL := "service-now-url"
+	username := "username"
+	password := "password"
+	data := restapi.AlertingChannel{
+		ID:            "id",
+		Name:          resourceName,
+		Kind:          restapi.ServiceNowChannelType,
+		ServiceNowURL: &serviceNowURL,
+		Username:      &username,
+		Password:      &password,
+	}
+
+	testHelper := NewTestHelper[*restapi.AlertingChannel](t)
+	sut := NewAlertingChannelResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, &data)
+
+	require.Nil(t, err)
+	require.Equal(t, "id", resourceData.Id())
+	require.Equal(t, resourceName, resourceData.Get(AlertingChannelFieldName))
+	r.verifyChannelIsMappedToResource(t, resourceData, AlertingChannelFieldChannelServiceNow)
+
+	channel := resourceData.Get(AlertingChannelFieldChannelServiceNow).([]interface{})[0].(map[string]interface{})
+	require.Len(t, channel, 3)
+	require.Equal(t, serviceNowURL, channel[AlertingChannelServiceNowFieldServiceNowURL])
+	require.Equal(t, username, channel[AlertingChannelServiceNowFieldUsername])
+	require.Equal(t, password, channel[AlertingChannelServiceNowFieldPassword])
+}
+
 func (r *alertingChannelUnitTest) shouldMapSlackChannelToState(t *testing.T) {
 	webhookURL := "webhook-url"
 	iconURL := "icon-url"
@@ -669,7 +989,7 @@ func (r *alertingChannelUnitTest) shouldMapVictorOpsChannelToState(t *testing.T)
 
 func (r *alertingChannelUnitTest) shouldMapWebhookChannelToState(t *testing.T) {
 	webhookURLs := []string{"url1", "url2"}
-	headers := []string{"key1", "key2:"}
+	headers := []string{"key1", "key2:", "Authorization: Bearer token:with:colons", "key1: duplicate"}
 	data := restapi.AlertingChannel{
 		ID:          "id",
 		Name:        resourceName,
@@ -696,12 +1016,22 @@ func (r *alertingChannelUnitTest) shouldMapWebhookChannelToState(t *testing.T) {
 	channel := resourceData.Get(AlertingChannelFieldChannelWebhook).([]interface{})[0].(map[string]interface{})
 	require.Len(t, channel, 2)
 	require.Equal(t, []interface{}{"url1", "url2"}, channel[AlertingChannelWebhookFieldWebhookURLs].(*schema.Set).List())
-	require.Equal(t, map[string]interface{}{
-		"key1": "",
-		"key2": "",
+	require.Equal(t, []interface{}{
+		r.createHTTPHeaderState("key1", "", false),
+		r.createHTTPHeaderState("key2", "", false),
+		r.createHTTPHeaderState("Authorization", "Bearer token:with:colons", false),
+		r.createHTTPHeaderState("key1", "duplicate", false),
 	}, channel[AlertingChannelWebhookFieldHTTPHeaders])
 }
 
+func (r *alertingChannelUnitTest) createHTTPHeaderState(name string, value string, sensitive bool) map[string]interface{} {
+	return map[string]interface{}{
+		AlertingChannelHTTPHeaderFieldName:      name,
+		AlertingChannelHTTPHeaderFieldValue:     value,
+		AlertingChannelHTTPHeaderFieldSensitive: sensitive,
+	}
+}
+
 func (r *alertingChannelUnitTest) shouldMapOffice365ChannelToState(t *testing.T) {
 	webhookURL := "webhookUrl"
 	data := restapi.AlertingChannel{
@@ -760,6 +1090,177 @@ func (r *alertingChannelUnitTest) shouldMapGoogleChatChannelToState(t *testing.T
 	require.Equal(t, webhookURL, channel[AlertingChannelWebhookBasedFieldWebhookURL])
 }
 
+func (r *alertingChannelUnitTest) shouldMapPrometheusWebhookChannelToState(t *testing.T) {
+	webhookURL := "webhook-url"
+	receiver := "receiver"
+	data := restapi.AlertingChannel{
+		ID:         "id",
+		Name:       resourceName,
+		Kind:       restapi.PrometheusWebhookChannelType,
+		WebhookURL: &webhookURL,
+		Receiver:   &receiver,
+	}
+
+	testHelper := NewTestHelper[*restapi.AlertingChannel](t)
+	sut := NewAlertingChannelResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, &data)
+
+	require.Nil(t, err)
+	require.Equal(t, "id", resourceData.Id())
+	require.Equal(t, resourceName, resourceData.Get(AlertingChannelFieldName))
+	r.verifyChannelIsMappedToResource(t, resourceData, AlertingChannelFieldChannelPrometheusWebhook)
+
+	channel := resourceData.Get(AlertingChannelFieldChannelPrometheusWebhook).([]interface{})[0].(map[string]interface{})
+	require.Len(t, channel, 2)
+	require.Equal(t, webhookURL, channel[AlertingChannelWebhookBasedFieldWebhookURL])
+	require.Equal(t, receiver, channel[AlertingChannelPrometheusWebhookFieldReceiver])
+}
+
+func (r *alertingChannelUnitTest) shouldMapWebexTeamsWebhookChannelToState(t *testing.T) {
+	webhookURL := "webhook-url"
+	data := restapi.AlertingChannel{
+		ID:         "id",
+		Name:       resourceName,
+		Kind:       restapi.WebexTeamsWebhookChannelType,
+		WebhookURL: &webhookURL,
+	}
+
+	testHelper := NewTestHelper[*restapi.AlertingChannel](t)
+	sut := NewAlertingChannelResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, &data)
+
+	require.Nil(t, err)
+	require.Equal(t, "id", resourceData.Id())
+	require.Equal(t, resourceName, resourceData.Get(AlertingChannelFieldName))
+	r.verifyChannelIsMappedToResource(t, resourceData, AlertingChannelFieldChannelWebexTeamsWebhook)
+
+	channel := resourceData.Get(AlertingChannelFieldChannelWebexTeamsWebhook).([]interface{})[0].(map[string]interface{})
+	require.Len(t, channel, 1)
+	require.Equal(t, webhookURL, channel[AlertingChannelWebhookBasedFieldWebhookURL])
+}
+
+func (r *alertingChannelUnitTest) shouldMapWatsonAIOpsWebhookChannelToState(t *testing.T) {
+	webhookURL := "webhook-url"
+	data := restapi.AlertingChannel{
+		ID:         "id",
+		Name:       resourceName,
+		Kind:       restapi.WatsonAIOpsWebhookChannelType,
+		WebhookURL: &webhookURL,
+		Headers:    []string{"key1: value1", "key2"},
+	}
+
+	testHelper := NewTestHelper[*restapi.AlertingChannel](t)
+	sut := NewAlertingChannelResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, &data)
+
+	require.Nil(t, err)
+	require.Equal(t, "id", resourceData.Id())
+	require.Equal(t, resourceName, resourceData.Get(AlertingChannelFieldName))
+	r.verifyChannelIsMappedToResource(t, resourceData, AlertingChannelFieldChannelWatsonAIOpsWebhook)
+
+	channel := resourceData.Get(AlertingChannelFieldChannelWatsonAIOpsWebhook).([]interface{})[0].(map[string]interface{})
+	require.Len(t, channel, 2)
+	require.Equal(t, webhookURL, channel[AlertingChannelWebhookBasedFieldWebhookURL])
+	require.Equal(t, []interface{}{
+		r.createHTTPHeaderState("key1", "value1", false),
+		r.createHTTPHeaderState("key2", "", false),
+	}, channel[AlertingChannelWebhookFieldHTTPHeaders])
+}
+
+func (r *alertingChannelUnitTest) shouldMapSlackAppChannelToState(t *testing.T) {
+	appID := "app-id"
+	teamID := "team-id"
+	teamName := "team-name"
+	channelID := "channel-id"
+	channelName := "channel-name"
+	data := restapi.AlertingChannel{
+		ID:             "id",
+		Name:           resourceName,
+		Kind:           restapi.SlackAppChannelType,
+		AppID:          &appID,
+		TeamID:         &teamID,
+		TeamName:       &teamName,
+		ChannelID:      &channelID,
+		ChannelName:    &channelName,
+		EmojiRendering: utils.BoolPtr(true),
+	}
+
+	testHelper := NewTestHelper[*restapi.AlertingChannel](t)
+	sut := NewAlertingChannelResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, &data)
+
+	require.Nil(t, err)
+	require.Equal(t, "id", resourceData.Id())
+	require.Equal(t, resourceName, resourceData.Get(AlertingChannelFieldName))
+	r.verifyChannelIsMappedToResource(t, resourceData, AlertingChannelFieldChannelSlackApp)
+
+	channel := resourceData.Get(AlertingChannelFieldChannelSlackApp).([]interface{})[0].(map[string]interface{})
+	require.Len(t, channel, 6)
+	require.Equal(t, appID, channel[AlertingChannelSlackAppFieldAppID])
+	require.Equal(t, teamID, channel[AlertingChannelAppFieldTeamID])
+	require.Equal(t, teamName, channel[AlertingChannelAppFieldTeamName])
+	require.Equal(t, channelID, channel[AlertingChannelAppFieldChannelID])
+	require.Equal(t, channelName, channel[AlertingChannelAppFieldChannelName])
+	require.Equal(t, true, channel[AlertingChannelSlackAppFieldEmojiRendering])
+}
+
+func (r *alertingChannelUnitTest) shouldMapMsTeamsAppChannelToState(t *testing.T) {
+	apiTokenID := "api-token-id"
+	teamID := "team-id"
+	teamName := "team-name"
+	channelID := "channel-id"
+	channelName := "channel-name"
+	tenantID := "tenant-id"
+	tenantName := "tenant-name"
+	serviceURL := "service-url"
+	instanaURL := "instana-url"
+	data := restapi.AlertingChannel{
+		ID:          "id",
+		Name:        resourceName,
+		Kind:        restapi.MsTeamsAppChannelType,
+		APITokenID:  &apiTokenID,
+		TeamID:      &teamID,
+		TeamName:    &teamName,
+		ChannelID:   &channelID,
+		ChannelName: &channelName,
+		TenantID:    &tenantID,
+		TenantName:  &tenantName,
+		ServiceURL:  &serviceURL,
+		InstanaURL:  &instanaURL,
+	}
+
+	testHelper := NewTestHelper[*restapi.AlertingChannel](t)
+	sut := NewAlertingChannelResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, &data)
+
+	require.Nil(t, err)
+	require.Equal(t, "id", resourceData.Id())
+	require.Equal(t, resourceName, resourceData.Get(AlertingChannelFieldName))
+	r.verifyChannelIsMappedToResource(t, resourceData, AlertingChannelFieldChannelMsTeamsApp)
+
+	channel := resourceData.Get(AlertingChannelFieldChannelMsTeamsApp).([]interface{})[0].(map[string]interface{})
+	require.Len(t, channel, 9)
+	require.Equal(t, apiTokenID, channel[AlertingChannelMsTeamsAppFieldAPITokenID])
+	require.Equal(t, teamID, channel[AlertingChannelAppFieldTeamID])
+	require.Equal(t, teamName, channel[AlertingChannelAppFieldTeamName])
+	require.Equal(t, channelID, channel[AlertingChannelAppFieldChannelID])
+	require.Equal(t, channelName, channel[AlertingChannelAppFieldChannelName])
+	require.Equal(t, tenantID, channel[AlertingChannelMsTeamsAppFieldTenantID])
+	require.Equal(t, tenantName, channel[AlertingChannelMsTeamsAppFieldTenantName])
+	require.Equal(t, serviceURL, channel[AlertingChannelMsTeamsAppFieldServiceURL])
+	require.Equal(t, instanaURL, channel[AlertingChannelMsTeamsAppFieldInstanaURL])
+}
+
 func (r *alertingChannelUnitTest) verifyChannelIsMappedToResource(t *testing.T, d *schema.ResourceData, expectedChannel string) {
 	for _, k := range AlertingChannelTypeFields {
 		require.IsType(t, []interface{}{}, d.Get(k))
@@ -771,6 +1272,134 @@ func (r *alertingChannelUnitTest) verifyChannelIsMappedToResource(t *testing.T,
 	}
 }
 
+func (r *alertingChannelUnitTest) shouldKeepConfiguredSecretWhenSecretIsMaskedByTheAPI(t *testing.T) {
+	maskedToken := "*****"
+	url := "url"
+	data := restapi.AlertingChannel{
+		ID:    "id",
+		Name:  resourceName,
+		Kind:  restapi.SplunkChannelType,
+		URL:   &url,
+		Token: &maskedToken,
+	}
+
+	resourceData := r.createSplunkChannelResourceData(t, "configured-token")
+
+	err := NewAlertingChannelResourceHandle().UpdateState(resourceData, &data)
+
+	require.NoError(t, err)
+	require.Equal(t, "configured-token", resourceData.Get(fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelSplunk, AlertingChannelSplunkFieldToken)))
+	require.Equal(t, url, resourceData.Get(fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelSplunk, AlertingChannelSplunkFieldURL)))
+}
+
+func (r *alertingChannelUnitTest) shouldKeepConfiguredSecretWhenSecretIsOmittedByTheAPI(t *testing.T) {
+	url := "url"
+	data := restapi.AlertingChannel{
+		ID:   "id",
+		Name: resourceName,
+		Kind: restapi.SplunkChannelType,
+		URL:  &url,
+	}
+
+	resourceData := r.createSplunkChannelResourceData(t, "configured-token")
+
+	err := NewAlertingChannelResourceHandle().UpdateState(resourceData, &data)
+
+	require.NoError(t, err)
+	require.Equal(t, "configured-token", resourceData.Get(fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelSplunk, AlertingChannelSplunkFieldToken)))
+}
+
+func (r *alertingChannelUnitTest) shouldUpdateSecretWhenSecretIsProvidedByTheAPI(t *testing.T) {
+	token := "new-token"
+	url := "url"
+	data := restapi.AlertingChannel{
+		ID:    "id",
+		Name:  resourceName,
+		Kind:  restapi.SplunkChannelType,
+		URL:   &url,
+		Token: &token,
+	}
+
+	resourceData := r.createSplunkChannelResourceData(t, "configured-token")
+
+	err := NewAlertingChannelResourceHandle().UpdateState(resourceData, &data)
+
+	require.NoError(t, err)
+	require.Equal(t, token, resourceData.Get(fmt.Sprintf(alertingChannelChannelFieldPattern, AlertingChannelFieldChannelSplunk, AlertingChannelSplunkFieldToken)))
+}
+
+func (r *alertingChannelUnitTest) shouldKeepConfiguredValueOfSensitiveHTTPHeaderWhenValueIsMaskedByTheAPI(t *testing.T) {
+	data := restapi.AlertingChannel{
+		ID:          "id",
+		Name:        resourceName,
+		Kind:        restapi.WebhookChannelType,
+		WebhookURLs: []string{"url"},
+		Headers:     []string{"key1: *****", "Authorization: *****"},
+	}
+
+	resourceData := r.createWebhookChannelResourceDataWithHeaders(t, []interface{}{
+		r.createHTTPHeaderState("key1", "value1", false),
+		r.createHTTPHeaderState("Authorization", "Bearer token:with:colons", true),
+	})
+
+	err := NewAlertingChannelResourceHandle().UpdateState(resourceData, &data)
+
+	require.NoError(t, err)
+	channel := resourceData.Get(AlertingChannelFieldChannelWebhook).([]interface{})[0].(map[string]interface{})
+	require.Equal(t, []interface{}{
+		r.createHTTPHeaderState("key1", "*****", false),
+		r.createHTTPHeaderState("Authorization", "Bearer token:with:colons", true),
+	}, channel[AlertingChannelWebhookFieldHTTPHeaders])
+}
+
+func (r *alertingChannelUnitTest) shouldUpdateValueOfSensitiveHTTPHeaderWhenValueIsProvidedByTheAPI(t *testing.T) {
+	data := restapi.AlertingChannel{
+		ID:          "id",
+		Name:        resourceName,
+		Kind:        restapi.WebhookChannelType,
+		WebhookURLs: []string{"url"},
+		Headers:     []string{"Authorization: Bearer new-token"},
+	}
+
+	resourceData := r.createWebhookChannelResourceDataWithHeaders(t, []interface{}{
+		r.createHTTPHeaderState("Authorization", "Bearer token", true),
+	})
+
+	err := NewAlertingChannelResourceHandle().UpdateState(resourceData, &data)
+
+	require.NoError(t, err)
+	channel := resourceData.Get(AlertingChannelFieldChannelWebhook).([]interface{})[0].(map[string]interface{})
+	require.Equal(t, []interface{}{
+		r.createHTTPHeaderState("Authorization", "Bearer new-token", true),
+	}, channel[AlertingChannelWebhookFieldHTTPHeaders])
+}
+
+func (r *alertingChannelUnitTest) createWebhookChannelResourceDataWithHeaders(t *testing.T, headers []interface{}) *schema.ResourceData {
+	testHelper := NewTestHelper[*restapi.AlertingChannel](t)
+	return testHelper.CreateResourceDataForResourceHandle(NewAlertingChannelResourceHandle(), map[string]interface{}{
+		AlertingChannelFieldName: resourceName,
+		AlertingChannelFieldChannelWebhook: []interface{}{
+			map[string]interface{}{
+				AlertingChannelWebhookFieldWebhookURLs: []interface{}{"url"},
+				AlertingChannelWebhookFieldHTTPHeaders: headers,
+			},
+		},
+	})
+}
+
+func (r *alertingChannelUnitTest) createSplunkChannelResourceData(t *testing.T, token string) *schema.ResourceData {
+	testHelper := NewTestHelper[*restapi.AlertingChannel](t)
+	return testHelper.CreateResourceDataForResourceHandle(NewAlertingChannelResourceHandle(), map[string]interface{}{
+		AlertingChannelFieldName: resourceName,
+		AlertingChannelFieldChannelSplunk: []interface{}{
+			map[string]interface{}{
+				AlertingChannelSplunkFieldURL:   "url",
+				AlertingChannelSplunkFieldToken: token,
+			},
+		},
+	})
+}
+
 func (r *alertingChannelUnitTest) shouldFailToMapChannelWhenTypeIsNotValid(t *testing.T) {
 	data := restapi.AlertingChannel{
 		ID:     "id",
@@ -891,6 +1520,32 @@ func (r *alertingChannelUnitTest) shouldMapStateOfPagerDutyChannelToDataModel(t
 	require.Equal(t, integrationKey, *result.ServiceIntegrationKey)
 }
 
+func (r *alertingChannelUnitTest) shouldMapStateOfServiceNowChannelToDataModel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.AlertingChannel](t)
+	resourceHandle := NewAlertingChannelResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId("id")
+	setValueOnResourceData(t, resourceData, AlertingChannelFieldName, resourceName)
+	setValueOnResourceData(t, resourceData, AlertingChannelFieldChannelServiceNow, []interface{}{
+		map[string]interface{}{
+			AlertingChannelServiceNowFieldServiceNowURL: "service-now-url",
+			AlertingChannelServiceNowFieldUsername:      "username",
+			AlertingChannelServiceNowFieldPassword:      "password",
+		},
+	})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.Nil(t, err)
+	require.Equal(t, "id", result.GetIDForResourcePath())
+	require.Equal(t, resourceName, result.Name)
+	require.Equal(t, restapi.ServiceNowChannelType, result.Kind)
+	require.Equal(t, "service-now-url", *result.ServiceNowURL)
+	require.Equal(t, "username", *result.Username)
+	require.Equal(t, "password", *result.Password)
+}
+
 func (r *alertingChannelUnitTest) shouldMapStateOfSlackChannelToDataModel(t *testing.T) {
 	testHelper := NewTestHelper[*restapi.AlertingChannel](t)
 	resourceHandle := NewAlertingChannelResourceHandle()
@@ -1052,7 +1707,12 @@ func (r *alertingChannelUnitTest) shouldMapStateOfWebhookChannelWithHeadersToDat
 	setValueOnResourceData(t, resourceData, AlertingChannelFieldChannelWebhook, []interface{}{
 		map[string]interface{}{
 			AlertingChannelWebhookFieldWebhookURLs: webhookURLs,
-			AlertingChannelWebhookFieldHTTPHeaders: map[string]interface{}{"key1": "value1", "key2": ""},
+			AlertingChannelWebhookFieldHTTPHeaders: []interface{}{
+				r.createHTTPHeaderState("key1", "value1", false),
+				r.createHTTPHeaderState("key2", "", false),
+				r.createHTTPHeaderState("Authorization", "Bearer token:with:colons", true),
+				r.createHTTPHeaderState("key1", "duplicate", false),
+			},
 		},
 	})
 	setValueOnResourceData(t, resourceData, AlertingChannelFieldChannelOffice365, []interface{}{})
@@ -1067,9 +1727,7 @@ func (r *alertingChannelUnitTest) shouldMapStateOfWebhookChannelWithHeadersToDat
 	require.Len(t, result.WebhookURLs, 2)
 	require.Contains(t, result.WebhookURLs, "url1")
 	require.Contains(t, result.WebhookURLs, "url2")
-	require.Len(t, result.Headers, 2)
-	require.Contains(t, result.Headers, "key1: value1")
-	require.Contains(t, result.Headers, "key2: ")
+	require.Equal(t, []string{"key1: value1", "key2: ", "Authorization: Bearer token:with:colons", "key1: duplicate"}, result.Headers)
 }
 
 func (r *alertingChannelUnitTest) shouldMapStateOfOffice365ChannelToDataModel(t *testing.T) {
@@ -1136,6 +1794,197 @@ func (r *alertingChannelUnitTest) shouldMapStateOfGoogleChatChannelToDataModel(t
 	require.Equal(t, webhookURL, *result.WebhookURL)
 }
 
+func (r *alertingChannelUnitTest) shouldMapStateOfPrometheusWebhookChannelToDataModel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.AlertingChannel](t)
+	resourceHandle := NewAlertingChannelResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId("id")
+	setValueOnResourceData(t, resourceData, AlertingChannelFieldName, resourceName)
+	setValueOnResourceData(t, resourceData, AlertingChannelFieldChannelPrometheusWebhook, []interface{}{
+		map[string]interface{}{
+			AlertingChannelWebhookBasedFieldWebhookURL:    "webhook-url",
+			AlertingChannelPrometheusWebhookFieldReceiver: "receiver",
+		},
+	})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.Nil(t, err)
+	require.Equal(t, "id", result.GetIDForResourcePath())
+	require.Equal(t, resourceName, result.Name)
+	require.Equal(t, restapi.PrometheusWebhookChannelType, result.Kind)
+	require.Equal(t, "webhook-url", *result.WebhookURL)
+	require.Equal(t, "receiver", *result.Receiver)
+}
+
+func (r *alertingChannelUnitTest) shouldMapStateOfPrometheusWebhookChannelWithoutReceiverToDataModel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.AlertingChannel](t)
+	resourceHandle := NewAlertingChannelResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId("id")
+	setValueOnResourceData(t, resourceData, AlertingChannelFieldName, resourceName)
+	setValueOnResourceData(t, resourceData, AlertingChannelFieldChannelPrometheusWebhook, []interface{}{
+		map[string]interface{}{
+			AlertingChannelWebhookBasedFieldWebhookURL: "webhook-url",
+		},
+	})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.Nil(t, err)
+	require.Equal(t, restapi.PrometheusWebhookChannelType, result.Kind)
+	require.Equal(t, "webhook-url", *result.WebhookURL)
+	require.Nil(t, result.Receiver)
+}
+
+func (r *alertingChannelUnitTest) shouldMapStateOfWebexTeamsWebhookChannelToDataModel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.AlertingChannel](t)
+	resourceHandle := NewAlertingChannelResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId("id")
+	setValueOnResourceData(t, resourceData, AlertingChannelFieldName, resourceName)
+	setValueOnResourceData(t, resourceData, AlertingChannelFieldChannelWebexTeamsWebhook, []interface{}{
+		map[string]interface{}{
+			AlertingChannelWebhookBasedFieldWebhookURL: "webhook-url",
+		},
+	})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.Nil(t, err)
+	require.Equal(t, "id", result.GetIDForResourcePath())
+	require.Equal(t, resourceName, result.Name)
+	require.Equal(t, restapi.WebexTeamsWebhookChannelType, result.Kind)
+	require.Equal(t, "webhook-url", *result.WebhookURL)
+}
+
+func (r *alertingChannelUnitTest) shouldMapStateOfWatsonAIOpsWebhookChannelToDataModel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.AlertingChannel](t)
+	resourceHandle := NewAlertingChannelResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId("id")
+	setValueOnResourceData(t, resourceData, AlertingChannelFieldName, resourceName)
+	setValueOnResourceData(t, resourceData, AlertingChannelFieldChannelWatsonAIOpsWebhook, []interface{}{
+		map[string]interface{}{
+			AlertingChannelWebhookBasedFieldWebhookURL: "webhook-url",
+			AlertingChannelWebhookFieldHTTPHeaders: []interface{}{
+				r.createHTTPHeaderState("key1", "value1", false),
+			},
+		},
+	})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.Nil(t, err)
+	require.Equal(t, "id", result.GetIDForResourcePath())
+	require.Equal(t, resourceName, result.Name)
+	require.Equal(t, restapi.WatsonAIOpsWebhookChannelType, result.Kind)
+	require.Equal(t, "webhook-url", *result.WebhookURL)
+	require.Equal(t, []string{"key1: value1"}, result.Headers)
+}
+
+func (r *alertingChannelUnitTest) shouldMapStateOfSlackAppChannelToDataModel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.AlertingChannel](t)
+	resourceHandle := NewAlertingChannelResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId("id")
+	setValueOnResourceData(t, resourceData, AlertingChannelFieldName, resourceName)
+	setValueOnResourceData(t, resourceData, AlertingChannelFieldChannelSlackApp, []interface{}{
+		map[string]interface{}{
+			AlertingChannelSlackAppFieldAppID:          "app-id",
+			AlertingChannelAppFieldTeamID:              "team-id",
+			AlertingChannelAppFieldTeamName:            "team-name",
+			AlertingChannelAppFieldChannelID:           "channel-id",
+			AlertingChannelAppFieldChannelName:         "channel-name",
+			AlertingChannelSlackAppFieldEmojiRendering: true,
+		},
+	})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.Nil(t, err)
+	require.Equal(t, "id", result.GetIDForResourcePath())
+	require.Equal(t, resourceName, result.Name)
+	require.Equal(t, restapi.SlackAppChannelType, result.Kind)
+	require.Equal(t, "app-id", *result.AppID)
+	require.Equal(t, "team-id", *result.TeamID)
+	require.Equal(t, "team-name", *result.TeamName)
+	require.Equal(t, "channel-id", *result.ChannelID)
+	require.Equal(t, "channel-name", *result.ChannelName)
+	require.Equal(t, utils.BoolPtr(true), result.EmojiRendering)
+}
+
+func (r *alertingChannelUnitTest) shouldMapStateOfMsTeamsAppChannelToDataModel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.AlertingChannel](t)
+	resourceHandle := NewAlertingChannelResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId("id")
+	setValueOnResourceData(t, resourceData, AlertingChannelFieldName, resourceName)
+	setValueOnResourceData(t, resourceData, AlertingChannelFieldChannelMsTeamsApp, []interface{}{
+		map[string]interface{}{
+			AlertingChannelMsTeamsAppFieldAPITokenID: "api-token-id",
+			AlertingChannelAppFieldTeamID:            "team-id",
+			AlertingChannelAppFieldTeamName:          "team-name",
+			AlertingChannelAppFieldChannelID:         "channel-id",
+			AlertingChannelAppFieldChannelName:       "channel-name",
+			AlertingChannelMsTeamsAppFieldTenantID:   "tenant-id",
+			AlertingChannelMsTeamsAppFieldTenantName: "tenant-name",
+			AlertingChannelMsTeamsAppFieldServiceURL: "service-url",
+			AlertingChannelMsTeamsAppFieldInstanaURL: "instana-url",
+		},
+	})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.Nil(t, err)
+	require.Equal(t, "id", result.GetIDForResourcePath())
+	require.Equal(t, resourceName, result.Name)
+	require.Equal(t, restapi.MsTeamsAppChannelType, result.Kind)
+	require.Equal(t, "api-token-id", *result.APITokenID)
+	require.Equal(t, "team-id", *result.TeamID)
+	require.Equal(t, "team-name", *result.TeamName)
+	require.Equal(t, "channel-id", *result.ChannelID)
+	require.Equal(t, "channel-name", *result.ChannelName)
+	require.Equal(t, "tenant-id", *result.TenantID)
+	require.Equal(t, "tenant-name", *result.TenantName)
+	require.Equal(t, "service-url", *result.ServiceURL)
+	require.Equal(t, "instana-url", *result.InstanaURL)
+}
+
+func (r *alertingChannelUnitTest) shouldFailToMapStateOfNewSlackAppChannelToDataModel(t *testing.T) {
+	r.shouldFailToMapStateOfNewAppChannelToDataModel(t, AlertingChannelFieldChannelSlackApp, restapi.SlackAppChannelType)
+}
+
+func (r *alertingChannelUnitTest) shouldFailToMapStateOfNewMsTeamsAppChannelToDataModel(t *testing.T) {
+	r.shouldFailToMapStateOfNewAppChannelToDataModel(t, AlertingChannelFieldChannelMsTeamsApp, restapi.MsTeamsAppChannelType)
+}
+
+func (r *alertingChannelUnitTest) shouldFailToMapStateOfNewAppChannelToDataModel(t *testing.T, channelField string, channelType restapi.AlertingChannelType) {
+	testHelper := NewTestHelper[*restapi.AlertingChannel](t)
+	resourceHandle := NewAlertingChannelResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.MarkNewResource()
+	resourceData.SetId("id")
+	setValueOnResourceData(t, resourceData, AlertingChannelFieldName, resourceName)
+	setValueOnResourceData(t, resourceData, channelField, []interface{}{
+		map[string]interface{}{
+			AlertingChannelAppFieldTeamID: "team-id",
+		},
+	})
+
+	_, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.Error(t, err)
+	require.ErrorContains(t, err, fmt.Sprintf("alerting channels of type %s require an interactive OAuth authorization", channelType))
+}
+
 func (r *alertingChannelUnitTest) shouldFailToMapStateWhenNoChannelIsProvided(t *testing.T) {
 	testHelper := NewTestHelper[*restapi.AlertingChannel](t)
 	resourceHandle := NewAlertingChannelResourceHandle()
@@ -1158,3 +2007,24 @@ func (r *alertingChannelUnitTest) shouldFailToMapStateWhenNoChannelIsProvided(t
 	require.Error(t, err)
 	require.ErrorContains(t, err, "no supported alerting channel defined")
 }
+
+func (r *alertingChannelUnitTest) shouldMapVerifyOnApplyFlagToDataModel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.AlertingChannel](t)
+	resourceHandle := NewAlertingChannelResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId("id")
+	setValueOnResourceData(t, resourceData, AlertingChannelFieldName, resourceName)
+	setValueOnResourceData(t, resourceData, AlertingChannelFieldVerifyOnApply, true)
+	setValueOnResourceData(t, resourceData, AlertingChannelFieldChannelEmail, []interface{}{
+		map[string]interface{}{
+			AlertingChannelEmailFieldEmails: []interface{}{"email1"},
+		},
+	})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.Equal(t, restapi.EmailChannelType, result.Kind)
+	require.True(t, result.VerifyOnApply)
+}
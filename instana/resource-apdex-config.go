@@ -0,0 +1,203 @@
+package instana
+
+import (
+	"fmt"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/instana/tagfilter"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaApdexConfig the name of the terraform-provider-instana resource to manage apdex configurations
+const ResourceInstanaApdexConfig = "instana_apdex_config"
+
+const (
+	//ApdexConfigFieldName constant value for the schema field name
+	ApdexConfigFieldName = "name"
+	//ApdexConfigFieldEntityType constant value for the schema field entity_type
+	ApdexConfigFieldEntityType = "entity_type"
+	//ApdexConfigFieldEntityID constant value for the schema field entity_id
+	ApdexConfigFieldEntityID = "entity_id"
+	//ApdexConfigFieldThreshold constant value for the schema field threshold
+	ApdexConfigFieldThreshold = "threshold"
+	//ApdexConfigFieldTagFilter constant value for the schema field tag_filter
+	ApdexConfigFieldTagFilter = "tag_filter"
+	//ApdexConfigFieldBoundaryScope constant value for the schema field boundary_scope
+	ApdexConfigFieldBoundaryScope = "boundary_scope"
+	//ApdexConfigFieldIncludeInternal constant value for the schema field include_internal
+	ApdexConfigFieldIncludeInternal = "include_internal"
+	//ApdexConfigFieldIncludeSynthetic constant value for the schema field include_synthetic
+	ApdexConfigFieldIncludeSynthetic = "include_synthetic"
+	//ApdexConfigFieldBeaconType constant value for the schema field beacon_type
+	ApdexConfigFieldBeaconType = "beacon_type"
+)
+
+// NewApdexConfigResourceHandle creates the resource handle for apdex configurations
+func NewApdexConfigResourceHandle() ResourceHandle[*restapi.ApdexConfig] {
+	return &apdexConfigResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaApdexConfig,
+			Schema: map[string]*schema.Schema{
+				ApdexConfigFieldName: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringLenBetween(0, 256),
+					Description:  "The name of the apdex config",
+				},
+				ApdexConfigFieldEntityType: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringInSlice(restapi.SupportedApdexEntityTypes.ToStringSlice(), false),
+					Description:  "The type of the entity of the apdex config (application, website)",
+				},
+				ApdexConfigFieldEntityID: {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "The ID of the application or website of the apdex config",
+				},
+				ApdexConfigFieldThreshold: {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The apdex threshold in milliseconds",
+				},
+				ApdexConfigFieldTagFilter: {
+					Type:             schema.TypeString,
+					Required:         true,
+					ForceNew:         true,
+					Description:      "The tag filter expression",
+					DiffSuppressFunc: tagFilterDiffSuppressFunc,
+					StateFunc:        tagFilterStateFunc,
+					ValidateFunc:     tagFilterValidateFunc,
+				},
+				ApdexConfigFieldBoundaryScope: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringInSlice([]string{"ALL", "INBOUND"}, false),
+					Description:  "The boundary scope of the apdex config (ALL, INBOUND). Required when entity_type is application",
+				},
+				ApdexConfigFieldIncludeInternal: {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					ForceNew:    true,
+					Default:     false,
+					Description: "Optional flag to indicate whether also internal calls are included. Only applicable when entity_type is application",
+				},
+				ApdexConfigFieldIncludeSynthetic: {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					ForceNew:    true,
+					Default:     false,
+					Description: "Optional flag to indicate whether also synthetic calls are included. Only applicable when entity_type is application",
+				},
+				ApdexConfigFieldBeaconType: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringInSlice([]string{"pageLoad", "resourceLoad", "httpRequest", "error", "custom", "pageChange"}, false),
+					Description:  "The beacon type of the apdex config (pageLoad, resourceLoad, httpRequest, error, custom, pageChange). Required when entity_type is website",
+				},
+			},
+			SchemaVersion: 0,
+			CreateOnly:    true,
+		},
+	}
+}
+
+type apdexConfigResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *apdexConfigResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *apdexConfigResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *apdexConfigResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.ApdexConfig] {
+	return api.ApdexConfigs()
+}
+
+func (r *apdexConfigResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *apdexConfigResource) UpdateState(d *schema.ResourceData, config *restapi.ApdexConfig) error {
+	entity := config.Entity
+	data := map[string]interface{}{
+		ApdexConfigFieldName:             config.Name,
+		ApdexConfigFieldEntityType:       string(entity.Type),
+		ApdexConfigFieldEntityID:         entity.EntityID,
+		ApdexConfigFieldThreshold:        entity.Threshold,
+		ApdexConfigFieldBoundaryScope:    entity.BoundaryScope,
+		ApdexConfigFieldIncludeInternal:  entity.IncludeInternal,
+		ApdexConfigFieldIncludeSynthetic: entity.IncludeSynthetic,
+		ApdexConfigFieldBeaconType:       entity.BeaconType,
+	}
+	if entity.TagFilterExpression != nil {
+		normalizedTagFilterString, err := tagfilter.MapTagFilterToNormalizedString(entity.TagFilterExpression)
+		if err != nil {
+			return err
+		}
+		data[ApdexConfigFieldTagFilter] = normalizedTagFilterString
+	}
+
+	d.SetId(config.ID)
+	return tfutils.UpdateState(d, data)
+}
+
+func (r *apdexConfigResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.ApdexConfig, error) {
+	tagFilter, err := r.mapTagFilterStringToAPIModel(d.Get(ApdexConfigFieldTagFilter).(string))
+	if err != nil {
+		return &restapi.ApdexConfig{}, err
+	}
+
+	entity := restapi.ApdexEntity{
+		Type:                restapi.ApdexEntityType(d.Get(ApdexConfigFieldEntityType).(string)),
+		EntityID:            d.Get(ApdexConfigFieldEntityID).(string),
+		Threshold:           GetInt32PointerFromResourceData(d, ApdexConfigFieldThreshold),
+		TagFilterExpression: tagFilter,
+	}
+
+	if entity.Type == restapi.ApdexEntityTypeApplication {
+		entity.BoundaryScope = GetStringPointerFromResourceData(d, ApdexConfigFieldBoundaryScope)
+		if entity.BoundaryScope == nil {
+			return &restapi.ApdexConfig{}, fmt.Errorf("%s is required for apdex configs of entity type %s", ApdexConfigFieldBoundaryScope, entity.Type)
+		}
+		includeInternal := d.Get(ApdexConfigFieldIncludeInternal).(bool)
+		includeSynthetic := d.Get(ApdexConfigFieldIncludeSynthetic).(bool)
+		entity.IncludeInternal = &includeInternal
+		entity.IncludeSynthetic = &includeSynthetic
+	} else {
+		entity.BeaconType = GetStringPointerFromResourceData(d, ApdexConfigFieldBeaconType)
+		if entity.BeaconType == nil {
+			return &restapi.ApdexConfig{}, fmt.Errorf("%s is required for apdex configs of entity type %s", ApdexConfigFieldBeaconType, entity.Type)
+		}
+	}
+
+	return &restapi.ApdexConfig{
+		ID:     d.Id(),
+		Name:   d.Get(ApdexConfigFieldName).(string),
+		Entity: entity,
+	}, nil
+}
+
+func (r *apdexConfigResource) mapTagFilterStringToAPIModel(input string) (*restapi.TagFilter, error) {
+	parser := tagfilter.NewParser()
+	expr, err := parser.Parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	mapper := tagfilter.NewMapper()
+	return mapper.ToAPIModel(expr), nil
+}
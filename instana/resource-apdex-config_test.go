@@ -0,0 +1,247 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+const apdexConfigTerraformTemplate = `
+resource "instana_apdex_config" "example" {
+	name           = "name"
+	entity_type    = "application"
+	entity_id      = "application-id"
+	threshold      = 500
+	tag_filter     = "service.name@dest EQUALS 'my-service'"
+	boundary_scope = "INBOUND"
+}
+`
+
+const apdexConfigDefinition = "instana_apdex_config.example"
+
+func TestCRUDOfApdexConfiguration(t *testing.T) {
+	id := RandomID()
+	var serverState []byte
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodPost, restapi.ApdexConfigResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		config := &restapi.ApdexConfig{}
+		err := json.NewDecoder(r.Body).Decode(config)
+		if err != nil {
+			httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		config.ID = id
+		serverState, _ = json.Marshal(config)
+		httpServer.WriteJSONResponse(w, serverState)
+	})
+	httpServer.AddRoute(http.MethodDelete, restapi.ApdexConfigResourcePath+"/{id}", testutils.EchoHandlerFunc)
+	httpServer.AddRoute(http.MethodGet, restapi.ApdexConfigResourcePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		httpServer.WriteJSONResponse(w, serverState)
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: appendProviderConfig(apdexConfigTerraformTemplate, httpServer.GetPort()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(apdexConfigDefinition, "id", id),
+					resource.TestCheckResourceAttr(apdexConfigDefinition, ApdexConfigFieldName, resourceName),
+					resource.TestCheckResourceAttr(apdexConfigDefinition, ApdexConfigFieldEntityType, "application"),
+					resource.TestCheckResourceAttr(apdexConfigDefinition, ApdexConfigFieldEntityID, "application-id"),
+					resource.TestCheckResourceAttr(apdexConfigDefinition, ApdexConfigFieldThreshold, "500"),
+					resource.TestCheckResourceAttr(apdexConfigDefinition, ApdexConfigFieldTagFilter, "service.name@dest EQUALS 'my-service'"),
+					resource.TestCheckResourceAttr(apdexConfigDefinition, ApdexConfigFieldBoundaryScope, "INBOUND"),
+					resource.TestCheckResourceAttr(apdexConfigDefinition, ApdexConfigFieldIncludeInternal, falseAsString),
+					resource.TestCheckResourceAttr(apdexConfigDefinition, ApdexConfigFieldIncludeSynthetic, falseAsString),
+				),
+			},
+			testStepImportWithCustomID(apdexConfigDefinition, id),
+		},
+	})
+}
+
+func TestResourceApdexConfigDefinition(t *testing.T) {
+	resourceHandle := NewApdexConfigResourceHandle()
+	schemaMap := resourceHandle.MetaData().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(ApdexConfigFieldName)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(ApdexConfigFieldEntityType)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(ApdexConfigFieldEntityID)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeInt(ApdexConfigFieldThreshold)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(ApdexConfigFieldTagFilter)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(ApdexConfigFieldBoundaryScope)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(ApdexConfigFieldIncludeInternal, false)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(ApdexConfigFieldIncludeSynthetic, false)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(ApdexConfigFieldBeaconType)
+	for field, fieldSchema := range schemaMap {
+		require.Truef(t, fieldSchema.ForceNew, "expected field %s to be ForceNew", field)
+	}
+	require.True(t, resourceHandle.MetaData().CreateOnly)
+}
+
+func TestShouldUpdateResourceStateForApdexConfigOfTypeApplication(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ApdexConfig](t)
+	resourceHandle := NewApdexConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	threshold := int32(500)
+	boundaryScope := "ALL"
+	includeInternal := true
+	includeSynthetic := false
+	data := restapi.ApdexConfig{
+		ID:   "id",
+		Name: resourceName,
+		Entity: restapi.ApdexEntity{
+			Type:                restapi.ApdexEntityTypeApplication,
+			EntityID:            "application-id",
+			Threshold:           &threshold,
+			TagFilterExpression: restapi.NewStringTagFilter(restapi.TagFilterEntityDestination, "service.name", restapi.EqualsOperator, "my-service"),
+			BoundaryScope:       &boundaryScope,
+			IncludeInternal:     &includeInternal,
+			IncludeSynthetic:    &includeSynthetic,
+		},
+	}
+
+	err := resourceHandle.UpdateState(resourceData, &data)
+
+	require.NoError(t, err)
+	require.Equal(t, "id", resourceData.Id())
+	require.Equal(t, resourceName, resourceData.Get(ApdexConfigFieldName))
+	require.Equal(t, "application", resourceData.Get(ApdexConfigFieldEntityType))
+	require.Equal(t, "application-id", resourceData.Get(ApdexConfigFieldEntityID))
+	require.Equal(t, 500, resourceData.Get(ApdexConfigFieldThreshold))
+	require.Equal(t, "service.name@dest EQUALS 'my-service'", resourceData.Get(ApdexConfigFieldTagFilter))
+	require.Equal(t, boundaryScope, resourceData.Get(ApdexConfigFieldBoundaryScope))
+	require.True(t, resourceData.Get(ApdexConfigFieldIncludeInternal).(bool))
+	require.False(t, resourceData.Get(ApdexConfigFieldIncludeSynthetic).(bool))
+	require.Equal(t, "", resourceData.Get(ApdexConfigFieldBeaconType))
+}
+
+func TestShouldUpdateResourceStateForApdexConfigOfTypeWebsite(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ApdexConfig](t)
+	resourceHandle := NewApdexConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	beaconType := "pageLoad"
+	data := restapi.ApdexConfig{
+		ID:   "id",
+		Name: resourceName,
+		Entity: restapi.ApdexEntity{
+			Type:                restapi.ApdexEntityTypeWebsite,
+			EntityID:            "website-id",
+			TagFilterExpression: restapi.NewStringTagFilter(restapi.TagFilterEntityDestination, "beacon.page.name", restapi.EqualsOperator, "home"),
+			BeaconType:          &beaconType,
+		},
+	}
+
+	err := resourceHandle.UpdateState(resourceData, &data)
+
+	require.NoError(t, err)
+	require.Equal(t, "website", resourceData.Get(ApdexConfigFieldEntityType))
+	require.Equal(t, "website-id", resourceData.Get(ApdexConfigFieldEntityID))
+	require.Equal(t, 0, resourceData.Get(ApdexConfigFieldThreshold))
+	require.Equal(t, "beacon.page.name@dest EQUALS 'home'", resourceData.Get(ApdexConfigFieldTagFilter))
+	require.Equal(t, beaconType, resourceData.Get(ApdexConfigFieldBeaconType))
+	require.Equal(t, "", resourceData.Get(ApdexConfigFieldBoundaryScope))
+}
+
+func TestShouldConvertStateOfApdexConfigOfTypeApplicationToDataModel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ApdexConfig](t)
+	resourceHandle := NewApdexConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId("id")
+	setValueOnResourceData(t, resourceData, ApdexConfigFieldName, resourceName)
+	setValueOnResourceData(t, resourceData, ApdexConfigFieldEntityType, "application")
+	setValueOnResourceData(t, resourceData, ApdexConfigFieldEntityID, "application-id")
+	setValueOnResourceData(t, resourceData, ApdexConfigFieldThreshold, 500)
+	setValueOnResourceData(t, resourceData, ApdexConfigFieldTagFilter, "service.name@dest EQUALS 'my-service'")
+	setValueOnResourceData(t, resourceData, ApdexConfigFieldBoundaryScope, "INBOUND")
+	setValueOnResourceData(t, resourceData, ApdexConfigFieldIncludeSynthetic, true)
+
+	model, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	threshold := int32(500)
+	boundaryScope := "INBOUND"
+	includeInternal := false
+	includeSynthetic := true
+	require.Equal(t, &restapi.ApdexConfig{
+		ID:   "id",
+		Name: resourceName,
+		Entity: restapi.ApdexEntity{
+			Type:                restapi.ApdexEntityTypeApplication,
+			EntityID:            "application-id",
+			Threshold:           &threshold,
+			TagFilterExpression: restapi.NewStringTagFilter(restapi.TagFilterEntityDestination, "service.name", restapi.EqualsOperator, "my-service"),
+			BoundaryScope:       &boundaryScope,
+			IncludeInternal:     &includeInternal,
+			IncludeSynthetic:    &includeSynthetic,
+		},
+	}, model)
+}
+
+func TestShouldConvertStateOfApdexConfigOfTypeWebsiteToDataModel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ApdexConfig](t)
+	resourceHandle := NewApdexConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId("id")
+	setValueOnResourceData(t, resourceData, ApdexConfigFieldName, resourceName)
+	setValueOnResourceData(t, resourceData, ApdexConfigFieldEntityType, "website")
+	setValueOnResourceData(t, resourceData, ApdexConfigFieldEntityID, "website-id")
+	setValueOnResourceData(t, resourceData, ApdexConfigFieldTagFilter, "beacon.page.name EQUALS 'home'")
+	setValueOnResourceData(t, resourceData, ApdexConfigFieldBeaconType, "pageLoad")
+
+	model, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	beaconType := "pageLoad"
+	require.Equal(t, restapi.ApdexEntity{
+		Type:                restapi.ApdexEntityTypeWebsite,
+		EntityID:            "website-id",
+		TagFilterExpression: restapi.NewStringTagFilter(restapi.TagFilterEntityDestination, "beacon.page.name", restapi.EqualsOperator, "home"),
+		BeaconType:          &beaconType,
+	}, model.Entity)
+}
+
+func TestShouldFailToConvertStateOfApdexConfigToDataModelWhenEntitySpecificFieldIsMissing(t *testing.T) {
+	for entityType, missingField := range map[string]string{"application": ApdexConfigFieldBoundaryScope, "website": ApdexConfigFieldBeaconType} {
+		t.Run(entityType, func(t *testing.T) {
+			testHelper := NewTestHelper[*restapi.ApdexConfig](t)
+			resourceHandle := NewApdexConfigResourceHandle()
+			resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+			setValueOnResourceData(t, resourceData, ApdexConfigFieldEntityType, entityType)
+			setValueOnResourceData(t, resourceData, ApdexConfigFieldTagFilter, "service.name@dest EQUALS 'my-service'")
+
+			_, err := resourceHandle.MapStateToDataObject(resourceData)
+
+			require.Error(t, err)
+			require.Contains(t, err.Error(), missingField)
+		})
+	}
+}
+
+func TestShouldFailToConvertStateOfApdexConfigToDataModelWhenTagFilterIsInvalid(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ApdexConfig](t)
+	resourceHandle := NewApdexConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, ApdexConfigFieldTagFilter, "invalid tag filter")
+
+	_, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.Error(t, err)
+}
+
+func TestShouldReturnCorrectResourceNameForApdexConfig(t *testing.T) {
+	name := NewApdexConfigResourceHandle().MetaData().ResourceName
+
+	require.Equal(t, "instana_apdex_config", name)
+}
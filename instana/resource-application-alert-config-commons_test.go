@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
 	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"net/http"
@@ -138,6 +139,7 @@ var applicationAlertConfigServerResponseTemplate = `
     },
     "severity": 5,
     "triggering": false,
+    "enabled": true,
     "tagFilters": [],
     "tagFilterExpression": {
       "type": "TAG_FILTER",
@@ -230,6 +232,7 @@ func (f *anyApplicationConfigTest) createIntegrationTest() func(t *testing.T) {
 			testutils.EchoHandlerFunc(w, r)
 		})
 		httpServer.AddRoute(http.MethodDelete, f.resourceInstanceRestAPIPath, testutils.EchoHandlerFunc)
+		httpServer.AddRoute(http.MethodPut, f.resourceInstanceRestAPIPath+"/enable", testutils.EchoHandlerFunc)
 		httpServer.AddRoute(http.MethodGet, f.resourceInstanceRestAPIPath, func(w http.ResponseWriter, r *http.Request) {
 			modCount := httpServer.GetCallCount(http.MethodPost, f.resourceRestAPIPath+"/"+id)
 			jsonData := fmt.Sprintf(applicationAlertConfigServerResponseTemplate, id, modCount)
@@ -283,6 +286,7 @@ func (f *anyApplicationConfigTest) createIntegrationTestStep(httpPort int, itera
 			resource.TestCheckResourceAttr(f.terraformResourceInstanceName, ApplicationAlertConfigFieldBoundaryScope, string(restapi.BoundaryScopeAll)),
 			resource.TestCheckResourceAttr(f.terraformResourceInstanceName, ApplicationAlertConfigFieldSeverity, restapi.SeverityWarning.GetTerraformRepresentation()),
 			resource.TestCheckResourceAttr(f.terraformResourceInstanceName, ApplicationAlertConfigFieldTriggering, falseAsString),
+			resource.TestCheckResourceAttr(f.terraformResourceInstanceName, ApplicationAlertConfigFieldEnabled, trueAsString),
 			resource.TestCheckResourceAttr(f.terraformResourceInstanceName, ApplicationAlertConfigFieldIncludeInternal, falseAsString),
 			resource.TestCheckResourceAttr(f.terraformResourceInstanceName, ApplicationAlertConfigFieldIncludeSynthetic, falseAsString),
 			resource.TestCheckResourceAttr(f.terraformResourceInstanceName, ApplicationAlertConfigFieldAlertChannelIDs+".0", "alert-channel-id-1"),
@@ -679,6 +683,7 @@ func (f *anyApplicationConfigTest) createTestShouldUpdateTerraformResourceStateF
 			Threshold:           thresholdTestPair.input,
 			TimeThreshold:       timeThresholdTestPair.input,
 			Triggering:          true,
+			Enabled:             utils.BoolPtr(false),
 		}
 
 		testHelper := NewTestHelper[*restapi.ApplicationAlertConfig](t)
@@ -715,6 +720,7 @@ func (f *anyApplicationConfigTest) createTestShouldUpdateTerraformResourceStateF
 		f.requireApplicationAlertConfigThresholdSetOnSchema(t, thresholdTestPair.expected, resourceData)
 		require.Equal(t, timeThresholdTestPair.expected, resourceData.Get(ApplicationAlertConfigFieldTimeThreshold))
 		require.True(t, resourceData.Get(ApplicationAlertConfigFieldTriggering).(bool))
+		require.False(t, resourceData.Get(ApplicationAlertConfigFieldEnabled).(bool))
 	}
 }
 
@@ -1092,6 +1098,7 @@ func (f *anyApplicationConfigTest) createTestShouldMapTerraformResourceStateToMo
 			Threshold:           thresholdTestPair.expected,
 			TimeThreshold:       timeThresholdTestPair.expected,
 			Triggering:          true,
+			Enabled:             utils.BoolPtr(false),
 		}
 
 		testHelper := NewTestHelper[*restapi.ApplicationAlertConfig](t)
@@ -1140,6 +1147,7 @@ func (f *anyApplicationConfigTest) createTestShouldMapTerraformResourceStateToMo
 		setValueOnResourceData(t, resourceData, ResourceFieldThreshold, thresholdTestPair.input)
 		setValueOnResourceData(t, resourceData, ApplicationAlertConfigFieldTimeThreshold, timeThresholdTestPair.input)
 		setValueOnResourceData(t, resourceData, ApplicationAlertConfigFieldTriggering, true)
+		setValueOnResourceData(t, resourceData, ApplicationAlertConfigFieldEnabled, false)
 		resourceData.SetId(applicationAlertConfigID)
 
 		result, err := sut.MapStateToDataObject(resourceData)
@@ -0,0 +1,80 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaApplicationAlertConfigRestore the name of the terraform-provider-instana resource to restore application alert configs to a prior version
+const ResourceInstanaApplicationAlertConfigRestore = "instana_application_alert_config_restore"
+
+const (
+	//AlertConfigRestoreFieldAlertConfigID constant value for the schema field alert_config_id
+	AlertConfigRestoreFieldAlertConfigID = "alert_config_id"
+	//AlertConfigRestoreFieldCreated constant value for the schema field created
+	AlertConfigRestoreFieldCreated = "created"
+)
+
+// NewApplicationAlertConfigRestoreResourceHandle creates the resource handle to restore application alert configs to a prior version
+func NewApplicationAlertConfigRestoreResourceHandle() ResourceHandle[*restapi.AlertConfigRestore] {
+	return &applicationAlertConfigRestoreResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaApplicationAlertConfigRestore,
+			Schema: map[string]*schema.Schema{
+				AlertConfigRestoreFieldAlertConfigID: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The ID of the application alert config which should be restored",
+				},
+				AlertConfigRestoreFieldCreated: {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The creation time of the version to which the application alert config should be restored as unix timestamp in milliseconds. Changing the value restores the application alert config to the given version",
+				},
+			},
+			SchemaVersion: 0,
+			WriteOnly:     true,
+		},
+	}
+}
+
+type applicationAlertConfigRestoreResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *applicationAlertConfigRestoreResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *applicationAlertConfigRestoreResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *applicationAlertConfigRestoreResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.AlertConfigRestore] {
+	return api.ApplicationAlertConfigRestores()
+}
+
+func (r *applicationAlertConfigRestoreResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *applicationAlertConfigRestoreResource) UpdateState(d *schema.ResourceData, restore *restapi.AlertConfigRestore) error {
+	d.SetId(restore.ID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		AlertConfigRestoreFieldAlertConfigID: restore.AlertConfigID,
+		AlertConfigRestoreFieldCreated:       int(restore.Created),
+	})
+}
+
+func (r *applicationAlertConfigRestoreResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.AlertConfigRestore, error) {
+	return &restapi.AlertConfigRestore{
+		ID:            d.Id(),
+		AlertConfigID: d.Get(AlertConfigRestoreFieldAlertConfigID).(string),
+		Created:       int64(d.Get(AlertConfigRestoreFieldCreated).(int)),
+	}, nil
+}
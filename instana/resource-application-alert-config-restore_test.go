@@ -0,0 +1,128 @@
+package instana_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+const applicationAlertConfigRestoreTerraformTemplate = `
+resource "instana_application_alert_config_restore" "example" {
+	alert_config_id = "alert-config-id"
+	created         = %d
+}
+`
+
+const applicationAlertConfigRestoreDefinition = "instana_application_alert_config_restore.example"
+
+func TestCRUDOfApplicationAlertConfigRestore(t *testing.T) {
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodPut, restapi.ApplicationAlertConfigsResourcePath+"/{id}/restore/{created}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			createApplicationAlertConfigRestoreTestStep(httpServer.GetPort(), 1000),
+			createApplicationAlertConfigRestoreTestStep(httpServer.GetPort(), 2000),
+		},
+	})
+}
+
+func createApplicationAlertConfigRestoreTestStep(httpPort int, created int) resource.TestStep {
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(applicationAlertConfigRestoreTerraformTemplate, created), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttrSet(applicationAlertConfigRestoreDefinition, "id"),
+			resource.TestCheckResourceAttr(applicationAlertConfigRestoreDefinition, AlertConfigRestoreFieldAlertConfigID, "alert-config-id"),
+			resource.TestCheckResourceAttr(applicationAlertConfigRestoreDefinition, AlertConfigRestoreFieldCreated, fmt.Sprintf("%d", created)),
+		),
+	}
+}
+
+func TestResourceApplicationAlertConfigRestoreDefinition(t *testing.T) {
+	schemaMap := NewApplicationAlertConfigRestoreResourceHandle().MetaData().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(AlertConfigRestoreFieldAlertConfigID)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeInt(AlertConfigRestoreFieldCreated)
+	require.True(t, schemaMap[AlertConfigRestoreFieldAlertConfigID].ForceNew)
+	require.False(t, schemaMap[AlertConfigRestoreFieldCreated].ForceNew)
+}
+
+func TestApplicationAlertConfigRestoreShouldBeWriteOnlyResource(t *testing.T) {
+	resourceHandle := NewApplicationAlertConfigRestoreResourceHandle()
+
+	require.True(t, resourceHandle.MetaData().WriteOnly)
+	require.Nil(t, NewTerraformResource(resourceHandle).ToSchemaResource().Importer)
+}
+
+func TestShouldRestoreApplicationAlertConfigOnCreate(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.AlertConfigRestore](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
+		restoreAPI := mocks.NewMockRestResource[*restapi.AlertConfigRestore](ctrl)
+		mockInstanaAPI.EXPECT().ApplicationAlertConfigRestores().Times(1).Return(restoreAPI)
+		restoreAPI.EXPECT().Create(gomock.Any()).Times(1).DoAndReturn(func(data *restapi.AlertConfigRestore) (*restapi.AlertConfigRestore, error) {
+			require.Equal(t, "alert-config-id", data.AlertConfigID)
+			require.Equal(t, int64(1234), data.Created)
+			return data, nil
+		})
+
+		resourceHandle := NewApplicationAlertConfigRestoreResourceHandle()
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+		setValueOnResourceData(t, resourceData, AlertConfigRestoreFieldAlertConfigID, "alert-config-id")
+		setValueOnResourceData(t, resourceData, AlertConfigRestoreFieldCreated, 1234)
+
+		diag := NewTerraformResource(resourceHandle).Create(context.TODO(), resourceData, providerMeta)
+
+		require.Nil(t, diag)
+		require.NotEmpty(t, resourceData.Id())
+		require.Equal(t, "alert-config-id", resourceData.Get(AlertConfigRestoreFieldAlertConfigID))
+		require.Equal(t, 1234, resourceData.Get(AlertConfigRestoreFieldCreated))
+	})
+}
+
+func TestShouldUpdateResourceStateForApplicationAlertConfigRestore(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.AlertConfigRestore](t)
+	resourceHandle := NewApplicationAlertConfigRestoreResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	data := restapi.AlertConfigRestore{
+		ID:            "id",
+		AlertConfigID: "alert-config-id",
+		Created:       1234,
+	}
+
+	err := resourceHandle.UpdateState(resourceData, &data)
+
+	require.NoError(t, err)
+	require.Equal(t, "id", resourceData.Id())
+	require.Equal(t, "alert-config-id", resourceData.Get(AlertConfigRestoreFieldAlertConfigID))
+	require.Equal(t, 1234, resourceData.Get(AlertConfigRestoreFieldCreated))
+}
+
+func TestShouldConvertStateOfApplicationAlertConfigRestoreToDataModel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.AlertConfigRestore](t)
+	resourceHandle := NewApplicationAlertConfigRestoreResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId("id")
+	setValueOnResourceData(t, resourceData, AlertConfigRestoreFieldAlertConfigID, "alert-config-id")
+	setValueOnResourceData(t, resourceData, AlertConfigRestoreFieldCreated, 1234)
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.Equal(t, &restapi.AlertConfigRestore{ID: "id", AlertConfigID: "alert-config-id", Created: 1234}, result)
+}
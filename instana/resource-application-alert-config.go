@@ -5,6 +5,7 @@ import (
 	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
 	"github.com/gessnerfl/terraform-provider-instana/instana/tagfilter"
 	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -37,6 +38,8 @@ const (
 	ApplicationAlertConfigFieldBoundaryScope = "boundary_scope"
 	//ApplicationAlertConfigFieldDescription constant value for field description of resource instana_application_alert_config
 	ApplicationAlertConfigFieldDescription = "description"
+	//ApplicationAlertConfigFieldEnabled constant value for field enabled of resource instana_application_alert_config
+	ApplicationAlertConfigFieldEnabled = "enabled"
 	//ApplicationAlertConfigFieldEvaluationType constant value for field evaluation_type of resource instana_application_alert_config
 	ApplicationAlertConfigFieldEvaluationType = "evaluation_type"
 	//ApplicationAlertConfigFieldGranularity constant value for field granularity of resource instana_application_alert_config
@@ -465,6 +468,12 @@ var (
 			},
 		},
 	}
+	applicationAlertConfigSchemaEnabled = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Optional flag to indicate whether the alert configuration is enabled or not. The default is true",
+	}
 	applicationAlertConfigSchemaTriggering = &schema.Schema{
 		Type:        schema.TypeBool,
 		Optional:    true,
@@ -479,6 +488,7 @@ var applicationAlertConfigResourceSchema = map[string]*schema.Schema{
 	ApplicationAlertConfigFieldBoundaryScope:    applicationAlertConfigSchemaBoundaryScope,
 	DefaultCustomPayloadFieldsName:              buildCustomPayloadFields(),
 	ApplicationAlertConfigFieldDescription:      applicationAlertConfigSchemaDescription,
+	ApplicationAlertConfigFieldEnabled:          applicationAlertConfigSchemaEnabled,
 	ApplicationAlertConfigFieldEvaluationType:   applicationAlertConfigSchemaEvaluationType,
 	ApplicationAlertConfigFieldGranularity:      applicationAlertConfigSchemaGranularity,
 	ApplicationAlertConfigFieldIncludeInternal:  applicationAlertConfigSchemaIncludeInternal,
@@ -494,16 +504,26 @@ var applicationAlertConfigResourceSchema = map[string]*schema.Schema{
 
 // NewApplicationAlertConfigResourceHandle creates a new instance of the ResourceHandle for application alert configs
 func NewApplicationAlertConfigResourceHandle() ResourceHandle[*restapi.ApplicationAlertConfig] {
+	resourceSchema := make(map[string]*schema.Schema, len(applicationAlertConfigResourceSchema)+1)
+	for k, v := range applicationAlertConfigResourceSchema {
+		resourceSchema[k] = v
+	}
+	resourceSchema[ResourceFieldBaselineRefreshTrigger] = baselineRefreshTriggerSchema
 	return &applicationAlertConfigResource{
 		metaData: ResourceMetaData{
 			ResourceName:     ResourceInstanaApplicationAlertConfig,
-			Schema:           applicationAlertConfigResourceSchema,
+			Schema:           resourceSchema,
 			SkipIDGeneration: true,
 			SchemaVersion:    1,
+			TagCatalogValidation: &TagCatalogValidation{
+				Catalog:         ApplicationTagCatalog,
+				TagFilterFields: []string{ApplicationAlertConfigFieldTagFilter},
+			},
 		},
 		resourceProvider: func(api restapi.InstanaAPI) restapi.RestResource[*restapi.ApplicationAlertConfig] {
 			return api.ApplicationAlertConfigs()
 		},
+		baselineRefreshSupported: true,
 	}
 }
 
@@ -514,6 +534,10 @@ func NewGlobalApplicationAlertConfigResourceHandle() ResourceHandle[*restapi.App
 			ResourceName:  ResourceInstanaGlobalApplicationAlertConfig,
 			Schema:        applicationAlertConfigResourceSchema,
 			SchemaVersion: 1,
+			TagCatalogValidation: &TagCatalogValidation{
+				Catalog:         ApplicationTagCatalog,
+				TagFilterFields: []string{ApplicationAlertConfigFieldTagFilter},
+			},
 		},
 		resourceProvider: func(api restapi.InstanaAPI) restapi.RestResource[*restapi.ApplicationAlertConfig] {
 			return api.GlobalApplicationAlertConfigs()
@@ -522,8 +546,9 @@ func NewGlobalApplicationAlertConfigResourceHandle() ResourceHandle[*restapi.App
 }
 
 type applicationAlertConfigResource struct {
-	metaData         ResourceMetaData
-	resourceProvider func(api restapi.InstanaAPI) restapi.RestResource[*restapi.ApplicationAlertConfig]
+	metaData                 ResourceMetaData
+	resourceProvider         func(api restapi.InstanaAPI) restapi.RestResource[*restapi.ApplicationAlertConfig]
+	baselineRefreshSupported bool
 }
 
 func (r *applicationAlertConfigResource) MetaData() *ResourceMetaData {
@@ -562,7 +587,7 @@ func (r *applicationAlertConfigResource) UpdateState(d *schema.ResourceData, con
 	}
 
 	d.SetId(config.ID)
-	return tfutils.UpdateState(d, map[string]interface{}{
+	data := map[string]interface{}{
 		ApplicationAlertConfigFieldAlertChannelIDs:  config.AlertChannelIDs,
 		ApplicationAlertConfigFieldApplications:     r.mapApplicationsToSchema(config),
 		ApplicationAlertConfigFieldBoundaryScope:    config.BoundaryScope,
@@ -579,7 +604,11 @@ func (r *applicationAlertConfigResource) UpdateState(d *schema.ResourceData, con
 		ResourceFieldThreshold:                      newThresholdMapper().toState(&config.Threshold),
 		ApplicationAlertConfigFieldTimeThreshold:    r.mapTimeThresholdToSchema(config),
 		ApplicationAlertConfigFieldTriggering:       config.Triggering,
-	})
+	}
+	if config.Enabled != nil {
+		data[ApplicationAlertConfigFieldEnabled] = *config.Enabled
+	}
+	return tfutils.UpdateState(d, data)
 }
 
 func (r *applicationAlertConfigResource) mapApplicationsToSchema(config *restapi.ApplicationAlertConfig) []interface{} {
@@ -733,6 +762,7 @@ func (r *applicationAlertConfigResource) MapStateToDataObject(d *schema.Resource
 		BoundaryScope:         restapi.BoundaryScope(d.Get(ApplicationAlertConfigFieldBoundaryScope).(string)),
 		CustomerPayloadFields: customPayloadFields,
 		Description:           d.Get(ApplicationAlertConfigFieldDescription).(string),
+		Enabled:               utils.BoolPtr(d.Get(ApplicationAlertConfigFieldEnabled).(bool)),
 		EvaluationType:        restapi.ApplicationAlertEvaluationType(d.Get(ApplicationAlertConfigFieldEvaluationType).(string)),
 		Granularity:           restapi.Granularity(d.Get(ApplicationAlertConfigFieldGranularity).(int)),
 		IncludeInternal:       d.Get(ApplicationAlertConfigFieldIncludeInternal).(bool),
@@ -744,6 +774,7 @@ func (r *applicationAlertConfigResource) MapStateToDataObject(d *schema.Resource
 		Threshold:             *threshold,
 		TimeThreshold:         r.mapTimeThresholdFromSchema(d),
 		Triggering:            d.Get(ApplicationAlertConfigFieldTriggering).(bool),
+		RefreshBaseline:       r.baselineRefreshSupported && d.HasChange(ResourceFieldBaselineRefreshTrigger),
 	}, nil
 }
 
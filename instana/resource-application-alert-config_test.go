@@ -8,6 +8,7 @@ import (
 	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
 	"github.com/gessnerfl/terraform-provider-instana/testutils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
 	"net/http"
 	"testing"
 )
@@ -17,6 +18,64 @@ func TestApplicationAlertConfig(t *testing.T) {
 	commonTests.run(t)
 }
 
+func TestApplicationAlertConfigShouldSupportBaselineRefreshTrigger(t *testing.T) {
+	schemaMap := NewApplicationAlertConfigResourceHandle().MetaData().Schema
+
+	testutils.NewTerraformSchemaAssert(schemaMap, t).AssertSchemaIsOptionalAndOfTypeString(ResourceFieldBaselineRefreshTrigger)
+	require.NotContains(t, NewGlobalApplicationAlertConfigResourceHandle().MetaData().Schema, ResourceFieldBaselineRefreshTrigger)
+}
+
+func TestShouldRequestBaselineRefreshOfApplicationAlertConfigWhenBaselineRefreshTriggerIsChanged(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ApplicationAlertConfig](t)
+	sut := NewApplicationAlertConfigResourceHandle()
+
+	data := createMinimalApplicationAlertConfigResourceData()
+	result, err := sut.MapStateToDataObject(testHelper.CreateResourceDataForResourceHandle(sut, data))
+	require.NoError(t, err)
+	require.False(t, result.RefreshBaseline)
+
+	data[ResourceFieldBaselineRefreshTrigger] = "trigger-1"
+	result, err = sut.MapStateToDataObject(testHelper.CreateResourceDataForResourceHandle(sut, data))
+	require.NoError(t, err)
+	require.True(t, result.RefreshBaseline)
+}
+
+func createMinimalApplicationAlertConfigResourceData() map[string]interface{} {
+	return map[string]interface{}{
+		ApplicationAlertConfigFieldName:     "name",
+		ApplicationAlertConfigFieldSeverity: restapi.SeverityWarning.GetTerraformRepresentation(),
+		ApplicationAlertConfigFieldRule: []interface{}{
+			map[string]interface{}{
+				ApplicationAlertConfigFieldRuleThroughput: []interface{}{
+					map[string]interface{}{
+						ApplicationAlertConfigFieldRuleMetricName:  "calls",
+						ApplicationAlertConfigFieldRuleAggregation: string(restapi.SumAggregation),
+					},
+				},
+			},
+		},
+		ResourceFieldThreshold: []interface{}{
+			map[string]interface{}{
+				ResourceFieldThresholdStatic: []interface{}{
+					map[string]interface{}{
+						ResourceFieldThresholdOperator:    string(restapi.ThresholdOperatorGreaterThan),
+						ResourceFieldThresholdStaticValue: 1.0,
+					},
+				},
+			},
+		},
+		ApplicationAlertConfigFieldTimeThreshold: []interface{}{
+			map[string]interface{}{
+				ApplicationAlertConfigFieldTimeThresholdViolationsInSequence: []interface{}{
+					map[string]interface{}{
+						ApplicationAlertConfigFieldTimeThresholdTimeWindow: 600000,
+					},
+				},
+			},
+		},
+	}
+}
+
 const issue141Template = `
 	resource "instana_application_alert_config" "issue141" {
   name              = "name %d"
@@ -74,6 +133,7 @@ const issue141JsonResponse = `
   },
   "severity": 5,
   "triggering": false,
+  "enabled": true,
   "tagFilterExpression": {
     "type": "EXPRESSION",
     "logicalOperator": "AND",
@@ -142,6 +202,7 @@ func TestIssue141(t *testing.T) {
 		testutils.EchoHandlerFunc(w, r)
 	})
 	httpServer.AddRoute(http.MethodDelete, resourceInstanceRestAPIPath, testutils.EchoHandlerFunc)
+	httpServer.AddRoute(http.MethodPut, resourceInstanceRestAPIPath+"/enable", testutils.EchoHandlerFunc)
 	httpServer.AddRoute(http.MethodGet, resourceInstanceRestAPIPath, func(w http.ResponseWriter, r *http.Request) {
 		modCount := httpServer.GetCallCount(http.MethodPost, resourceRestAPIPath+"/"+id)
 		jsonData := fmt.Sprintf(issue141JsonResponse, id, modCount)
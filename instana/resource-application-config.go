@@ -80,6 +80,10 @@ func NewApplicationConfigResourceHandle() ResourceHandle[*restapi.ApplicationCon
 				ApplicationConfigFieldTagFilter:     ApplicationConfigTagFilter,
 			},
 			SchemaVersion: 4,
+			TagCatalogValidation: &TagCatalogValidation{
+				Catalog:         ApplicationTagCatalog,
+				TagFilterFields: []string{ApplicationConfigFieldTagFilter},
+			},
 		},
 	}
 }
@@ -0,0 +1,118 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaBuiltinEventSpecificationState the name of the terraform-provider-instana resource to manage the enabled state of builtin event specifications
+const ResourceInstanaBuiltinEventSpecificationState = "instana_builtin_event_spec_state"
+
+const (
+	//BuiltinEventSpecificationStateFieldShortPluginID constant value for the schema field short_plugin_id
+	BuiltinEventSpecificationStateFieldShortPluginID = "short_plugin_id"
+	//BuiltinEventSpecificationStateFieldName constant value for the schema field name
+	BuiltinEventSpecificationStateFieldName = "name"
+	//BuiltinEventSpecificationStateFieldEnabled constant value for the schema field enabled
+	BuiltinEventSpecificationStateFieldEnabled = "enabled"
+	//BuiltinEventSpecificationStateFieldOriginalEnabled constant value for the computed schema field original_enabled
+	BuiltinEventSpecificationStateFieldOriginalEnabled = "original_enabled"
+)
+
+// NewBuiltinEventSpecificationStateResourceHandle creates the resource handle for the enabled state of builtin event specifications
+func NewBuiltinEventSpecificationStateResourceHandle() ResourceHandle[*restapi.BuiltinEventSpecificationState] {
+	return &builtinEventSpecificationStateResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaBuiltinEventSpecificationState,
+			Schema: map[string]*schema.Schema{
+				BuiltinEventSpecificationStateFieldShortPluginID: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The plugin id of the builtin event",
+				},
+				BuiltinEventSpecificationStateFieldName: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The name of the builtin event",
+				},
+				BuiltinEventSpecificationStateFieldEnabled: {
+					Type:        schema.TypeBool,
+					Required:    true,
+					Description: "Flag to indicate whether the builtin event is enabled or not",
+				},
+				BuiltinEventSpecificationStateFieldOriginalEnabled: {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "The enabled state of the builtin event before it was managed by terraform. It is restored when the resource is destroyed",
+				},
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+		},
+	}
+}
+
+type builtinEventSpecificationStateResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *builtinEventSpecificationStateResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *builtinEventSpecificationStateResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *builtinEventSpecificationStateResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.BuiltinEventSpecificationState] {
+	return api.BuiltinEventSpecificationStates()
+}
+
+func (r *builtinEventSpecificationStateResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *builtinEventSpecificationStateResource) UpdateState(d *schema.ResourceData, state *restapi.BuiltinEventSpecificationState) error {
+	data := map[string]interface{}{
+		BuiltinEventSpecificationStateFieldShortPluginID: state.ShortPluginID,
+		BuiltinEventSpecificationStateFieldName:          state.Name,
+		BuiltinEventSpecificationStateFieldEnabled:       state.Enabled,
+	}
+	if state.OriginalEnabled != nil {
+		data[BuiltinEventSpecificationStateFieldOriginalEnabled] = *state.OriginalEnabled
+	}
+
+	d.SetId(state.ID)
+	return tfutils.UpdateState(d, data)
+}
+
+func (r *builtinEventSpecificationStateResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.BuiltinEventSpecificationState, error) {
+	return &restapi.BuiltinEventSpecificationState{
+		ID:              d.Id(),
+		ShortPluginID:   d.Get(BuiltinEventSpecificationStateFieldShortPluginID).(string),
+		Name:            d.Get(BuiltinEventSpecificationStateFieldName).(string),
+		Enabled:         d.Get(BuiltinEventSpecificationStateFieldEnabled).(bool),
+		OriginalEnabled: r.getOriginalEnabledFromState(d),
+	}, nil
+}
+
+// getOriginalEnabledFromState returns the original enabled state from the terraform state. The raw state is used to
+// distinguish between an original state of false and an unknown original state (e.g. after an import).
+func (r *builtinEventSpecificationStateResource) getOriginalEnabledFromState(d *schema.ResourceData) *bool {
+	rawState := d.GetRawState()
+	if rawState.IsNull() || !rawState.IsKnown() {
+		return nil
+	}
+	value := rawState.GetAttr(BuiltinEventSpecificationStateFieldOriginalEnabled)
+	if value.IsNull() || !value.IsKnown() {
+		return nil
+	}
+	originalEnabled := value.True()
+	return &originalEnabled
+}
@@ -0,0 +1,180 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+const builtinEventSpecificationStateTerraformTemplate = `
+resource "instana_builtin_event_spec_state" "example" {
+	short_plugin_id = "host"
+	name            = "System load too high"
+	enabled         = %t
+}
+`
+
+const (
+	builtinEventSpecificationStateDefinition = "instana_builtin_event_spec_state.example"
+	builtinEventSpecificationStateID         = "builtin-event-id"
+)
+
+func TestCRUDOfBuiltinEventSpecificationState(t *testing.T) {
+	spec := &restapi.BuiltinEventSpecification{ID: builtinEventSpecificationStateID, ShortPluginID: "host", Name: "System load too high", Enabled: true}
+	httpServer := testutils.NewTestHTTPServer()
+	writeSpec := func(w http.ResponseWriter) {
+		data, _ := json.Marshal(spec)
+		httpServer.WriteJSONResponse(w, data)
+	}
+	httpServer.AddRoute(http.MethodGet, restapi.BuiltinEventSpecificationResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		data, _ := json.Marshal([]*restapi.BuiltinEventSpecification{spec})
+		httpServer.WriteJSONResponse(w, data)
+	})
+	httpServer.AddRoute(http.MethodGet, restapi.BuiltinEventSpecificationResourcePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		writeSpec(w)
+	})
+	httpServer.AddRoute(http.MethodPost, restapi.BuiltinEventSpecificationResourcePath+"/{id}/{operation}", func(w http.ResponseWriter, r *http.Request) {
+		spec.Enabled = mux.Vars(r)["operation"] == "enable"
+		writeSpec(w)
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			createBuiltinEventSpecificationStateTestStep(httpServer.GetPort(), false),
+			createBuiltinEventSpecificationStateTestStep(httpServer.GetPort(), true),
+		},
+	})
+	require.True(t, spec.Enabled)
+}
+
+func createBuiltinEventSpecificationStateTestStep(httpPort int, enabled bool) resource.TestStep {
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(builtinEventSpecificationStateTerraformTemplate, enabled), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(builtinEventSpecificationStateDefinition, "id", builtinEventSpecificationStateID),
+			resource.TestCheckResourceAttr(builtinEventSpecificationStateDefinition, BuiltinEventSpecificationStateFieldShortPluginID, "host"),
+			resource.TestCheckResourceAttr(builtinEventSpecificationStateDefinition, BuiltinEventSpecificationStateFieldName, "System load too high"),
+			resource.TestCheckResourceAttr(builtinEventSpecificationStateDefinition, BuiltinEventSpecificationStateFieldEnabled, fmt.Sprintf("%t", enabled)),
+			resource.TestCheckResourceAttr(builtinEventSpecificationStateDefinition, BuiltinEventSpecificationStateFieldOriginalEnabled, "true"),
+		),
+	}
+}
+
+func TestResourceBuiltinEventSpecificationStateDefinition(t *testing.T) {
+	schemaMap := NewBuiltinEventSpecificationStateResourceHandle().MetaData().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(BuiltinEventSpecificationStateFieldShortPluginID)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(BuiltinEventSpecificationStateFieldName)
+	require.Equal(t, schema.TypeBool, schemaMap[BuiltinEventSpecificationStateFieldEnabled].Type)
+	require.True(t, schemaMap[BuiltinEventSpecificationStateFieldEnabled].Required)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeBool(BuiltinEventSpecificationStateFieldOriginalEnabled)
+}
+
+func TestShouldUpdateResourceStateForBuiltinEventSpecificationState(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.BuiltinEventSpecificationState](t)
+	resourceHandle := NewBuiltinEventSpecificationStateResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	originalEnabled := true
+	data := restapi.BuiltinEventSpecificationState{
+		ID:              builtinEventSpecificationStateID,
+		ShortPluginID:   "host",
+		Name:            "System load too high",
+		Enabled:         false,
+		OriginalEnabled: &originalEnabled,
+	}
+
+	err := resourceHandle.UpdateState(resourceData, &data)
+
+	require.NoError(t, err)
+	require.Equal(t, builtinEventSpecificationStateID, resourceData.Id())
+	require.Equal(t, "host", resourceData.Get(BuiltinEventSpecificationStateFieldShortPluginID))
+	require.Equal(t, "System load too high", resourceData.Get(BuiltinEventSpecificationStateFieldName))
+	require.False(t, resourceData.Get(BuiltinEventSpecificationStateFieldEnabled).(bool))
+	require.True(t, resourceData.Get(BuiltinEventSpecificationStateFieldOriginalEnabled).(bool))
+}
+
+func TestShouldKeepOriginalEnabledStateOfBuiltinEventSpecificationStateWhenItIsNotProvided(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.BuiltinEventSpecificationState](t)
+	resourceHandle := NewBuiltinEventSpecificationStateResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, BuiltinEventSpecificationStateFieldOriginalEnabled, true)
+
+	err := resourceHandle.UpdateState(resourceData, &restapi.BuiltinEventSpecificationState{ID: builtinEventSpecificationStateID, Enabled: false})
+
+	require.NoError(t, err)
+	require.True(t, resourceData.Get(BuiltinEventSpecificationStateFieldOriginalEnabled).(bool))
+}
+
+func TestShouldConvertStateOfBuiltinEventSpecificationStateToDataModelWithoutOriginalEnabledStateWhenStateIsEmpty(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.BuiltinEventSpecificationState](t)
+	resourceHandle := NewBuiltinEventSpecificationStateResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(builtinEventSpecificationStateID)
+	setValueOnResourceData(t, resourceData, BuiltinEventSpecificationStateFieldShortPluginID, "host")
+	setValueOnResourceData(t, resourceData, BuiltinEventSpecificationStateFieldName, "System load too high")
+	setValueOnResourceData(t, resourceData, BuiltinEventSpecificationStateFieldEnabled, true)
+
+	model, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.Equal(t, &restapi.BuiltinEventSpecificationState{
+		ID:            builtinEventSpecificationStateID,
+		ShortPluginID: "host",
+		Name:          "System load too high",
+		Enabled:       true,
+	}, model)
+}
+
+func TestShouldConvertStateOfBuiltinEventSpecificationStateToDataModelWithOriginalEnabledStateFromRawState(t *testing.T) {
+	for _, originalEnabled := range []bool{true, false} {
+		t.Run(fmt.Sprintf("original enabled %t", originalEnabled), func(t *testing.T) {
+			resourceHandle := NewBuiltinEventSpecificationStateResourceHandle()
+			state := &terraform.InstanceState{
+				ID: builtinEventSpecificationStateID,
+				Attributes: map[string]string{
+					BuiltinEventSpecificationStateFieldShortPluginID:   "host",
+					BuiltinEventSpecificationStateFieldName:            "System load too high",
+					BuiltinEventSpecificationStateFieldEnabled:         "false",
+					BuiltinEventSpecificationStateFieldOriginalEnabled: fmt.Sprintf("%t", originalEnabled),
+				},
+				RawState: cty.ObjectVal(map[string]cty.Value{
+					"id": cty.StringVal(builtinEventSpecificationStateID),
+					BuiltinEventSpecificationStateFieldShortPluginID:   cty.StringVal("host"),
+					BuiltinEventSpecificationStateFieldName:            cty.StringVal("System load too high"),
+					BuiltinEventSpecificationStateFieldEnabled:         cty.False,
+					BuiltinEventSpecificationStateFieldOriginalEnabled: cty.BoolVal(originalEnabled),
+				}),
+			}
+			resourceData, err := schema.InternalMap(resourceHandle.MetaData().Schema).Data(state, nil)
+			require.NoError(t, err)
+
+			model, err := resourceHandle.MapStateToDataObject(resourceData)
+
+			require.NoError(t, err)
+			require.NotNil(t, model.OriginalEnabled)
+			require.Equal(t, originalEnabled, *model.OriginalEnabled)
+		})
+	}
+}
+
+func TestShouldReturnCorrectResourceNameForBuiltinEventSpecificationState(t *testing.T) {
+	name := NewBuiltinEventSpecificationStateResourceHandle().MetaData().ResourceName
+
+	require.Equal(t, "instana_builtin_event_spec_state", name)
+}
@@ -0,0 +1,88 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaGroupMapping the name of the terraform-provider-instana resource to manage mappings of identity provider groups to groups for role based access control
+const ResourceInstanaGroupMapping = "instana_rbac_group_mapping"
+
+const (
+	//GroupMappingFieldGroupID constant value for the schema field group_id
+	GroupMappingFieldGroupID = "group_id"
+	//GroupMappingFieldKey constant value for the schema field key
+	GroupMappingFieldKey = "key"
+	//GroupMappingFieldValue constant value for the schema field value
+	GroupMappingFieldValue = "value"
+)
+
+// NewGroupMappingResourceHandle creates the resource handle for RBAC group mappings
+func NewGroupMappingResourceHandle() ResourceHandle[*restapi.GroupMapping] {
+	return &groupMappingResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaGroupMapping,
+			Schema: map[string]*schema.Schema{
+				GroupMappingFieldGroupID: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The ID of the Instana group to which the users of the identity provider group are assigned",
+				},
+				GroupMappingFieldKey: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 65536),
+					Description:  "The key of the identity provider attribute (e.g. the LDAP or SAML attribute name)",
+				},
+				GroupMappingFieldValue: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The value of the identity provider attribute which users must have to be assigned to the group",
+				},
+			},
+			SchemaVersion: 0,
+		},
+	}
+}
+
+type groupMappingResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *groupMappingResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *groupMappingResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *groupMappingResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.GroupMapping] {
+	return api.GroupMappings()
+}
+
+func (r *groupMappingResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *groupMappingResource) UpdateState(d *schema.ResourceData, mapping *restapi.GroupMapping) error {
+	d.SetId(mapping.ID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		GroupMappingFieldGroupID: mapping.GroupID,
+		GroupMappingFieldKey:     mapping.Key,
+		GroupMappingFieldValue:   mapping.Value,
+	})
+}
+
+func (r *groupMappingResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.GroupMapping, error) {
+	return &restapi.GroupMapping{
+		ID:      d.Id(),
+		GroupID: d.Get(GroupMappingFieldGroupID).(string),
+		Key:     d.Get(GroupMappingFieldKey).(string),
+		Value:   d.Get(GroupMappingFieldValue).(string),
+	}, nil
+}
@@ -0,0 +1,127 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+const groupMappingTerraformTemplate = `
+resource "instana_rbac_group_mapping" "example" {
+	group_id = "group-id"
+	key      = "memberOf"
+	value    = "cn=group-%d,ou=groups,dc=example,dc=com"
+}
+`
+
+const groupMappingDefinition = "instana_rbac_group_mapping.example"
+
+func TestCRUDOfGroupMapping(t *testing.T) {
+	id := RandomID()
+	var serverState *restapi.GroupMapping
+	httpServer := testutils.NewTestHTTPServer()
+	storeAndEcho := func(w http.ResponseWriter, r *http.Request) {
+		mapping := &restapi.GroupMapping{}
+		err := json.NewDecoder(r.Body).Decode(mapping)
+		if err != nil {
+			httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		mapping.ID = id
+		serverState = mapping
+		data, _ := json.Marshal(mapping)
+		httpServer.WriteJSONResponse(w, data)
+	}
+	httpServer.AddRoute(http.MethodPost, restapi.GroupMappingsResourcePath, storeAndEcho)
+	httpServer.AddRoute(http.MethodPut, restapi.GroupMappingsResourcePath+"/{id}", storeAndEcho)
+	httpServer.AddRoute(http.MethodDelete, restapi.GroupMappingsResourcePath+"/{id}", testutils.EchoHandlerFunc)
+	httpServer.AddRoute(http.MethodGet, restapi.GroupMappingsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		data, _ := json.Marshal([]*restapi.GroupMapping{serverState})
+		httpServer.WriteJSONResponse(w, data)
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			createGroupMappingTestStep(httpServer.GetPort(), 0, id),
+			testStepImportWithCustomID(groupMappingDefinition, id),
+			createGroupMappingTestStep(httpServer.GetPort(), 1, id),
+			testStepImportWithCustomID(groupMappingDefinition, id),
+		},
+	})
+}
+
+func createGroupMappingTestStep(httpPort int, iteration int, id string) resource.TestStep {
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(groupMappingTerraformTemplate, iteration), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(groupMappingDefinition, "id", id),
+			resource.TestCheckResourceAttr(groupMappingDefinition, GroupMappingFieldGroupID, "group-id"),
+			resource.TestCheckResourceAttr(groupMappingDefinition, GroupMappingFieldKey, "memberOf"),
+			resource.TestCheckResourceAttr(groupMappingDefinition, GroupMappingFieldValue, fmt.Sprintf("cn=group-%d,ou=groups,dc=example,dc=com", iteration)),
+		),
+	}
+}
+
+func TestResourceGroupMappingDefinition(t *testing.T) {
+	schemaMap := NewGroupMappingResourceHandle().MetaData().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(GroupMappingFieldGroupID)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(GroupMappingFieldKey)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(GroupMappingFieldValue)
+}
+
+func TestShouldUpdateResourceStateForGroupMapping(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.GroupMapping](t)
+	resourceHandle := NewGroupMappingResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	data := restapi.GroupMapping{
+		ID:      "id",
+		GroupID: "group-id",
+		Key:     "memberOf",
+		Value:   "admins",
+	}
+
+	err := resourceHandle.UpdateState(resourceData, &data)
+
+	require.NoError(t, err)
+	require.Equal(t, "id", resourceData.Id())
+	require.Equal(t, "group-id", resourceData.Get(GroupMappingFieldGroupID))
+	require.Equal(t, "memberOf", resourceData.Get(GroupMappingFieldKey))
+	require.Equal(t, "admins", resourceData.Get(GroupMappingFieldValue))
+}
+
+func TestShouldConvertStateOfGroupMappingToDataModel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.GroupMapping](t)
+	resourceHandle := NewGroupMappingResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId("id")
+	setValueOnResourceData(t, resourceData, GroupMappingFieldGroupID, "group-id")
+	setValueOnResourceData(t, resourceData, GroupMappingFieldKey, "memberOf")
+	setValueOnResourceData(t, resourceData, GroupMappingFieldValue, "admins")
+
+	model, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.Equal(t, "id", model.GetIDForResourcePath())
+	require.Equal(t, "group-id", model.GroupID)
+	require.Equal(t, "memberOf", model.Key)
+	require.Equal(t, "admins", model.Value)
+}
+
+func TestShouldReturnCorrectResourceNameForGroupMapping(t *testing.T) {
+	name := NewGroupMappingResourceHandle().MetaData().ResourceName
+
+	require.Equal(t, "instana_rbac_group_mapping", name)
+}
@@ -0,0 +1,118 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+const groupMembershipTerraformTemplate = `
+resource "instana_rbac_group_membership" "example" {
+	group_id = "group-id"
+	user_id  = "user-id"
+}
+`
+
+const groupMembershipDefinition = "instana_rbac_group_membership.example"
+
+func TestCRUDOfGroupMembership(t *testing.T) {
+	group := &restapi.Group{ID: "group-id", Name: "group", Members: []restapi.APIMember{{UserID: "other-user-id"}}}
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodPut, restapi.GroupsResourcePath+"/{id}/users", func(w http.ResponseWriter, r *http.Request) {
+		userIDs := make([]string, 0)
+		err := json.NewDecoder(r.Body).Decode(&userIDs)
+		if err != nil {
+			httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		for _, userID := range userIDs {
+			group.Members = append(group.Members, restapi.APIMember{UserID: userID})
+		}
+		data, _ := json.Marshal(group)
+		httpServer.WriteJSONResponse(w, data)
+	})
+	httpServer.AddRoute(http.MethodDelete, restapi.GroupsResourcePath+"/{id}/user/{userId}", func(w http.ResponseWriter, r *http.Request) {
+		userID := mux.Vars(r)["userId"]
+		members := make([]restapi.APIMember, 0)
+		for _, m := range group.Members {
+			if m.UserID != userID {
+				members = append(members, m)
+			}
+		}
+		group.Members = members
+		testutils.EchoHandlerFunc(w, r)
+	})
+	httpServer.AddRoute(http.MethodGet, restapi.GroupsResourcePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		data, _ := json.Marshal(group)
+		httpServer.WriteJSONResponse(w, data)
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: appendProviderConfig(groupMembershipTerraformTemplate, httpServer.GetPort()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(groupMembershipDefinition, "id", "group-id:user-id"),
+					resource.TestCheckResourceAttr(groupMembershipDefinition, GroupMembershipFieldGroupID, "group-id"),
+					resource.TestCheckResourceAttr(groupMembershipDefinition, GroupMembershipFieldUserID, "user-id"),
+				),
+			},
+			testStepImportWithCustomID(groupMembershipDefinition, "group-id:user-id"),
+		},
+	})
+}
+
+func TestResourceGroupMembershipDefinition(t *testing.T) {
+	metaData := NewGroupMembershipResourceHandle().MetaData()
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(metaData.Schema, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(GroupMembershipFieldGroupID)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(GroupMembershipFieldUserID)
+	require.True(t, metaData.Schema[GroupMembershipFieldGroupID].ForceNew)
+	require.True(t, metaData.Schema[GroupMembershipFieldUserID].ForceNew)
+	require.True(t, metaData.CreateOnly)
+	require.True(t, metaData.SkipIDGeneration)
+}
+
+func TestShouldUpdateResourceStateForGroupMembership(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.GroupMembership](t)
+	resourceHandle := NewGroupMembershipResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+
+	err := resourceHandle.UpdateState(resourceData, &restapi.GroupMembership{GroupID: "group-id", UserID: "user-id"})
+
+	require.NoError(t, err)
+	require.Equal(t, "group-id:user-id", resourceData.Id())
+	require.Equal(t, "group-id", resourceData.Get(GroupMembershipFieldGroupID))
+	require.Equal(t, "user-id", resourceData.Get(GroupMembershipFieldUserID))
+}
+
+func TestShouldConvertStateOfGroupMembershipToDataModel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.GroupMembership](t)
+	resourceHandle := NewGroupMembershipResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, GroupMembershipFieldGroupID, "group-id")
+	setValueOnResourceData(t, resourceData, GroupMembershipFieldUserID, "user-id")
+
+	model, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.Equal(t, &restapi.GroupMembership{GroupID: "group-id", UserID: "user-id"}, model)
+}
+
+func TestShouldReturnCorrectResourceNameForGroupMembership(t *testing.T) {
+	name := NewGroupMembershipResourceHandle().MetaData().ResourceName
+
+	require.Equal(t, "instana_rbac_group_membership", name)
+}
@@ -0,0 +1,105 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaGroupPermissions the name of the terraform-provider-instana resource to manage the permissions of a group for role based access control
+const ResourceInstanaGroupPermissions = "instana_rbac_group_permissions"
+
+const (
+	//GroupPermissionsFieldGroupID constant value for the schema field group_id
+	GroupPermissionsFieldGroupID = "group_id"
+	//GroupPermissionsFieldPermissions constant value for the schema field permissions
+	GroupPermissionsFieldPermissions = "permissions"
+)
+
+// NewGroupPermissionsResourceHandle creates the resource handle for RBAC group permissions
+func NewGroupPermissionsResourceHandle() ResourceHandle[*restapi.GroupPermissions] {
+	return &groupPermissionsResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaGroupPermissions,
+			Schema: map[string]*schema.Schema{
+				GroupPermissionsFieldGroupID: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The ID of the group",
+				},
+				GroupPermissionsFieldPermissions: {
+					Type:        schema.TypeSet,
+					Required:    true,
+					Description: "The permissions assigned to the users of the group",
+					MinItems:    1,
+					MaxItems:    groupMaxNumberOfSetElements,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice(restapi.SupportedInstanaPermissions.ToStringSlice(), false),
+					},
+				},
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+		},
+	}
+}
+
+type groupPermissionsResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *groupPermissionsResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *groupPermissionsResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *groupPermissionsResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.GroupPermissions] {
+	return api.GroupPermissions()
+}
+
+func (r *groupPermissionsResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *groupPermissionsResource) UpdateState(d *schema.ResourceData, permissions *restapi.GroupPermissions) error {
+	managedPermissions := d.Get(GroupPermissionsFieldPermissions).(*schema.Set)
+	permissionValues := make([]interface{}, 0, len(permissions.Permissions))
+	for _, p := range permissions.Permissions {
+		if managedPermissions.Len() == 0 || managedPermissions.Contains(string(p)) {
+			permissionValues = append(permissionValues, string(p))
+		}
+	}
+
+	d.SetId(permissions.GroupID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		GroupPermissionsFieldGroupID:     permissions.GroupID,
+		GroupPermissionsFieldPermissions: schema.NewSet(schema.HashString, permissionValues),
+	})
+}
+
+func (r *groupPermissionsResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.GroupPermissions, error) {
+	oldPermissions, _ := d.GetChange(GroupPermissionsFieldPermissions)
+	permissions := d.Get(GroupPermissionsFieldPermissions).(*schema.Set)
+
+	return &restapi.GroupPermissions{
+		GroupID:            d.Get(GroupPermissionsFieldGroupID).(string),
+		Permissions:        r.toInstanaPermissions(permissions),
+		RevokedPermissions: r.toInstanaPermissions(oldPermissions.(*schema.Set).Difference(permissions)),
+	}, nil
+}
+
+func (r *groupPermissionsResource) toInstanaPermissions(permissionSet *schema.Set) []restapi.InstanaPermission {
+	permissionValues := permissionSet.List()
+	permissions := make([]restapi.InstanaPermission, len(permissionValues))
+	for i, p := range permissionValues {
+		permissions[i] = restapi.InstanaPermission(p.(string))
+	}
+	return permissions
+}
@@ -0,0 +1,191 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+const groupPermissionsTerraformTemplate = `
+resource "instana_rbac_group_permissions" "example" {
+	group_id    = "group-id"
+	permissions = [%s]
+}
+`
+
+const groupPermissionsDefinition = "instana_rbac_group_permissions.example"
+
+func TestCRUDOfGroupPermissions(t *testing.T) {
+	group := &restapi.Group{ID: "group-id", Name: "group"}
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodPut, restapi.GroupsResourcePath+"/{id}/permissions", func(w http.ResponseWriter, r *http.Request) {
+		permissions := make([]restapi.InstanaPermission, 0)
+		err := json.NewDecoder(r.Body).Decode(&permissions)
+		if err != nil {
+			httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		assignedPermissions := make(map[restapi.InstanaPermission]bool)
+		for _, p := range group.PermissionSet.Permissions {
+			assignedPermissions[p] = true
+		}
+		for _, p := range permissions {
+			if !assignedPermissions[p] {
+				group.PermissionSet.Permissions = append(group.PermissionSet.Permissions, p)
+			}
+		}
+		data, _ := json.Marshal(group)
+		httpServer.WriteJSONResponse(w, data)
+	})
+	httpServer.AddRoute(http.MethodPut, restapi.GroupsResourcePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		updatedGroup := &restapi.Group{}
+		err := json.NewDecoder(r.Body).Decode(updatedGroup)
+		if err != nil {
+			httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		group = updatedGroup
+		data, _ := json.Marshal(group)
+		httpServer.WriteJSONResponse(w, data)
+	})
+	httpServer.AddRoute(http.MethodGet, restapi.GroupsResourcePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		data, _ := json.Marshal(group)
+		httpServer.WriteJSONResponse(w, data)
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: appendProviderConfig(fmt.Sprintf(groupPermissionsTerraformTemplate, `"CAN_CONFIGURE_AGENTS"`), httpServer.GetPort()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(groupPermissionsDefinition, "id", "group-id"),
+					resource.TestCheckResourceAttr(groupPermissionsDefinition, GroupPermissionsFieldGroupID, "group-id"),
+					resource.TestCheckResourceAttr(groupPermissionsDefinition, GroupPermissionsFieldPermissions+".#", "1"),
+					resource.TestCheckTypeSetElemAttr(groupPermissionsDefinition, GroupPermissionsFieldPermissions+".*", "CAN_CONFIGURE_AGENTS"),
+				),
+			},
+			testStepImportWithCustomID(groupPermissionsDefinition, "group-id"),
+			{
+				PreConfig: func() {
+					group.PermissionSet.Permissions = append(group.PermissionSet.Permissions, restapi.PermissionCanViewAuditLog)
+				},
+				Config: appendProviderConfig(fmt.Sprintf(groupPermissionsTerraformTemplate, `"CAN_CONFIGURE_AGENTS", "CAN_VIEW_LOGS"`), httpServer.GetPort()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(groupPermissionsDefinition, GroupPermissionsFieldPermissions+".#", "2"),
+					resource.TestCheckTypeSetElemAttr(groupPermissionsDefinition, GroupPermissionsFieldPermissions+".*", "CAN_CONFIGURE_AGENTS"),
+					resource.TestCheckTypeSetElemAttr(groupPermissionsDefinition, GroupPermissionsFieldPermissions+".*", "CAN_VIEW_LOGS"),
+				),
+			},
+			{
+				Config: appendProviderConfig(fmt.Sprintf(groupPermissionsTerraformTemplate, `"CAN_VIEW_LOGS"`), httpServer.GetPort()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(groupPermissionsDefinition, GroupPermissionsFieldPermissions+".#", "1"),
+					resource.TestCheckTypeSetElemAttr(groupPermissionsDefinition, GroupPermissionsFieldPermissions+".*", "CAN_VIEW_LOGS"),
+					func(_ *terraform.State) error {
+						expectedPermissions := []restapi.InstanaPermission{restapi.PermissionCanViewAuditLog, restapi.PermissionCanViewLogs}
+						if !assert.ElementsMatch(t, expectedPermissions, group.PermissionSet.Permissions) {
+							return fmt.Errorf("expected permissions %v of group but got %v", expectedPermissions, group.PermissionSet.Permissions)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGroupPermissionsDefinition(t *testing.T) {
+	schemaMap := NewGroupPermissionsResourceHandle().MetaData().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(GroupPermissionsFieldGroupID)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeSetOfStrings(GroupPermissionsFieldPermissions)
+	require.True(t, schemaMap[GroupPermissionsFieldGroupID].ForceNew)
+}
+
+func TestShouldUpdateResourceStateForGroupPermissions(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.GroupPermissions](t)
+	resourceHandle := NewGroupPermissionsResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	data := restapi.GroupPermissions{
+		GroupID:     "group-id",
+		Permissions: []restapi.InstanaPermission{restapi.PermissionCanConfigureAgents, restapi.PermissionCanViewLogs},
+	}
+
+	err := resourceHandle.UpdateState(resourceData, &data)
+
+	require.NoError(t, err)
+	require.Equal(t, "group-id", resourceData.Id())
+	require.Equal(t, "group-id", resourceData.Get(GroupPermissionsFieldGroupID))
+	require.ElementsMatch(t, []interface{}{"CAN_CONFIGURE_AGENTS", "CAN_VIEW_LOGS"}, resourceData.Get(GroupPermissionsFieldPermissions).(*schema.Set).List())
+}
+
+func TestShouldOnlyUpdateManagedPermissionsInResourceStateForGroupPermissions(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.GroupPermissions](t)
+	resourceHandle := NewGroupPermissionsResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, GroupPermissionsFieldPermissions, []interface{}{"CAN_CONFIGURE_AGENTS", "CAN_VIEW_LOGS"})
+	data := restapi.GroupPermissions{
+		GroupID:     "group-id",
+		Permissions: []restapi.InstanaPermission{restapi.PermissionCanConfigureAgents, restapi.PermissionCanViewAuditLog},
+	}
+
+	err := resourceHandle.UpdateState(resourceData, &data)
+
+	require.NoError(t, err)
+	require.ElementsMatch(t, []interface{}{"CAN_CONFIGURE_AGENTS"}, resourceData.Get(GroupPermissionsFieldPermissions).(*schema.Set).List())
+}
+
+func TestShouldConvertStateOfGroupPermissionsToDataModel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.GroupPermissions](t)
+	resourceHandle := NewGroupPermissionsResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, GroupPermissionsFieldGroupID, "group-id")
+	setValueOnResourceData(t, resourceData, GroupPermissionsFieldPermissions, []interface{}{"CAN_CONFIGURE_AGENTS", "CAN_VIEW_LOGS"})
+
+	model, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.Equal(t, "group-id", model.GetIDForResourcePath())
+	require.ElementsMatch(t, []restapi.InstanaPermission{restapi.PermissionCanConfigureAgents, restapi.PermissionCanViewLogs}, model.Permissions)
+}
+
+func TestShouldMapRemovedPermissionsOfGroupPermissionsToRevokedPermissions(t *testing.T) {
+	resourceHandle := NewGroupPermissionsResourceHandle()
+	resourceData := (&schema.Resource{Schema: resourceHandle.MetaData().Schema}).Data(&terraform.InstanceState{
+		ID: "group-id",
+		Attributes: map[string]string{
+			GroupPermissionsFieldGroupID:            "group-id",
+			GroupPermissionsFieldPermissions + ".#": "2",
+			fmt.Sprintf("%s.%d", GroupPermissionsFieldPermissions, schema.HashString("CAN_CONFIGURE_AGENTS")): "CAN_CONFIGURE_AGENTS",
+			fmt.Sprintf("%s.%d", GroupPermissionsFieldPermissions, schema.HashString("CAN_VIEW_LOGS")):        "CAN_VIEW_LOGS",
+		},
+	})
+	setValueOnResourceData(t, resourceData, GroupPermissionsFieldPermissions, []interface{}{"CAN_VIEW_LOGS"})
+
+	model, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.Equal(t, []restapi.InstanaPermission{restapi.PermissionCanViewLogs}, model.Permissions)
+	require.Equal(t, []restapi.InstanaPermission{restapi.PermissionCanConfigureAgents}, model.RevokedPermissions)
+}
+
+func TestShouldReturnCorrectResourceNameForGroupPermissions(t *testing.T) {
+	name := NewGroupPermissionsResourceHandle().MetaData().ResourceName
+
+	require.Equal(t, "instana_rbac_group_permissions", name)
+}
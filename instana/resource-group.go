@@ -40,6 +40,14 @@ const (
 	GroupFieldPermissionSetWebsiteIDs = "website_ids"
 	//GroupFieldPermissionSetPermissions constant value for the schema field permissions
 	GroupFieldPermissionSetPermissions = "permissions"
+	//GroupFieldIgnoreUnmanagedMembers constant value for the schema field ignore_unmanaged_members
+	GroupFieldIgnoreUnmanagedMembers = "ignore_unmanaged_members"
+	//GroupFieldIgnoreUnmanagedPermissions constant value for the schema field ignore_unmanaged_permissions
+	GroupFieldIgnoreUnmanagedPermissions = "ignore_unmanaged_permissions"
+	//GroupFieldUnmanagedMembers constant value for the computed schema field unmanaged_members
+	GroupFieldUnmanagedMembers = "unmanaged_members"
+	//GroupFieldUnmanagedPermissions constant value for the computed schema field unmanaged_permissions
+	GroupFieldUnmanagedPermissions = "unmanaged_permissions"
 
 	groupMaxNumberOfSetElements = 1024
 
@@ -156,6 +164,34 @@ var groupSchema = map[string]*schema.Schema{
 	GroupFieldName:          groupSchemaName,
 	GroupFieldMembers:       groupSchemaMembers,
 	GroupFieldPermissionSet: groupSchemaPermissionSet,
+	GroupFieldIgnoreUnmanagedMembers: {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Flag to indicate whether members of the group which are not defined in this resource (e.g. managed by instana_rbac_group_membership) are ignored and kept",
+	},
+	GroupFieldIgnoreUnmanagedPermissions: {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Flag to indicate whether permissions of the group which are not defined in this resource (e.g. managed by instana_rbac_group_permissions) are ignored and kept",
+	},
+	GroupFieldUnmanagedMembers: {
+		Type:        schema.TypeSet,
+		Computed:    true,
+		Description: "The user IDs of the members of the group which are not managed by this resource. Only populated when ignore_unmanaged_members is enabled",
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	},
+	GroupFieldUnmanagedPermissions: {
+		Type:        schema.TypeSet,
+		Computed:    true,
+		Description: "The permissions of the group which are not managed by this resource. Only populated when ignore_unmanaged_permissions is enabled",
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	},
 }
 
 // NewGroupResourceHandle creates the resource handle for RBAC Groups
@@ -201,12 +237,26 @@ func (r *groupResource) UpdateState(d *schema.ResourceData, group *restapi.Group
 		GroupFieldName: group.Name,
 	}
 
-	members := r.convertGroupMembersToState(group)
+	groupMembers := group.Members
+	unmanagedMembers := make([]interface{}, 0)
+	if d.Get(GroupFieldIgnoreUnmanagedMembers).(bool) {
+		groupMembers, unmanagedMembers = r.splitManagedAndUnmanagedMembers(d, group.Members)
+	}
+	data[GroupFieldUnmanagedMembers] = schema.NewSet(schema.HashString, unmanagedMembers)
+
+	permissionSet := group.PermissionSet
+	unmanagedPermissions := make([]interface{}, 0)
+	if d.Get(GroupFieldIgnoreUnmanagedPermissions).(bool) {
+		permissionSet.Permissions, unmanagedPermissions = r.splitManagedAndUnmanagedPermissions(d, group.PermissionSet.Permissions)
+	}
+	data[GroupFieldUnmanagedPermissions] = schema.NewSet(schema.HashString, unmanagedPermissions)
+
+	members := r.convertGroupMembersToState(groupMembers)
 	if members != nil {
 		data[GroupFieldMembers] = members
 	}
-	if !group.PermissionSet.IsEmpty() {
-		permissions := r.convertPermissionSetToState(group)
+	if !permissionSet.IsEmpty() {
+		permissions := r.convertPermissionSetToState(permissionSet)
 		data[GroupFieldPermissionSet] = permissions
 	}
 
@@ -214,9 +264,45 @@ func (r *groupResource) UpdateState(d *schema.ResourceData, group *restapi.Group
 	return tfutils.UpdateState(d, data)
 }
 
-func (r *groupResource) convertGroupMembersToState(obj *restapi.Group) *schema.Set {
-	result := make([]interface{}, len(obj.Members))
-	for i, v := range obj.Members {
+func (r *groupResource) splitManagedAndUnmanagedMembers(d *schema.ResourceData, members []restapi.APIMember) ([]restapi.APIMember, []interface{}) {
+	managedUserIDs := make(map[string]bool)
+	for _, m := range r.convertStateToGroupMembers(d) {
+		managedUserIDs[m.UserID] = true
+	}
+
+	managed := make([]restapi.APIMember, 0)
+	unmanaged := make([]interface{}, 0)
+	for _, m := range members {
+		if managedUserIDs[m.UserID] {
+			managed = append(managed, m)
+		} else {
+			unmanaged = append(unmanaged, m.UserID)
+		}
+	}
+	return managed, unmanaged
+}
+
+func (r *groupResource) splitManagedAndUnmanagedPermissions(d *schema.ResourceData, permissions []restapi.InstanaPermission) ([]restapi.InstanaPermission, []interface{}) {
+	managedPermissions := make(map[restapi.InstanaPermission]bool)
+	for _, p := range r.convertStateToPermissionSet(d).Permissions {
+		managedPermissions[p] = true
+	}
+
+	managed := make([]restapi.InstanaPermission, 0)
+	unmanaged := make([]interface{}, 0)
+	for _, p := range permissions {
+		if managedPermissions[p] {
+			managed = append(managed, p)
+		} else {
+			unmanaged = append(unmanaged, string(p))
+		}
+	}
+	return managed, unmanaged
+}
+
+func (r *groupResource) convertGroupMembersToState(groupMembers []restapi.APIMember) *schema.Set {
+	result := make([]interface{}, len(groupMembers))
+	for i, v := range groupMembers {
 		var email string
 		if v.Email != nil {
 			email = *v.Email
@@ -233,11 +319,9 @@ func (r *groupResource) convertGroupMembersToState(obj *restapi.Group) *schema.S
 	return nil
 }
 
-func (r *groupResource) convertPermissionSetToState(obj *restapi.Group) []interface{} {
-	permissionSet := obj.PermissionSet
-
+func (r *groupResource) convertPermissionSetToState(permissionSet restapi.APIPermissionSetWithRoles) []interface{} {
 	m := make(map[string]interface{})
-	if obj.PermissionSet.InfraDFQFilter != nil && len(obj.PermissionSet.InfraDFQFilter.ScopeID) > 0 {
+	if permissionSet.InfraDFQFilter != nil && len(permissionSet.InfraDFQFilter.ScopeID) > 0 {
 		m[GroupFieldPermissionSetInfraDFQFilter] = permissionSet.InfraDFQFilter.ScopeID
 	}
 	m[GroupFieldPermissionSetApplicationIDs] = r.convertScopeBindingSliceToState(permissionSet.ApplicationIDs)
@@ -259,7 +343,13 @@ func (r *groupResource) convertScopeBindingSliceToState(value []restapi.ScopeBin
 
 func (r *groupResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.Group, error) {
 	members := r.convertStateToGroupMembers(d)
+	if d.Get(GroupFieldIgnoreUnmanagedMembers).(bool) {
+		members = r.appendUnmanagedMembers(d, members)
+	}
 	permissionSet := r.convertStateToPermissionSet(d)
+	if d.Get(GroupFieldIgnoreUnmanagedPermissions).(bool) {
+		permissionSet.Permissions = r.appendUnmanagedPermissions(d, permissionSet.Permissions)
+	}
 	return &restapi.Group{
 		ID:            d.Id(),
 		Name:          d.Get(GroupFieldName).(string),
@@ -268,6 +358,34 @@ func (r *groupResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.G
 	}, nil
 }
 
+func (r *groupResource) appendUnmanagedMembers(d *schema.ResourceData, members []restapi.APIMember) []restapi.APIMember {
+	userIDs := make(map[string]bool)
+	for _, m := range members {
+		userIDs[m.UserID] = true
+	}
+	for _, v := range d.Get(GroupFieldUnmanagedMembers).(*schema.Set).List() {
+		userID := v.(string)
+		if !userIDs[userID] {
+			members = append(members, restapi.APIMember{UserID: userID})
+		}
+	}
+	return members
+}
+
+func (r *groupResource) appendUnmanagedPermissions(d *schema.ResourceData, permissions []restapi.InstanaPermission) []restapi.InstanaPermission {
+	existingPermissions := make(map[restapi.InstanaPermission]bool)
+	for _, p := range permissions {
+		existingPermissions[p] = true
+	}
+	for _, v := range d.Get(GroupFieldUnmanagedPermissions).(*schema.Set).List() {
+		permission := restapi.InstanaPermission(v.(string))
+		if !existingPermissions[permission] {
+			permissions = append(permissions, permission)
+		}
+	}
+	return permissions
+}
+
 func (r *groupResource) convertStateToGroupMembers(d *schema.ResourceData) []restapi.APIMember {
 	if val, ok := d.GetOk(GroupFieldMembers); ok {
 		if set, ok := val.(*schema.Set); ok {
@@ -268,6 +268,10 @@ func TestResourceGroupDefinition(t *testing.T) {
 
 	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
 	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(GroupFieldName)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(GroupFieldIgnoreUnmanagedMembers, false)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(GroupFieldIgnoreUnmanagedPermissions, false)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeSetOfStrings(GroupFieldUnmanagedMembers)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeSetOfStrings(GroupFieldUnmanagedPermissions)
 	verifyGroupMemberSchema(t, schemaMap[GroupFieldMembers])
 	verifyGroupPermissionSetSchema(t, schemaMap[GroupFieldPermissionSet])
 }
@@ -477,3 +481,105 @@ func TestGroupResourceShouldReadModelFromState(t *testing.T) {
 	}
 	require.Equal(t, expectedMembers, result.Members)
 }
+
+func TestShouldKeepUnmanagedMembersAndPermissionsOutOfStateWhenIgnoreFlagsAreEnabled(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.Group](t)
+	resourceHandle := NewGroupResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, GroupFieldIgnoreUnmanagedMembers, true)
+	setValueOnResourceData(t, resourceData, GroupFieldIgnoreUnmanagedPermissions, true)
+	setValueOnResourceData(t, resourceData, GroupFieldMembers, []interface{}{
+		map[string]interface{}{GroupFieldMemberUserID: defaultGroupMember1UserID, GroupFieldMemberEmail: defaultGroupMember1Email},
+	})
+	setValueOnResourceData(t, resourceData, GroupFieldPermissionSet, []interface{}{
+		map[string]interface{}{
+			GroupFieldPermissionSetPermissions: schema.NewSet(schema.HashString, []interface{}{string(restapi.PermissionCanConfigureAgents)}),
+		},
+	})
+
+	member1Email := defaultGroupMember1Email
+	group := restapi.Group{
+		ID:   defaultGroupID,
+		Name: defaultGroupName,
+		Members: []restapi.APIMember{
+			{UserID: defaultGroupMember1UserID, Email: &member1Email},
+			{UserID: defaultGroupMember2UserID},
+		},
+		PermissionSet: restapi.APIPermissionSetWithRoles{
+			Permissions: []restapi.InstanaPermission{restapi.PermissionCanConfigureAgents, restapi.PermissionCanViewLogs},
+		},
+	}
+
+	err := resourceHandle.UpdateState(resourceData, &group)
+
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{
+		map[string]interface{}{GroupFieldMemberUserID: defaultGroupMember1UserID, GroupFieldMemberEmail: defaultGroupMember1Email},
+	}, resourceData.Get(GroupFieldMembers).(*schema.Set).List())
+	require.Equal(t, []interface{}{defaultGroupMember2UserID}, resourceData.Get(GroupFieldUnmanagedMembers).(*schema.Set).List())
+	permissionSet := resourceData.Get(GroupFieldPermissionSet).([]interface{})[0].(map[string]interface{})
+	require.Equal(t, []interface{}{string(restapi.PermissionCanConfigureAgents)}, permissionSet[GroupFieldPermissionSetPermissions].(*schema.Set).List())
+	require.Equal(t, []interface{}{string(restapi.PermissionCanViewLogs)}, resourceData.Get(GroupFieldUnmanagedPermissions).(*schema.Set).List())
+}
+
+func TestShouldNotTrackUnmanagedMembersAndPermissionsWhenIgnoreFlagsAreDisabled(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.Group](t)
+	resourceHandle := NewGroupResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	group := restapi.Group{
+		ID:      defaultGroupID,
+		Name:    defaultGroupName,
+		Members: []restapi.APIMember{{UserID: defaultGroupMember1UserID}},
+		PermissionSet: restapi.APIPermissionSetWithRoles{
+			Permissions: []restapi.InstanaPermission{restapi.PermissionCanViewLogs},
+		},
+	}
+
+	err := resourceHandle.UpdateState(resourceData, &group)
+
+	require.NoError(t, err)
+	require.Len(t, resourceData.Get(GroupFieldMembers).(*schema.Set).List(), 1)
+	require.Empty(t, resourceData.Get(GroupFieldUnmanagedMembers).(*schema.Set).List())
+	require.Empty(t, resourceData.Get(GroupFieldUnmanagedPermissions).(*schema.Set).List())
+}
+
+func TestGroupResourceShouldKeepUnmanagedMembersAndPermissionsWhenReadingModelFromStateAndIgnoreFlagsAreEnabled(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.Group](t)
+	resourceHandle := NewGroupResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(defaultGroupID)
+	setValueOnResourceData(t, resourceData, GroupFieldName, defaultGroupName)
+	setValueOnResourceData(t, resourceData, GroupFieldIgnoreUnmanagedMembers, true)
+	setValueOnResourceData(t, resourceData, GroupFieldIgnoreUnmanagedPermissions, true)
+	setValueOnResourceData(t, resourceData, GroupFieldMembers, []interface{}{
+		map[string]interface{}{GroupFieldMemberUserID: defaultGroupMember1UserID, GroupFieldMemberEmail: defaultGroupMember1Email},
+	})
+	setValueOnResourceData(t, resourceData, GroupFieldUnmanagedMembers, []interface{}{defaultGroupMember1UserID, defaultGroupMember2UserID})
+	setValueOnResourceData(t, resourceData, GroupFieldUnmanagedPermissions, []interface{}{string(restapi.PermissionCanViewLogs)})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	member1Email := defaultGroupMember1Email
+	require.Equal(t, []restapi.APIMember{
+		{UserID: defaultGroupMember1UserID, Email: &member1Email},
+		{UserID: defaultGroupMember2UserID},
+	}, result.Members)
+	require.Equal(t, []restapi.InstanaPermission{restapi.PermissionCanViewLogs}, result.PermissionSet.Permissions)
+}
+
+func TestGroupResourceShouldDropUnmanagedMembersAndPermissionsWhenReadingModelFromStateAndIgnoreFlagsAreDisabled(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.Group](t)
+	resourceHandle := NewGroupResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(defaultGroupID)
+	setValueOnResourceData(t, resourceData, GroupFieldName, defaultGroupName)
+	setValueOnResourceData(t, resourceData, GroupFieldUnmanagedMembers, []interface{}{defaultGroupMember2UserID})
+	setValueOnResourceData(t, resourceData, GroupFieldUnmanagedPermissions, []interface{}{string(restapi.PermissionCanViewLogs)})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.Empty(t, result.Members)
+	require.Empty(t, result.PermissionSet.Permissions)
+}
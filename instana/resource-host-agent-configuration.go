@@ -0,0 +1,113 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaHostAgentConfiguration the name of the terraform-provider-instana resource to manage the configuration management settings of host agents
+const ResourceInstanaHostAgentConfiguration = "instana_host_agent_configuration"
+
+const (
+	//HostAgentConfigurationFieldHostID constant value for the schema field host_id
+	HostAgentConfigurationFieldHostID = "host_id"
+	//HostAgentConfigurationFieldQuery constant value for the schema field query
+	HostAgentConfigurationFieldQuery = "query"
+	//HostAgentConfigurationFieldRemoteURI constant value for the schema field remote_uri
+	HostAgentConfigurationFieldRemoteURI = "remote_uri"
+	//HostAgentConfigurationFieldRemoteName constant value for the schema field remote_name
+	HostAgentConfigurationFieldRemoteName = "remote_name"
+	//HostAgentConfigurationFieldRemoteBranch constant value for the schema field remote_branch
+	HostAgentConfigurationFieldRemoteBranch = "remote_branch"
+)
+
+// NewHostAgentConfigurationResourceHandle creates the resource handle for the configuration management settings of host agents
+func NewHostAgentConfigurationResourceHandle() ResourceHandle[*restapi.HostAgentConfiguration] {
+	return &hostAgentConfigurationResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaHostAgentConfiguration,
+			Schema: map[string]*schema.Schema{
+				HostAgentConfigurationFieldHostID: {
+					Type:          schema.TypeString,
+					Optional:      true,
+					ForceNew:      true,
+					ValidateFunc:  validation.StringIsNotEmpty,
+					ConflictsWith: []string{HostAgentConfigurationFieldQuery},
+					Description:   "The ID of the host of the agent which should be configured",
+				},
+				HostAgentConfigurationFieldQuery: {
+					Type:          schema.TypeString,
+					Optional:      true,
+					ForceNew:      true,
+					ValidateFunc:  validation.StringIsNotEmpty,
+					ConflictsWith: []string{HostAgentConfigurationFieldHostID},
+					Description:   "The dynamic focus query to select the agents which should be configured",
+				},
+				HostAgentConfigurationFieldRemoteURI: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 65536),
+					Description:  "The URI of the git repository from which the agents pull their configuration",
+				},
+				HostAgentConfigurationFieldRemoteName: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(1, 256),
+					Description:  "The name of the git remote",
+				},
+				HostAgentConfigurationFieldRemoteBranch: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(1, 256),
+					Description:  "The branch of the git repository from which the agents pull their configuration",
+				},
+			},
+			SchemaVersion: 0,
+			WriteOnly:     true,
+		},
+	}
+}
+
+type hostAgentConfigurationResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *hostAgentConfigurationResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *hostAgentConfigurationResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *hostAgentConfigurationResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.HostAgentConfiguration] {
+	return api.HostAgentConfigurations()
+}
+
+func (r *hostAgentConfigurationResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *hostAgentConfigurationResource) UpdateState(d *schema.ResourceData, config *restapi.HostAgentConfiguration) error {
+	d.SetId(config.ID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		HostAgentConfigurationFieldHostID:       config.HostID,
+		HostAgentConfigurationFieldQuery:        config.Query,
+		HostAgentConfigurationFieldRemoteURI:    config.RemoteURI,
+		HostAgentConfigurationFieldRemoteName:   config.RemoteName,
+		HostAgentConfigurationFieldRemoteBranch: config.RemoteBranch,
+	})
+}
+
+func (r *hostAgentConfigurationResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.HostAgentConfiguration, error) {
+	return &restapi.HostAgentConfiguration{
+		ID:           d.Id(),
+		HostID:       GetStringPointerFromResourceData(d, HostAgentConfigurationFieldHostID),
+		Query:        GetStringPointerFromResourceData(d, HostAgentConfigurationFieldQuery),
+		RemoteURI:    d.Get(HostAgentConfigurationFieldRemoteURI).(string),
+		RemoteName:   GetStringPointerFromResourceData(d, HostAgentConfigurationFieldRemoteName),
+		RemoteBranch: GetStringPointerFromResourceData(d, HostAgentConfigurationFieldRemoteBranch),
+	}, nil
+}
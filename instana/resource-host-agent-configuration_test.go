@@ -0,0 +1,149 @@
+package instana_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+const hostAgentConfigurationTerraformTemplate = `
+resource "instana_host_agent_configuration" "example" {
+	host_id       = "host-id"
+	remote_uri    = "https://git.example.com/agent-config.git"
+	remote_branch = "branch-%d"
+}
+`
+
+const hostAgentConfigurationDefinition = "instana_host_agent_configuration.example"
+
+func TestCRUDOfHostAgentConfiguration(t *testing.T) {
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodPost, restapi.HostAgentResourcePath+"/{hostId}/configuration", func(w http.ResponseWriter, r *http.Request) {
+		payload := make(map[string]interface{})
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		if err != nil {
+			httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			createHostAgentConfigurationTestStep(httpServer.GetPort(), 0),
+			createHostAgentConfigurationTestStep(httpServer.GetPort(), 1),
+		},
+	})
+}
+
+func createHostAgentConfigurationTestStep(httpPort int, iteration int) resource.TestStep {
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(hostAgentConfigurationTerraformTemplate, iteration), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttrSet(hostAgentConfigurationDefinition, "id"),
+			resource.TestCheckResourceAttr(hostAgentConfigurationDefinition, HostAgentConfigurationFieldHostID, "host-id"),
+			resource.TestCheckResourceAttr(hostAgentConfigurationDefinition, HostAgentConfigurationFieldRemoteURI, "https://git.example.com/agent-config.git"),
+			resource.TestCheckResourceAttr(hostAgentConfigurationDefinition, HostAgentConfigurationFieldRemoteBranch, fmt.Sprintf("branch-%d", iteration)),
+		),
+	}
+}
+
+func TestResourceHostAgentConfigurationDefinition(t *testing.T) {
+	schemaMap := NewHostAgentConfigurationResourceHandle().MetaData().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(HostAgentConfigurationFieldHostID)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(HostAgentConfigurationFieldQuery)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(HostAgentConfigurationFieldRemoteURI)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(HostAgentConfigurationFieldRemoteName)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(HostAgentConfigurationFieldRemoteBranch)
+}
+
+func TestHostAgentConfigurationShouldBeWriteOnlyResource(t *testing.T) {
+	resourceHandle := NewHostAgentConfigurationResourceHandle()
+
+	require.True(t, resourceHandle.MetaData().WriteOnly)
+	require.Nil(t, NewTerraformResource(resourceHandle).ToSchemaResource().Importer)
+}
+
+func TestShouldKeepStateOfHostAgentConfigurationOnReadWithoutCallingInstanaAPI(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.HostAgentConfiguration](t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI) {
+		resourceHandle := NewHostAgentConfigurationResourceHandle()
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+		resourceData.SetId("id")
+		setValueOnResourceData(t, resourceData, HostAgentConfigurationFieldRemoteURI, "https://git.example.com/agent-config.git")
+
+		diag := NewTerraformResource(resourceHandle).Read(context.TODO(), resourceData, providerMeta)
+
+		require.Nil(t, diag)
+		require.Equal(t, "id", resourceData.Id())
+		require.Equal(t, "https://git.example.com/agent-config.git", resourceData.Get(HostAgentConfigurationFieldRemoteURI))
+	})
+}
+
+func TestShouldUpdateResourceStateForHostAgentConfiguration(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.HostAgentConfiguration](t)
+	resourceHandle := NewHostAgentConfigurationResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	query := "entity.zone:production"
+	remoteName := "origin"
+	remoteBranch := "main"
+	data := restapi.HostAgentConfiguration{
+		ID:           "id",
+		Query:        &query,
+		RemoteURI:    "https://git.example.com/agent-config.git",
+		RemoteName:   &remoteName,
+		RemoteBranch: &remoteBranch,
+	}
+
+	err := resourceHandle.UpdateState(resourceData, &data)
+
+	require.NoError(t, err)
+	require.Equal(t, "id", resourceData.Id())
+	require.Equal(t, "", resourceData.Get(HostAgentConfigurationFieldHostID))
+	require.Equal(t, query, resourceData.Get(HostAgentConfigurationFieldQuery))
+	require.Equal(t, "https://git.example.com/agent-config.git", resourceData.Get(HostAgentConfigurationFieldRemoteURI))
+	require.Equal(t, remoteName, resourceData.Get(HostAgentConfigurationFieldRemoteName))
+	require.Equal(t, remoteBranch, resourceData.Get(HostAgentConfigurationFieldRemoteBranch))
+}
+
+func TestShouldConvertStateOfHostAgentConfigurationToDataModel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.HostAgentConfiguration](t)
+	resourceHandle := NewHostAgentConfigurationResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId("id")
+	setValueOnResourceData(t, resourceData, HostAgentConfigurationFieldHostID, "host-id")
+	setValueOnResourceData(t, resourceData, HostAgentConfigurationFieldRemoteURI, "https://git.example.com/agent-config.git")
+	setValueOnResourceData(t, resourceData, HostAgentConfigurationFieldRemoteBranch, "main")
+
+	model, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.Equal(t, "id", model.GetIDForResourcePath())
+	require.Equal(t, "host-id", *model.HostID)
+	require.Nil(t, model.Query)
+	require.Equal(t, "https://git.example.com/agent-config.git", model.RemoteURI)
+	require.Nil(t, model.RemoteName)
+	require.Equal(t, "main", *model.RemoteBranch)
+}
+
+func TestShouldReturnCorrectResourceNameForHostAgentConfiguration(t *testing.T) {
+	name := NewHostAgentConfigurationResourceHandle().MetaData().ResourceName
+
+	require.Equal(t, "instana_host_agent_configuration", name)
+}
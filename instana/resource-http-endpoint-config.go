@@ -0,0 +1,190 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaHttpEndpointConfig the name of the terraform-provider-instana resource to manage http endpoint configurations of services
+const ResourceInstanaHttpEndpointConfig = "instana_http_endpoint_config"
+
+const (
+	//HttpEndpointConfigFieldServiceID constant value for the schema field service_id
+	HttpEndpointConfigFieldServiceID = "service_id"
+	//HttpEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled constant value for the schema field endpoint_name_by_collected_path_template_rule_enabled
+	HttpEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled = "endpoint_name_by_collected_path_template_rule_enabled"
+	//HttpEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled constant value for the schema field endpoint_name_by_first_path_segment_rule_enabled
+	HttpEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled = "endpoint_name_by_first_path_segment_rule_enabled"
+	//HttpEndpointConfigFieldRule constant value for the schema field rule
+	HttpEndpointConfigFieldRule = "rule"
+	//HttpEndpointConfigFieldRuleEnabled constant value for the schema field rule.enabled
+	HttpEndpointConfigFieldRuleEnabled = "enabled"
+	//HttpEndpointConfigFieldRulePathSegment constant value for the schema field rule.path_segment
+	HttpEndpointConfigFieldRulePathSegment = "path_segment"
+	//HttpEndpointConfigFieldRulePathSegmentType constant value for the schema field rule.path_segment.type
+	HttpEndpointConfigFieldRulePathSegmentType = "type"
+	//HttpEndpointConfigFieldRulePathSegmentName constant value for the schema field rule.path_segment.name
+	HttpEndpointConfigFieldRulePathSegmentName = "name"
+	//HttpEndpointConfigFieldRuleTestCases constant value for the schema field rule.test_cases
+	HttpEndpointConfigFieldRuleTestCases = "test_cases"
+)
+
+var httpEndpointConfigServiceIDFieldName = HttpEndpointConfigFieldServiceID
+
+// NewHttpEndpointConfigResourceHandle creates the resource handle for http endpoint configurations
+func NewHttpEndpointConfigResourceHandle() ResourceHandle[*restapi.HttpEndpointConfig] {
+	return &httpEndpointConfigResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaHttpEndpointConfig,
+			Schema: map[string]*schema.Schema{
+				HttpEndpointConfigFieldServiceID: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The ID of the service the http endpoint configuration belongs to",
+				},
+				HttpEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled: {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Flag to indicate whether endpoint names are derived from the collected path templates",
+				},
+				HttpEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled: {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Flag to indicate whether endpoint names are derived from the first path segment",
+				},
+				HttpEndpointConfigFieldRule: {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "The list of custom http endpoint rules of the service. Rules are evaluated in the given order",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							HttpEndpointConfigFieldRuleEnabled: {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Default:     true,
+								Description: "Flag to indicate whether the rule is enabled or not",
+							},
+							HttpEndpointConfigFieldRulePathSegment: {
+								Type:        schema.TypeList,
+								Required:    true,
+								MinItems:    1,
+								Description: "The list of path segments of the rule",
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										HttpEndpointConfigFieldRulePathSegmentType: {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringInSlice(restapi.SupportedHttpPathSegmentMatchingRuleTypes.ToStringSlice(), false),
+											Description:  "The type of the path segment",
+										},
+										HttpEndpointConfigFieldRulePathSegmentName: {
+											Type:        schema.TypeString,
+											Optional:    true,
+											Description: "The name of the path segment. Required for the types FIXED and PARAMETER",
+										},
+									},
+								},
+							},
+							HttpEndpointConfigFieldRuleTestCases: {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "The list of example paths which are used to verify the rule",
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+						},
+					},
+				},
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+			ResourceIDField:  &httpEndpointConfigServiceIDFieldName,
+		},
+	}
+}
+
+type httpEndpointConfigResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *httpEndpointConfigResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *httpEndpointConfigResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *httpEndpointConfigResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.HttpEndpointConfig] {
+	return api.HttpEndpointConfigs()
+}
+
+func (r *httpEndpointConfigResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *httpEndpointConfigResource) UpdateState(d *schema.ResourceData, config *restapi.HttpEndpointConfig) error {
+	rules := make([]interface{}, len(config.Rules))
+	for i, rule := range config.Rules {
+		pathSegments := make([]interface{}, len(rule.PathSegments))
+		for j, segment := range rule.PathSegments {
+			pathSegments[j] = map[string]interface{}{
+				HttpEndpointConfigFieldRulePathSegmentType: string(segment.Type),
+				HttpEndpointConfigFieldRulePathSegmentName: segment.Name,
+			}
+		}
+		rules[i] = map[string]interface{}{
+			HttpEndpointConfigFieldRuleEnabled:     rule.Enabled,
+			HttpEndpointConfigFieldRulePathSegment: pathSegments,
+			HttpEndpointConfigFieldRuleTestCases:   rule.TestCases,
+		}
+	}
+
+	d.SetId(config.ServiceID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		HttpEndpointConfigFieldServiceID:                                      config.ServiceID,
+		HttpEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled: config.EndpointNameByCollectedPathTemplateRuleEnabled,
+		HttpEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled:      config.EndpointNameByFirstPathSegmentRuleEnabled,
+		HttpEndpointConfigFieldRule:                                           rules,
+	})
+}
+
+func (r *httpEndpointConfigResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.HttpEndpointConfig, error) {
+	rulesData := d.Get(HttpEndpointConfigFieldRule).([]interface{})
+	rules := make([]restapi.HttpEndpointRule, len(rulesData))
+	for i, v := range rulesData {
+		ruleData := v.(map[string]interface{})
+		pathSegmentsData := ruleData[HttpEndpointConfigFieldRulePathSegment].([]interface{})
+		pathSegments := make([]restapi.HttpPathSegmentMatchingRule, len(pathSegmentsData))
+		for j, s := range pathSegmentsData {
+			segmentData := s.(map[string]interface{})
+			pathSegments[j] = restapi.HttpPathSegmentMatchingRule{
+				Type: restapi.HttpPathSegmentMatchingRuleType(segmentData[HttpEndpointConfigFieldRulePathSegmentType].(string)),
+				Name: GetPointerFromMap[string](segmentData, HttpEndpointConfigFieldRulePathSegmentName),
+			}
+		}
+		testCases := make([]string, 0)
+		if testCasesData, ok := ruleData[HttpEndpointConfigFieldRuleTestCases]; ok {
+			testCases = ConvertInterfaceSlice[string](testCasesData.([]interface{}))
+		}
+		rules[i] = restapi.HttpEndpointRule{
+			Enabled:      ruleData[HttpEndpointConfigFieldRuleEnabled].(bool),
+			PathSegments: pathSegments,
+			TestCases:    testCases,
+		}
+	}
+
+	return &restapi.HttpEndpointConfig{
+		ServiceID: d.Get(HttpEndpointConfigFieldServiceID).(string),
+		EndpointNameByCollectedPathTemplateRuleEnabled: d.Get(HttpEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled).(bool),
+		EndpointNameByFirstPathSegmentRuleEnabled:      d.Get(HttpEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled).(bool),
+		Rules: rules,
+	}, nil
+}
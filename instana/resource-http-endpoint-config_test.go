@@ -0,0 +1,183 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+const httpEndpointConfigTerraformTemplate = `
+resource "instana_http_endpoint_config" "example" {
+	service_id = "service-id"
+	endpoint_name_by_first_path_segment_rule_enabled = false
+
+	rule {
+		path_segment {
+			type = "FIXED"
+			name = "api%d"
+		}
+
+		path_segment {
+			type = "PARAMETER"
+			name = "id"
+		}
+
+		test_cases = [ "/api%d/123" ]
+	}
+}
+`
+
+const httpEndpointConfigDefinition = "instana_http_endpoint_config.example"
+
+func TestCRUDOfHttpEndpointConfiguration(t *testing.T) {
+	resourceInstanceRestAPIPath := restapi.HttpEndpointConfigsResourcePath + "/{id}"
+	var serverState []byte
+	httpServer := testutils.NewTestHTTPServer()
+	storeAndEcho := func(w http.ResponseWriter, r *http.Request) {
+		config := &restapi.HttpEndpointConfig{}
+		err := json.NewDecoder(r.Body).Decode(config)
+		if err != nil {
+			httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		serverState, _ = json.Marshal(config)
+		httpServer.WriteJSONResponse(w, serverState)
+	}
+	httpServer.AddRoute(http.MethodPost, restapi.HttpEndpointConfigsResourcePath, storeAndEcho)
+	httpServer.AddRoute(http.MethodPut, resourceInstanceRestAPIPath, storeAndEcho)
+	httpServer.AddRoute(http.MethodDelete, resourceInstanceRestAPIPath, testutils.EchoHandlerFunc)
+	httpServer.AddRoute(http.MethodGet, resourceInstanceRestAPIPath, func(w http.ResponseWriter, r *http.Request) {
+		httpServer.WriteJSONResponse(w, serverState)
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			createHttpEndpointConfigTestStep(httpServer.GetPort(), 0),
+			testStepImportWithCustomID(httpEndpointConfigDefinition, "service-id"),
+			createHttpEndpointConfigTestStep(httpServer.GetPort(), 1),
+			testStepImportWithCustomID(httpEndpointConfigDefinition, "service-id"),
+		},
+	})
+}
+
+func createHttpEndpointConfigTestStep(httpPort int, iteration int) resource.TestStep {
+	rule := HttpEndpointConfigFieldRule + ".0."
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(httpEndpointConfigTerraformTemplate, iteration, iteration), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(httpEndpointConfigDefinition, "id", "service-id"),
+			resource.TestCheckResourceAttr(httpEndpointConfigDefinition, HttpEndpointConfigFieldServiceID, "service-id"),
+			resource.TestCheckResourceAttr(httpEndpointConfigDefinition, HttpEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled, trueAsString),
+			resource.TestCheckResourceAttr(httpEndpointConfigDefinition, HttpEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled, falseAsString),
+			resource.TestCheckResourceAttr(httpEndpointConfigDefinition, rule+HttpEndpointConfigFieldRuleEnabled, trueAsString),
+			resource.TestCheckResourceAttr(httpEndpointConfigDefinition, rule+HttpEndpointConfigFieldRulePathSegment+".0."+HttpEndpointConfigFieldRulePathSegmentType, "FIXED"),
+			resource.TestCheckResourceAttr(httpEndpointConfigDefinition, rule+HttpEndpointConfigFieldRulePathSegment+".0."+HttpEndpointConfigFieldRulePathSegmentName, fmt.Sprintf("api%d", iteration)),
+			resource.TestCheckResourceAttr(httpEndpointConfigDefinition, rule+HttpEndpointConfigFieldRulePathSegment+".1."+HttpEndpointConfigFieldRulePathSegmentType, "PARAMETER"),
+			resource.TestCheckResourceAttr(httpEndpointConfigDefinition, rule+HttpEndpointConfigFieldRulePathSegment+".1."+HttpEndpointConfigFieldRulePathSegmentName, "id"),
+			resource.TestCheckResourceAttr(httpEndpointConfigDefinition, rule+HttpEndpointConfigFieldRuleTestCases+".0", fmt.Sprintf("/api%d/123", iteration)),
+		),
+	}
+}
+
+func TestResourceHttpEndpointConfigDefinition(t *testing.T) {
+	schemaMap := NewHttpEndpointConfigResourceHandle().MetaData().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(HttpEndpointConfigFieldServiceID)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(HttpEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled, true)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(HttpEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled, true)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(HttpEndpointConfigFieldRule)
+}
+
+func TestShouldUpdateResourceStateForHttpEndpointConfig(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.HttpEndpointConfig](t)
+	resourceHandle := NewHttpEndpointConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	segmentName := "api"
+	data := restapi.HttpEndpointConfig{
+		ServiceID: "service-id",
+		EndpointNameByCollectedPathTemplateRuleEnabled: true,
+		EndpointNameByFirstPathSegmentRuleEnabled:      false,
+		Rules: []restapi.HttpEndpointRule{
+			{
+				Enabled: true,
+				PathSegments: []restapi.HttpPathSegmentMatchingRule{
+					{Type: restapi.HttpPathSegmentMatchingRuleTypeFixed, Name: &segmentName},
+					{Type: restapi.HttpPathSegmentMatchingRuleTypeMatchAll},
+				},
+				TestCases: []string{"/api/foo/bar"},
+			},
+		},
+	}
+
+	err := resourceHandle.UpdateState(resourceData, &data)
+
+	require.NoError(t, err)
+	require.Equal(t, "service-id", resourceData.Id())
+	require.Equal(t, "service-id", resourceData.Get(HttpEndpointConfigFieldServiceID))
+	require.True(t, resourceData.Get(HttpEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled).(bool))
+	require.False(t, resourceData.Get(HttpEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled).(bool))
+	require.Equal(t, []interface{}{
+		map[string]interface{}{
+			HttpEndpointConfigFieldRuleEnabled: true,
+			HttpEndpointConfigFieldRulePathSegment: []interface{}{
+				map[string]interface{}{HttpEndpointConfigFieldRulePathSegmentType: "FIXED", HttpEndpointConfigFieldRulePathSegmentName: segmentName},
+				map[string]interface{}{HttpEndpointConfigFieldRulePathSegmentType: "MATCH_ALL", HttpEndpointConfigFieldRulePathSegmentName: ""},
+			},
+			HttpEndpointConfigFieldRuleTestCases: []interface{}{"/api/foo/bar"},
+		},
+	}, resourceData.Get(HttpEndpointConfigFieldRule))
+}
+
+func TestShouldConvertStateOfHttpEndpointConfigToDataModel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.HttpEndpointConfig](t)
+	resourceHandle := NewHttpEndpointConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, HttpEndpointConfigFieldServiceID, "service-id")
+	setValueOnResourceData(t, resourceData, HttpEndpointConfigFieldRule, []interface{}{
+		map[string]interface{}{
+			HttpEndpointConfigFieldRuleEnabled: false,
+			HttpEndpointConfigFieldRulePathSegment: []interface{}{
+				map[string]interface{}{HttpEndpointConfigFieldRulePathSegmentType: "PARAMETER", HttpEndpointConfigFieldRulePathSegmentName: "id"},
+				map[string]interface{}{HttpEndpointConfigFieldRulePathSegmentType: "MATCH_ALL"},
+			},
+		},
+	})
+
+	model, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	segmentName := "id"
+	require.Equal(t, &restapi.HttpEndpointConfig{
+		ServiceID: "service-id",
+		EndpointNameByCollectedPathTemplateRuleEnabled: true,
+		EndpointNameByFirstPathSegmentRuleEnabled:      true,
+		Rules: []restapi.HttpEndpointRule{
+			{
+				Enabled: false,
+				PathSegments: []restapi.HttpPathSegmentMatchingRule{
+					{Type: restapi.HttpPathSegmentMatchingRuleTypeParameter, Name: &segmentName},
+					{Type: restapi.HttpPathSegmentMatchingRuleTypeMatchAll},
+				},
+				TestCases: []string{},
+			},
+		},
+	}, model)
+}
+
+func TestShouldReturnCorrectResourceNameForHttpEndpointConfig(t *testing.T) {
+	name := NewHttpEndpointConfigResourceHandle().MetaData().ResourceName
+
+	require.Equal(t, "instana_http_endpoint_config", name)
+}
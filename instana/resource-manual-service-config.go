@@ -0,0 +1,130 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/instana/tagfilter"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaManualServiceConfig the name of the terraform-provider-instana resource to manage manual service configurations
+const ResourceInstanaManualServiceConfig = "instana_manual_service_config"
+
+const (
+	//ManualServiceConfigFieldDescription constant value for the schema field description
+	ManualServiceConfigFieldDescription = "description"
+	//ManualServiceConfigFieldEnabled constant value for the schema field enabled
+	ManualServiceConfigFieldEnabled = "enabled"
+	//ManualServiceConfigFieldExistingServiceID constant value for the schema field existing_service_id
+	ManualServiceConfigFieldExistingServiceID = "existing_service_id"
+	//ManualServiceConfigFieldUnmonitoredServiceName constant value for the schema field unmonitored_service_name
+	ManualServiceConfigFieldUnmonitoredServiceName = "unmonitored_service_name"
+	//ManualServiceConfigFieldTagFilter constant value for the schema field tag_filter
+	ManualServiceConfigFieldTagFilter = "tag_filter"
+)
+
+var manualServiceConfigTargetServiceFields = []string{ManualServiceConfigFieldExistingServiceID, ManualServiceConfigFieldUnmonitoredServiceName}
+
+// NewManualServiceConfigResourceHandle creates the resource handle for manual service configurations
+func NewManualServiceConfigResourceHandle() ResourceHandle[*restapi.ManualServiceConfig] {
+	return &manualServiceConfigResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaManualServiceConfig,
+			Schema: map[string]*schema.Schema{
+				ManualServiceConfigFieldDescription: {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The description of the manual service configuration",
+				},
+				ManualServiceConfigFieldEnabled: {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Flag to indicate whether the manual service configuration is enabled or not",
+				},
+				ManualServiceConfigFieldExistingServiceID: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ExactlyOneOf: manualServiceConfigTargetServiceFields,
+					Description:  "The ID of an existing service to which the matching calls are mapped",
+				},
+				ManualServiceConfigFieldUnmonitoredServiceName: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ExactlyOneOf: manualServiceConfigTargetServiceFields,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+					Description:  "The name of the unmonitored service to which the matching calls are mapped",
+				},
+				ManualServiceConfigFieldTagFilter: RequiredTagFilterExpressionSchema,
+			},
+			SchemaVersion: 0,
+		},
+	}
+}
+
+type manualServiceConfigResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *manualServiceConfigResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *manualServiceConfigResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *manualServiceConfigResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.ManualServiceConfig] {
+	return api.ManualServiceConfigs()
+}
+
+func (r *manualServiceConfigResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *manualServiceConfigResource) UpdateState(d *schema.ResourceData, config *restapi.ManualServiceConfig) error {
+	data := map[string]interface{}{
+		ManualServiceConfigFieldDescription:            config.Description,
+		ManualServiceConfigFieldEnabled:                config.Enabled,
+		ManualServiceConfigFieldExistingServiceID:      config.ExistingServiceID,
+		ManualServiceConfigFieldUnmonitoredServiceName: config.UnmonitoredServiceName,
+	}
+	if config.TagFilterExpression != nil {
+		normalizedTagFilterString, err := tagfilter.MapTagFilterToNormalizedString(config.TagFilterExpression)
+		if err != nil {
+			return err
+		}
+		data[ManualServiceConfigFieldTagFilter] = normalizedTagFilterString
+	}
+
+	d.SetId(config.ID)
+	return tfutils.UpdateState(d, data)
+}
+
+func (r *manualServiceConfigResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.ManualServiceConfig, error) {
+	tagFilter, err := r.mapTagFilterStringToAPIModel(d.Get(ManualServiceConfigFieldTagFilter).(string))
+	if err != nil {
+		return &restapi.ManualServiceConfig{}, err
+	}
+
+	return &restapi.ManualServiceConfig{
+		ID:                     d.Id(),
+		Description:            GetStringPointerFromResourceData(d, ManualServiceConfigFieldDescription),
+		Enabled:                d.Get(ManualServiceConfigFieldEnabled).(bool),
+		ExistingServiceID:      GetStringPointerFromResourceData(d, ManualServiceConfigFieldExistingServiceID),
+		UnmonitoredServiceName: GetStringPointerFromResourceData(d, ManualServiceConfigFieldUnmonitoredServiceName),
+		TagFilterExpression:    tagFilter,
+	}, nil
+}
+
+func (r *manualServiceConfigResource) mapTagFilterStringToAPIModel(input string) (*restapi.TagFilter, error) {
+	parser := tagfilter.NewParser()
+	expr, err := parser.Parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	mapper := tagfilter.NewMapper()
+	return mapper.ToAPIModel(expr), nil
+}
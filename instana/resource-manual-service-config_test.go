@@ -0,0 +1,149 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+const manualServiceConfigTerraformTemplate = `
+resource "instana_manual_service_config" "example" {
+	description              = "description %d"
+	unmonitored_service_name = "service-name"
+	tag_filter               = "call.http.host@dest EQUALS 'example.com'"
+}
+`
+
+const manualServiceConfigDefinition = "instana_manual_service_config.example"
+
+func TestCRUDOfManualServiceConfiguration(t *testing.T) {
+	id := RandomID()
+	var serverState *restapi.ManualServiceConfig
+	httpServer := testutils.NewTestHTTPServer()
+	storeAndEcho := func(w http.ResponseWriter, r *http.Request) {
+		config := &restapi.ManualServiceConfig{}
+		err := json.NewDecoder(r.Body).Decode(config)
+		if err != nil {
+			httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		config.ID = id
+		serverState = config
+		data, _ := json.Marshal(config)
+		httpServer.WriteJSONResponse(w, data)
+	}
+	httpServer.AddRoute(http.MethodPost, restapi.ManualServiceConfigsResourcePath, storeAndEcho)
+	httpServer.AddRoute(http.MethodPut, restapi.ManualServiceConfigsResourcePath+"/{id}", storeAndEcho)
+	httpServer.AddRoute(http.MethodDelete, restapi.ManualServiceConfigsResourcePath+"/{id}", testutils.EchoHandlerFunc)
+	httpServer.AddRoute(http.MethodGet, restapi.ManualServiceConfigsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		data, _ := json.Marshal([]*restapi.ManualServiceConfig{serverState})
+		httpServer.WriteJSONResponse(w, data)
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			createManualServiceConfigTestStep(httpServer.GetPort(), 0, id),
+			testStepImportWithCustomID(manualServiceConfigDefinition, id),
+			createManualServiceConfigTestStep(httpServer.GetPort(), 1, id),
+			testStepImportWithCustomID(manualServiceConfigDefinition, id),
+		},
+	})
+}
+
+func createManualServiceConfigTestStep(httpPort int, iteration int, id string) resource.TestStep {
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(manualServiceConfigTerraformTemplate, iteration), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(manualServiceConfigDefinition, "id", id),
+			resource.TestCheckResourceAttr(manualServiceConfigDefinition, ManualServiceConfigFieldDescription, fmt.Sprintf("description %d", iteration)),
+			resource.TestCheckResourceAttr(manualServiceConfigDefinition, ManualServiceConfigFieldEnabled, trueAsString),
+			resource.TestCheckResourceAttr(manualServiceConfigDefinition, ManualServiceConfigFieldUnmonitoredServiceName, "service-name"),
+			resource.TestCheckResourceAttr(manualServiceConfigDefinition, ManualServiceConfigFieldTagFilter, "call.http.host@dest EQUALS 'example.com'"),
+		),
+	}
+}
+
+func TestResourceManualServiceConfigDefinition(t *testing.T) {
+	schemaMap := NewManualServiceConfigResourceHandle().MetaData().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(ManualServiceConfigFieldDescription)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(ManualServiceConfigFieldEnabled, true)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(ManualServiceConfigFieldExistingServiceID)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(ManualServiceConfigFieldUnmonitoredServiceName)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(ManualServiceConfigFieldTagFilter)
+}
+
+func TestShouldUpdateResourceStateForManualServiceConfig(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ManualServiceConfig](t)
+	resourceHandle := NewManualServiceConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	description := "description"
+	existingServiceID := "service-id"
+	value := "example.com"
+	data := restapi.ManualServiceConfig{
+		ID:                  "id",
+		Description:         &description,
+		Enabled:             true,
+		ExistingServiceID:   &existingServiceID,
+		TagFilterExpression: restapi.NewStringTagFilter(restapi.TagFilterEntityDestination, "call.http.host", restapi.EqualsOperator, value),
+	}
+
+	err := resourceHandle.UpdateState(resourceData, &data)
+
+	require.NoError(t, err)
+	require.Equal(t, "id", resourceData.Id())
+	require.Equal(t, description, resourceData.Get(ManualServiceConfigFieldDescription))
+	require.True(t, resourceData.Get(ManualServiceConfigFieldEnabled).(bool))
+	require.Equal(t, existingServiceID, resourceData.Get(ManualServiceConfigFieldExistingServiceID))
+	require.Equal(t, "", resourceData.Get(ManualServiceConfigFieldUnmonitoredServiceName))
+	require.Equal(t, "call.http.host@dest EQUALS 'example.com'", resourceData.Get(ManualServiceConfigFieldTagFilter))
+}
+
+func TestShouldConvertStateOfManualServiceConfigToDataModel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ManualServiceConfig](t)
+	resourceHandle := NewManualServiceConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId("id")
+	setValueOnResourceData(t, resourceData, ManualServiceConfigFieldEnabled, false)
+	setValueOnResourceData(t, resourceData, ManualServiceConfigFieldUnmonitoredServiceName, "service-name")
+	setValueOnResourceData(t, resourceData, ManualServiceConfigFieldTagFilter, "call.http.host@dest EQUALS 'example.com'")
+
+	model, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.Equal(t, "id", model.GetIDForResourcePath())
+	require.Nil(t, model.Description)
+	require.False(t, model.Enabled)
+	require.Nil(t, model.ExistingServiceID)
+	require.Equal(t, "service-name", *model.UnmonitoredServiceName)
+	require.Equal(t, restapi.NewStringTagFilter(restapi.TagFilterEntityDestination, "call.http.host", restapi.EqualsOperator, "example.com"), model.TagFilterExpression)
+}
+
+func TestShouldFailToConvertStateOfManualServiceConfigToDataModelWhenTagFilterIsInvalid(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ManualServiceConfig](t)
+	resourceHandle := NewManualServiceConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, ManualServiceConfigFieldTagFilter, "invalid tag filter")
+
+	_, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.Error(t, err)
+}
+
+func TestShouldReturnCorrectResourceNameForManualServiceConfig(t *testing.T) {
+	name := NewManualServiceConfigResourceHandle().MetaData().ResourceName
+
+	require.Equal(t, "instana_manual_service_config", name)
+}
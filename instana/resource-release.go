@@ -0,0 +1,187 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaRelease the name of the terraform-provider-instana resource to manage releases (deployment markers)
+const ResourceInstanaRelease = "instana_release"
+
+const (
+	//ReleaseFieldName constant value for the schema field name
+	ReleaseFieldName = "name"
+	//ReleaseFieldStart constant value for the schema field start
+	ReleaseFieldStart = "start"
+	//ReleaseFieldApplication constant value for the schema field application
+	ReleaseFieldApplication = "application"
+	//ReleaseFieldApplicationName constant value for the schema field application.name
+	ReleaseFieldApplicationName = "name"
+	//ReleaseFieldService constant value for the schema field service
+	ReleaseFieldService = "service"
+	//ReleaseFieldServiceName constant value for the schema field service.name
+	ReleaseFieldServiceName = "name"
+	//ReleaseFieldServiceScopedToApplications constant value for the schema field service.scoped_to_applications
+	ReleaseFieldServiceScopedToApplications = "scoped_to_applications"
+
+	releaseMaxNumberOfScopes = 10
+)
+
+// NewReleaseResourceHandle creates the resource handle for releases
+func NewReleaseResourceHandle() ResourceHandle[*restapi.Release] {
+	return &releaseResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaRelease,
+			Schema: map[string]*schema.Schema{
+				ReleaseFieldName: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 256),
+					Description:  "The name of the release",
+				},
+				ReleaseFieldStart: {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The start time of the release as unix timestamp in milliseconds",
+				},
+				ReleaseFieldApplication: {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    releaseMaxNumberOfScopes,
+					Description: "The applications to which the release is scoped",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							ReleaseFieldApplicationName: {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 256),
+								Description:  "The name of the application",
+							},
+						},
+					},
+				},
+				ReleaseFieldService: {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    releaseMaxNumberOfScopes,
+					Description: "The services to which the release is scoped",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							ReleaseFieldServiceName: {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 256),
+								Description:  "The name of the service",
+							},
+							ReleaseFieldServiceScopedToApplications: {
+								Type:        schema.TypeSet,
+								Optional:    true,
+								MaxItems:    releaseMaxNumberOfScopes,
+								Description: "The names of the applications to which the service scope is limited",
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: validation.StringLenBetween(1, 256),
+								},
+							},
+						},
+					},
+				},
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+		},
+	}
+}
+
+type releaseResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *releaseResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *releaseResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *releaseResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.Release] {
+	return api.Releases()
+}
+
+func (r *releaseResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *releaseResource) UpdateState(d *schema.ResourceData, release *restapi.Release) error {
+	applications := make([]interface{}, len(release.Applications))
+	for i, a := range release.Applications {
+		applications[i] = map[string]interface{}{
+			ReleaseFieldApplicationName: a.Name,
+		}
+	}
+	services := make([]interface{}, len(release.Services))
+	for i, s := range release.Services {
+		scopedToApplications := make([]interface{}, 0)
+		if s.ScopedTo != nil {
+			for _, a := range s.ScopedTo.Applications {
+				scopedToApplications = append(scopedToApplications, a.Name)
+			}
+		}
+		services[i] = map[string]interface{}{
+			ReleaseFieldServiceName:                 s.Name,
+			ReleaseFieldServiceScopedToApplications: schema.NewSet(schema.HashString, scopedToApplications),
+		}
+	}
+
+	d.SetId(release.ID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		ReleaseFieldName:        release.Name,
+		ReleaseFieldStart:       int(release.Start),
+		ReleaseFieldApplication: applications,
+		ReleaseFieldService:     services,
+	})
+}
+
+func (r *releaseResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.Release, error) {
+	applicationsData := d.Get(ReleaseFieldApplication).([]interface{})
+	applications := make([]restapi.ReleaseApplicationScope, len(applicationsData))
+	for i, v := range applicationsData {
+		application := v.(map[string]interface{})
+		applications[i] = restapi.ReleaseApplicationScope{Name: application[ReleaseFieldApplicationName].(string)}
+	}
+
+	servicesData := d.Get(ReleaseFieldService).([]interface{})
+	services := make([]restapi.ReleaseServiceScope, len(servicesData))
+	for i, v := range servicesData {
+		service := v.(map[string]interface{})
+		services[i] = restapi.ReleaseServiceScope{
+			Name:     service[ReleaseFieldServiceName].(string),
+			ScopedTo: r.mapServiceScopedToFromState(service),
+		}
+	}
+
+	return &restapi.Release{
+		ID:           d.Id(),
+		Name:         d.Get(ReleaseFieldName).(string),
+		Start:        int64(d.Get(ReleaseFieldStart).(int)),
+		Applications: applications,
+		Services:     services,
+	}, nil
+}
+
+func (r *releaseResource) mapServiceScopedToFromState(service map[string]interface{}) *restapi.ReleaseServiceScopedTo {
+	scopedToData, ok := service[ReleaseFieldServiceScopedToApplications].(*schema.Set)
+	if !ok || scopedToData.Len() == 0 {
+		return nil
+	}
+	applicationNames := ConvertInterfaceSlice[string](scopedToData.List())
+	applications := make([]restapi.ReleaseApplicationScope, len(applicationNames))
+	for i, name := range applicationNames {
+		applications[i] = restapi.ReleaseApplicationScope{Name: name}
+	}
+	return &restapi.ReleaseServiceScopedTo{Applications: applications}
+}
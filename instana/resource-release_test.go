@@ -0,0 +1,172 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+const releaseTerraformTemplate = `
+resource "instana_release" "example" {
+	name  = "release-%d"
+	start = 1680000000000
+
+	application {
+		name = "application"
+	}
+
+	service {
+		name                   = "service"
+		scoped_to_applications = [ "application" ]
+	}
+}
+`
+
+const releaseDefinition = "instana_release.example"
+
+func TestCRUDOfRelease(t *testing.T) {
+	id := RandomID()
+	var serverState *restapi.Release
+	httpServer := testutils.NewTestHTTPServer()
+	storeAndEcho := func(w http.ResponseWriter, r *http.Request) {
+		release := &restapi.Release{}
+		err := json.NewDecoder(r.Body).Decode(release)
+		if err != nil {
+			httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		release.ID = id
+		serverState = release
+		data, _ := json.Marshal(release)
+		httpServer.WriteJSONResponse(w, data)
+	}
+	httpServer.AddRoute(http.MethodPost, restapi.ReleasesResourcePath, storeAndEcho)
+	httpServer.AddRoute(http.MethodPut, restapi.ReleasesResourcePath+"/{id}", storeAndEcho)
+	httpServer.AddRoute(http.MethodDelete, restapi.ReleasesResourcePath+"/{id}", testutils.EchoHandlerFunc)
+	httpServer.AddRoute(http.MethodGet, restapi.ReleasesResourcePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		data, _ := json.Marshal(serverState)
+		httpServer.WriteJSONResponse(w, data)
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			createReleaseTestStep(httpServer.GetPort(), 0, id),
+			testStepImportWithCustomID(releaseDefinition, id),
+			createReleaseTestStep(httpServer.GetPort(), 1, id),
+			testStepImportWithCustomID(releaseDefinition, id),
+		},
+	})
+}
+
+func createReleaseTestStep(httpPort int, iteration int, id string) resource.TestStep {
+	serviceField := fmt.Sprintf("%s.0.", ReleaseFieldService)
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(releaseTerraformTemplate, iteration), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(releaseDefinition, "id", id),
+			resource.TestCheckResourceAttr(releaseDefinition, ReleaseFieldName, fmt.Sprintf("release-%d", iteration)),
+			resource.TestCheckResourceAttr(releaseDefinition, ReleaseFieldStart, "1680000000000"),
+			resource.TestCheckResourceAttr(releaseDefinition, fmt.Sprintf("%s.0.%s", ReleaseFieldApplication, ReleaseFieldApplicationName), "application"),
+			resource.TestCheckResourceAttr(releaseDefinition, serviceField+ReleaseFieldServiceName, "service"),
+			resource.TestCheckResourceAttr(releaseDefinition, serviceField+ReleaseFieldServiceScopedToApplications+".#", "1"),
+			resource.TestCheckTypeSetElemAttr(releaseDefinition, serviceField+ReleaseFieldServiceScopedToApplications+".*", "application"),
+		),
+	}
+}
+
+func TestResourceReleaseDefinition(t *testing.T) {
+	schemaMap := NewReleaseResourceHandle().MetaData().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(ReleaseFieldName)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeInt(ReleaseFieldStart)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(ReleaseFieldApplication)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(ReleaseFieldService)
+
+	applicationSchemaAssert := testutils.NewTerraformSchemaAssert(schemaMap[ReleaseFieldApplication].Elem.(*schema.Resource).Schema, t)
+	applicationSchemaAssert.AssertSchemaIsRequiredAndOfTypeString(ReleaseFieldApplicationName)
+
+	serviceSchemaAssert := testutils.NewTerraformSchemaAssert(schemaMap[ReleaseFieldService].Elem.(*schema.Resource).Schema, t)
+	serviceSchemaAssert.AssertSchemaIsRequiredAndOfTypeString(ReleaseFieldServiceName)
+	serviceSchemaAssert.AssertSchemaIsOptionalAndOfTypeSetOfStrings(ReleaseFieldServiceScopedToApplications)
+}
+
+func TestShouldUpdateResourceStateForRelease(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.Release](t)
+	resourceHandle := NewReleaseResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	data := restapi.Release{
+		ID:           "id",
+		Name:         "release",
+		Start:        1680000000000,
+		Applications: []restapi.ReleaseApplicationScope{{Name: "application"}},
+		Services: []restapi.ReleaseServiceScope{
+			{Name: "service-1", ScopedTo: &restapi.ReleaseServiceScopedTo{Applications: []restapi.ReleaseApplicationScope{{Name: "application"}}}},
+			{Name: "service-2"},
+		},
+	}
+
+	err := resourceHandle.UpdateState(resourceData, &data)
+
+	require.NoError(t, err)
+	require.Equal(t, "id", resourceData.Id())
+	require.Equal(t, "release", resourceData.Get(ReleaseFieldName))
+	require.Equal(t, 1680000000000, resourceData.Get(ReleaseFieldStart))
+	require.Equal(t, []interface{}{map[string]interface{}{ReleaseFieldApplicationName: "application"}}, resourceData.Get(ReleaseFieldApplication))
+	services := resourceData.Get(ReleaseFieldService).([]interface{})
+	require.Len(t, services, 2)
+	service1 := services[0].(map[string]interface{})
+	require.Equal(t, "service-1", service1[ReleaseFieldServiceName])
+	require.Equal(t, []interface{}{"application"}, service1[ReleaseFieldServiceScopedToApplications].(*schema.Set).List())
+	service2 := services[1].(map[string]interface{})
+	require.Equal(t, "service-2", service2[ReleaseFieldServiceName])
+	require.Equal(t, 0, service2[ReleaseFieldServiceScopedToApplications].(*schema.Set).Len())
+}
+
+func TestShouldConvertStateOfReleaseToDataModel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.Release](t)
+	resourceHandle := NewReleaseResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId("id")
+	setValueOnResourceData(t, resourceData, ReleaseFieldName, "release")
+	setValueOnResourceData(t, resourceData, ReleaseFieldStart, 1680000000000)
+	setValueOnResourceData(t, resourceData, ReleaseFieldApplication, []interface{}{
+		map[string]interface{}{ReleaseFieldApplicationName: "application"},
+	})
+	setValueOnResourceData(t, resourceData, ReleaseFieldService, []interface{}{
+		map[string]interface{}{ReleaseFieldServiceName: "service-1", ReleaseFieldServiceScopedToApplications: []interface{}{"application"}},
+		map[string]interface{}{ReleaseFieldServiceName: "service-2"},
+	})
+
+	model, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.Equal(t, &restapi.Release{
+		ID:           "id",
+		Name:         "release",
+		Start:        1680000000000,
+		Applications: []restapi.ReleaseApplicationScope{{Name: "application"}},
+		Services: []restapi.ReleaseServiceScope{
+			{Name: "service-1", ScopedTo: &restapi.ReleaseServiceScopedTo{Applications: []restapi.ReleaseApplicationScope{{Name: "application"}}}},
+			{Name: "service-2"},
+		},
+	}, model)
+}
+
+func TestShouldReturnCorrectResourceNameForRelease(t *testing.T) {
+	name := NewReleaseResourceHandle().MetaData().ResourceName
+
+	require.Equal(t, "instana_release", name)
+}
@@ -0,0 +1,111 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+const serviceConfigOrderTerraformTemplate = `
+resource "instana_service_config_order" "example" {
+	service_config_ids = [ %s ]
+}
+`
+
+const serviceConfigOrderDefinition = "instana_service_config_order.example"
+
+func TestCRUDOfServiceConfigOrder(t *testing.T) {
+	serverState := []*restapi.ServiceConfig{{ID: "id1"}, {ID: "id2"}}
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodPut, restapi.ServiceConfigsResourcePath+"/"+restapi.ServiceConfigOrderID, func(w http.ResponseWriter, r *http.Request) {
+		ids := make([]string, 0)
+		err := json.NewDecoder(r.Body).Decode(&ids)
+		if err != nil {
+			httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		serverState = make([]*restapi.ServiceConfig, len(ids))
+		for i, id := range ids {
+			serverState[i] = &restapi.ServiceConfig{ID: id}
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	httpServer.AddRoute(http.MethodGet, restapi.ServiceConfigsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		data, err := json.Marshal(serverState)
+		if err != nil {
+			httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		httpServer.WriteJSONResponse(w, data)
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			createServiceConfigOrderTestStep(httpServer.GetPort(), "id2", "id1"),
+			testStepImportWithCustomID(serviceConfigOrderDefinition, restapi.ServiceConfigOrderID),
+			createServiceConfigOrderTestStep(httpServer.GetPort(), "id1", "id2"),
+			testStepImportWithCustomID(serviceConfigOrderDefinition, restapi.ServiceConfigOrderID),
+		},
+	})
+}
+
+func createServiceConfigOrderTestStep(httpPort int, first string, second string) resource.TestStep {
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(serviceConfigOrderTerraformTemplate, fmt.Sprintf("\"%s\", \"%s\"", first, second)), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(serviceConfigOrderDefinition, "id", restapi.ServiceConfigOrderID),
+			resource.TestCheckResourceAttr(serviceConfigOrderDefinition, ServiceConfigOrderFieldServiceConfigIDs+".#", "2"),
+			resource.TestCheckResourceAttr(serviceConfigOrderDefinition, ServiceConfigOrderFieldServiceConfigIDs+".0", first),
+			resource.TestCheckResourceAttr(serviceConfigOrderDefinition, ServiceConfigOrderFieldServiceConfigIDs+".1", second),
+		),
+	}
+}
+
+func TestResourceServiceConfigOrderDefinition(t *testing.T) {
+	schemaMap := NewServiceConfigOrderResourceHandle().MetaData().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeListOfStrings(ServiceConfigOrderFieldServiceConfigIDs)
+}
+
+func TestShouldUpdateResourceStateForServiceConfigOrder(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ServiceConfigOrder](t)
+	resourceHandle := NewServiceConfigOrderResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+
+	err := resourceHandle.UpdateState(resourceData, &restapi.ServiceConfigOrder{ServiceConfigIDs: []string{"id1", "id2"}})
+
+	require.NoError(t, err)
+	require.Equal(t, restapi.ServiceConfigOrderID, resourceData.Id())
+	require.Equal(t, []interface{}{"id1", "id2"}, resourceData.Get(ServiceConfigOrderFieldServiceConfigIDs))
+}
+
+func TestShouldConvertStateOfServiceConfigOrderToDataModel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ServiceConfigOrder](t)
+	resourceHandle := NewServiceConfigOrderResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, ServiceConfigOrderFieldServiceConfigIDs, []interface{}{"id2", "id1"})
+
+	model, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.Equal(t, []string{"id2", "id1"}, model.ServiceConfigIDs)
+	require.Equal(t, restapi.ServiceConfigOrderID, model.GetIDForResourcePath())
+}
+
+func TestShouldReturnCorrectResourceNameForServiceConfigOrder(t *testing.T) {
+	name := NewServiceConfigOrderResourceHandle().MetaData().ResourceName
+
+	require.Equal(t, "instana_service_config_order", name)
+}
@@ -0,0 +1,147 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaServiceConfig the name of the terraform-provider-instana resource to manage service configurations (service mapping rules)
+const ResourceInstanaServiceConfig = "instana_service_config"
+
+const (
+	//ServiceConfigFieldName constant value for the schema field name
+	ServiceConfigFieldName = "name"
+	//ServiceConfigFieldLabel constant value for the schema field label
+	ServiceConfigFieldLabel = "label"
+	//ServiceConfigFieldComment constant value for the schema field comment
+	ServiceConfigFieldComment = "comment"
+	//ServiceConfigFieldEnabled constant value for the schema field enabled
+	ServiceConfigFieldEnabled = "enabled"
+	//ServiceConfigFieldMatchSpecification constant value for the schema field match_specification
+	ServiceConfigFieldMatchSpecification = "match_specification"
+	//ServiceConfigFieldMatchSpecificationKey constant value for the schema field match_specification.key
+	ServiceConfigFieldMatchSpecificationKey = "key"
+	//ServiceConfigFieldMatchSpecificationValue constant value for the schema field match_specification.value
+	ServiceConfigFieldMatchSpecificationValue = "value"
+)
+
+// NewServiceConfigResourceHandle creates the resource handle for service configurations
+func NewServiceConfigResourceHandle() ResourceHandle[*restapi.ServiceConfig] {
+	return &serviceConfigResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaServiceConfig,
+			Schema: map[string]*schema.Schema{
+				ServiceConfigFieldName: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+					Description:  "The name of the service configuration",
+				},
+				ServiceConfigFieldLabel: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The label of the service which is created by this service configuration. Tag values can be referenced using the syntax {tag}",
+				},
+				ServiceConfigFieldComment: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 2048),
+					Description:  "An optional comment of the service configuration",
+				},
+				ServiceConfigFieldEnabled: {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Flag to indicate whether the service configuration is enabled or not",
+				},
+				ServiceConfigFieldMatchSpecification: {
+					Type:        schema.TypeList,
+					Required:    true,
+					MinItems:    1,
+					MaxItems:    20,
+					Description: "The list of key/value match specifications of the service configuration. Calls are mapped to the service when all match specifications apply",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							ServiceConfigFieldMatchSpecificationKey: {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+								Description:  "The tag key of the match specification",
+							},
+							ServiceConfigFieldMatchSpecificationValue: {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Default:     "",
+								Description: "The value of the match specification",
+							},
+						},
+					},
+				},
+			},
+			SchemaVersion: 0,
+		},
+	}
+}
+
+type serviceConfigResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *serviceConfigResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *serviceConfigResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *serviceConfigResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.ServiceConfig] {
+	return api.ServiceConfigs()
+}
+
+func (r *serviceConfigResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *serviceConfigResource) UpdateState(d *schema.ResourceData, config *restapi.ServiceConfig) error {
+	matchSpecification := make([]interface{}, len(config.MatchSpecification))
+	for i, rule := range config.MatchSpecification {
+		matchSpecification[i] = map[string]interface{}{
+			ServiceConfigFieldMatchSpecificationKey:   rule.Key,
+			ServiceConfigFieldMatchSpecificationValue: rule.Value,
+		}
+	}
+
+	d.SetId(config.ID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		ServiceConfigFieldName:               config.Name,
+		ServiceConfigFieldLabel:              config.Label,
+		ServiceConfigFieldComment:            config.Comment,
+		ServiceConfigFieldEnabled:            config.Enabled,
+		ServiceConfigFieldMatchSpecification: matchSpecification,
+	})
+}
+
+func (r *serviceConfigResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.ServiceConfig, error) {
+	matchSpecificationData := d.Get(ServiceConfigFieldMatchSpecification).([]interface{})
+	matchSpecification := make([]restapi.ServiceMatchingRule, len(matchSpecificationData))
+	for i, v := range matchSpecificationData {
+		rule := v.(map[string]interface{})
+		matchSpecification[i] = restapi.ServiceMatchingRule{
+			Key:   rule[ServiceConfigFieldMatchSpecificationKey].(string),
+			Value: rule[ServiceConfigFieldMatchSpecificationValue].(string),
+		}
+	}
+
+	return &restapi.ServiceConfig{
+		ID:                 d.Id(),
+		Name:               d.Get(ServiceConfigFieldName).(string),
+		Label:              d.Get(ServiceConfigFieldLabel).(string),
+		Comment:            GetStringPointerFromResourceData(d, ServiceConfigFieldComment),
+		Enabled:            d.Get(ServiceConfigFieldEnabled).(bool),
+		MatchSpecification: matchSpecification,
+	}, nil
+}
@@ -0,0 +1,187 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+const serviceConfigTerraformTemplate = `
+resource "instana_service_config" "example" {
+	name    = "name %d"
+	label   = "{gce.zone}-{jvm.args.abc}"
+	comment = "comment %d"
+	enabled = true
+
+	match_specification {
+		key = "gce.zone"
+	}
+
+	match_specification {
+		key   = "jvm.args.abc"
+		value = "abc"
+	}
+}
+`
+
+const serviceConfigDefinition = "instana_service_config.example"
+
+func TestCRUDOfServiceConfiguration(t *testing.T) {
+	id := RandomID()
+	resourceInstanceRestAPIPath := restapi.ServiceConfigsResourcePath + "/{id}"
+	var serverState []byte
+	httpServer := testutils.NewTestHTTPServer()
+	storeAndEcho := func(w http.ResponseWriter, r *http.Request) {
+		config := &restapi.ServiceConfig{}
+		err := json.NewDecoder(r.Body).Decode(config)
+		if err != nil {
+			httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		config.ID = id
+		serverState, _ = json.Marshal(config)
+		httpServer.WriteJSONResponse(w, serverState)
+	}
+	httpServer.AddRoute(http.MethodPost, restapi.ServiceConfigsResourcePath, storeAndEcho)
+	httpServer.AddRoute(http.MethodPut, resourceInstanceRestAPIPath, storeAndEcho)
+	httpServer.AddRoute(http.MethodDelete, resourceInstanceRestAPIPath, testutils.EchoHandlerFunc)
+	httpServer.AddRoute(http.MethodGet, resourceInstanceRestAPIPath, func(w http.ResponseWriter, r *http.Request) {
+		httpServer.WriteJSONResponse(w, serverState)
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			createServiceConfigTestStep(httpServer.GetPort(), 0, id),
+			testStepImportWithCustomID(serviceConfigDefinition, id),
+			createServiceConfigTestStep(httpServer.GetPort(), 1, id),
+			testStepImportWithCustomID(serviceConfigDefinition, id),
+		},
+	})
+}
+
+func createServiceConfigTestStep(httpPort int, iteration int, id string) resource.TestStep {
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(serviceConfigTerraformTemplate, iteration, iteration), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(serviceConfigDefinition, "id", id),
+			resource.TestCheckResourceAttr(serviceConfigDefinition, ServiceConfigFieldName, formatResourceName(iteration)),
+			resource.TestCheckResourceAttr(serviceConfigDefinition, ServiceConfigFieldLabel, "{gce.zone}-{jvm.args.abc}"),
+			resource.TestCheckResourceAttr(serviceConfigDefinition, ServiceConfigFieldComment, fmt.Sprintf("comment %d", iteration)),
+			resource.TestCheckResourceAttr(serviceConfigDefinition, ServiceConfigFieldEnabled, trueAsString),
+			resource.TestCheckResourceAttr(serviceConfigDefinition, ServiceConfigFieldMatchSpecification+".0."+ServiceConfigFieldMatchSpecificationKey, "gce.zone"),
+			resource.TestCheckResourceAttr(serviceConfigDefinition, ServiceConfigFieldMatchSpecification+".0."+ServiceConfigFieldMatchSpecificationValue, ""),
+			resource.TestCheckResourceAttr(serviceConfigDefinition, ServiceConfigFieldMatchSpecification+".1."+ServiceConfigFieldMatchSpecificationKey, "jvm.args.abc"),
+			resource.TestCheckResourceAttr(serviceConfigDefinition, ServiceConfigFieldMatchSpecification+".1."+ServiceConfigFieldMatchSpecificationValue, "abc"),
+		),
+	}
+}
+
+func TestResourceServiceConfigDefinition(t *testing.T) {
+	schemaMap := NewServiceConfigResourceHandle().MetaData().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(ServiceConfigFieldName)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(ServiceConfigFieldLabel)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(ServiceConfigFieldComment)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(ServiceConfigFieldEnabled, true)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeListOfResource(ServiceConfigFieldMatchSpecification)
+
+	matchSpecificationSchemaAssert := testutils.NewTerraformSchemaAssert(schemaMap[ServiceConfigFieldMatchSpecification].Elem.(*schema.Resource).Schema, t)
+	matchSpecificationSchemaAssert.AssertSchemaIsRequiredAndOfTypeString(ServiceConfigFieldMatchSpecificationKey)
+	matchSpecificationSchemaAssert.AssertSchemaIsOptionalAndOfTypeStringWithDefault(ServiceConfigFieldMatchSpecificationValue, "")
+}
+
+func TestServiceConfigShouldValidateLengthOfNameAndLabelAccordingToTheAPI(t *testing.T) {
+	schemaMap := NewServiceConfigResourceHandle().MetaData().Schema
+
+	_, errs := schemaMap[ServiceConfigFieldName].ValidateFunc(strings.Repeat("a", 128), ServiceConfigFieldName)
+	require.Empty(t, errs)
+	_, errs = schemaMap[ServiceConfigFieldName].ValidateFunc(strings.Repeat("a", 129), ServiceConfigFieldName)
+	require.NotEmpty(t, errs)
+	_, errs = schemaMap[ServiceConfigFieldLabel].ValidateFunc(strings.Repeat("a", 512), ServiceConfigFieldLabel)
+	require.Empty(t, errs)
+	_, errs = schemaMap[ServiceConfigFieldLabel].ValidateFunc("", ServiceConfigFieldLabel)
+	require.NotEmpty(t, errs)
+}
+
+func TestShouldUpdateResourceStateForServiceConfig(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ServiceConfig](t)
+	resourceHandle := NewServiceConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	comment := "comment"
+	data := restapi.ServiceConfig{
+		ID:      "id",
+		Name:    resourceName,
+		Label:   "label",
+		Comment: &comment,
+		Enabled: true,
+		MatchSpecification: []restapi.ServiceMatchingRule{
+			{Key: "key1", Value: "value1"},
+			{Key: "key2", Value: ""},
+		},
+	}
+
+	err := resourceHandle.UpdateState(resourceData, &data)
+
+	require.NoError(t, err)
+	require.Equal(t, "id", resourceData.Id())
+	require.Equal(t, resourceName, resourceData.Get(ServiceConfigFieldName))
+	require.Equal(t, "label", resourceData.Get(ServiceConfigFieldLabel))
+	require.Equal(t, comment, resourceData.Get(ServiceConfigFieldComment))
+	require.True(t, resourceData.Get(ServiceConfigFieldEnabled).(bool))
+	require.Equal(t, []interface{}{
+		map[string]interface{}{ServiceConfigFieldMatchSpecificationKey: "key1", ServiceConfigFieldMatchSpecificationValue: "value1"},
+		map[string]interface{}{ServiceConfigFieldMatchSpecificationKey: "key2", ServiceConfigFieldMatchSpecificationValue: ""},
+	}, resourceData.Get(ServiceConfigFieldMatchSpecification))
+}
+
+func TestShouldConvertStateOfServiceConfigToDataModel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.ServiceConfig](t)
+	resourceHandle := NewServiceConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId("id")
+	setValueOnResourceData(t, resourceData, ServiceConfigFieldName, resourceName)
+	setValueOnResourceData(t, resourceData, ServiceConfigFieldLabel, "label")
+	setValueOnResourceData(t, resourceData, ServiceConfigFieldEnabled, false)
+	setValueOnResourceData(t, resourceData, ServiceConfigFieldMatchSpecification, []interface{}{
+		map[string]interface{}{ServiceConfigFieldMatchSpecificationKey: "key1", ServiceConfigFieldMatchSpecificationValue: "value1"},
+	})
+
+	model, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.Equal(t, &restapi.ServiceConfig{
+		ID:                 "id",
+		Name:               resourceName,
+		Label:              "label",
+		Comment:            nil,
+		Enabled:            false,
+		MatchSpecification: []restapi.ServiceMatchingRule{{Key: "key1", Value: "value1"}},
+	}, model)
+}
+
+func TestShouldReturnCorrectResourceNameForServiceConfig(t *testing.T) {
+	name := NewServiceConfigResourceHandle().MetaData().ResourceName
+
+	require.Equal(t, "instana_service_config", name)
+}
+
+func TestServiceConfigShouldHaveSchemaVersionZeroAndNoStateUpgrader(t *testing.T) {
+	resourceHandle := NewServiceConfigResourceHandle()
+
+	require.Equal(t, 0, resourceHandle.MetaData().SchemaVersion)
+	require.Empty(t, resourceHandle.StateUpgraders())
+}
@@ -33,6 +33,14 @@ const (
 	SyntheticTestFieldConfigHttpScript = "http_script"
 	//SyntheticTestFieldConfigHttpAction constant value for the schema field configuration.http_action
 	SyntheticTestFieldConfigHttpAction = "http_action"
+	//SyntheticTestFieldConfigBrowserScript constant value for the schema field configuration.browser_script
+	SyntheticTestFieldConfigBrowserScript = "browser_script"
+	//SyntheticTestFieldConfigWebpageAction constant value for the schema field configuration.webpage_action
+	SyntheticTestFieldConfigWebpageAction = "webpage_action"
+	//SyntheticTestFieldConfigWebpageScript constant value for the schema field configuration.webpage_script
+	SyntheticTestFieldConfigWebpageScript = "webpage_script"
+	//SyntheticTestFieldConfigDNS constant value for the schema field configuration.dns
+	SyntheticTestFieldConfigDNS = "dns"
 
 	//SyntheticTestFieldConfigMarkSyntheticCall constant value for the schema field configuration.mark_synthetic_call
 	SyntheticTestFieldConfigMarkSyntheticCall = "mark_synthetic_call"
@@ -62,15 +70,68 @@ const (
 	SyntheticTestFieldConfigExpectMatch = "expect_match"
 	//SyntheticTestFieldConfigScript constant value for the schema field configuration.script
 	SyntheticTestFieldConfigScript = "script"
+	//SyntheticTestFieldConfigBrowser constant value for the schema field configuration.browser
+	SyntheticTestFieldConfigBrowser = "browser"
+	//SyntheticTestFieldConfigRecordVideo constant value for the schema field configuration.record_video
+	SyntheticTestFieldConfigRecordVideo = "record_video"
+	//SyntheticTestFieldConfigScriptType constant value for the schema field configuration.script_type
+	SyntheticTestFieldConfigScriptType = "script_type"
+	//SyntheticTestFieldConfigScripts constant value for the schema field configuration.scripts
+	SyntheticTestFieldConfigScripts = "scripts"
+	//SyntheticTestFieldConfigScriptsBundle constant value for the schema field configuration.scripts.bundle
+	SyntheticTestFieldConfigScriptsBundle = "bundle"
+	//SyntheticTestFieldConfigScriptsScriptFile constant value for the schema field configuration.scripts.script_file
+	SyntheticTestFieldConfigScriptsScriptFile = "script_file"
+	//SyntheticTestFieldConfigLookup constant value for the schema field configuration.lookup
+	SyntheticTestFieldConfigLookup = "lookup"
+	//SyntheticTestFieldConfigServer constant value for the schema field configuration.server
+	SyntheticTestFieldConfigServer = "server"
+	//SyntheticTestFieldConfigQueryType constant value for the schema field configuration.query_type
+	SyntheticTestFieldConfigQueryType = "query_type"
+	//SyntheticTestFieldConfigPort constant value for the schema field configuration.port
+	SyntheticTestFieldConfigPort = "port"
+	//SyntheticTestFieldConfigAcceptCNAME constant value for the schema field configuration.accept_cname
+	SyntheticTestFieldConfigAcceptCNAME = "accept_cname"
+	//SyntheticTestFieldConfigLookupServerName constant value for the schema field configuration.lookup_server_name
+	SyntheticTestFieldConfigLookupServerName = "lookup_server_name"
+	//SyntheticTestFieldConfigRecursiveLookups constant value for the schema field configuration.recursive_lookups
+	SyntheticTestFieldConfigRecursiveLookups = "recursive_lookups"
+	//SyntheticTestFieldConfigServerRetries constant value for the schema field configuration.server_retries
+	SyntheticTestFieldConfigServerRetries = "server_retries"
 )
 
 var syntheticTestConfigurationOptions = []string{
-	"http_script",
-	"http_action",
+	SyntheticTestFieldConfigHttpScript,
+	SyntheticTestFieldConfigHttpAction,
+	SyntheticTestFieldConfigBrowserScript,
+	SyntheticTestFieldConfigWebpageAction,
+	SyntheticTestFieldConfigWebpageScript,
+	SyntheticTestFieldConfigDNS,
+}
+
+var syntheticTestBrowserScriptOptions = []string{
+	SyntheticTestFieldConfigBrowserScript + ".0." + SyntheticTestFieldConfigScript,
+	SyntheticTestFieldConfigBrowserScript + ".0." + SyntheticTestFieldConfigScripts,
 }
 
 const SyntheticCheckTypeHttpAction = "HTTPAction"
 const SyntheticCheckTypeHttpScript = "HTTPScript"
+const SyntheticCheckTypeBrowserScript = "BrowserScript"
+const SyntheticCheckTypeWebpageAction = "WebpageAction"
+const SyntheticCheckTypeWebpageScript = "WebpageScript"
+const SyntheticCheckTypeDNS = "DNSAction"
+
+var supportedSyntheticCheckTypes = []string{
+	SyntheticCheckTypeHttpAction,
+	SyntheticCheckTypeHttpScript,
+	SyntheticCheckTypeBrowserScript,
+	SyntheticCheckTypeWebpageAction,
+	SyntheticCheckTypeWebpageScript,
+	SyntheticCheckTypeDNS,
+}
+
+var syntheticTestSupportedBrowsers = []string{"chrome", "firefox"}
+var syntheticTestSupportedDNSQueryTypes = []string{"A", "AAAA", "ANY", "AXFR", "CNAME", "HINFO", "MAILB", "MAILA", "MINFO", "MB", "MD", "MF", "MG", "MR", "MX", "NULL", "NS", "PTR", "SOA", "TXT", "WKS"}
 
 var (
 	syntheticTestSchemaConfigMarkSyntheticCall = &schema.Schema{
@@ -98,6 +159,19 @@ var (
 		Optional:    true,
 		Description: "The timeout to be used by the PoP playback engines running the test",
 	}
+	syntheticTestSchemaConfigBrowser = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "chrome",
+		Description:  "The browser type used to run the test",
+		ValidateFunc: validation.StringInSlice(syntheticTestSupportedBrowsers, false),
+	}
+	syntheticTestSchemaConfigRecordVideo = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Flag used to control if a video of the test execution should be recorded",
+	}
 )
 
 // NewSyntheticTestResourceHandle creates the resource handle Synthetic Tests
@@ -219,6 +293,171 @@ func NewSyntheticTestResourceHandle() ResourceHandle[*restapi.SyntheticTest] {
 						},
 					},
 				},
+				SyntheticTestFieldConfigBrowserScript: {
+					Type:         schema.TypeList,
+					MinItems:     0,
+					MaxItems:     1,
+					Optional:     true,
+					Description:  "The configuration of the synthetic alert of type browser script",
+					ExactlyOneOf: syntheticTestConfigurationOptions,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							SyntheticTestFieldConfigMarkSyntheticCall: syntheticTestSchemaConfigMarkSyntheticCall,
+							SyntheticTestFieldConfigRetries:           syntheticTestSchemaConfigRetries,
+							SyntheticTestFieldConfigRetryInterval:     syntheticTestSchemaConfigRetryInterval,
+							SyntheticTestFieldConfigTimeout:           syntheticTestSchemaConfigTimeout,
+							SyntheticTestFieldConfigBrowser:           syntheticTestSchemaConfigBrowser,
+							SyntheticTestFieldConfigRecordVideo:       syntheticTestSchemaConfigRecordVideo,
+							SyntheticTestFieldConfigScript: {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Description:  "The Javascript content in plain text",
+								ExactlyOneOf: syntheticTestBrowserScriptOptions,
+							},
+							SyntheticTestFieldConfigScriptType: {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Default:      "Basic",
+								Description:  "The type of the script (Basic or Jest)",
+								ValidateFunc: validation.StringInSlice([]string{"Basic", "Jest"}, false),
+							},
+							SyntheticTestFieldConfigScripts: {
+								Type:         schema.TypeList,
+								MinItems:     0,
+								MaxItems:     1,
+								Optional:     true,
+								Description:  "The script files of the test when the test consists of multiple script files",
+								ExactlyOneOf: syntheticTestBrowserScriptOptions,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										SyntheticTestFieldConfigScriptsBundle: {
+											Type:        schema.TypeString,
+											Required:    true,
+											Description: "The base64 encoded zip file containing the script files",
+										},
+										SyntheticTestFieldConfigScriptsScriptFile: {
+											Type:        schema.TypeString,
+											Required:    true,
+											Description: "The name of the script file in the bundle which should be executed",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				SyntheticTestFieldConfigWebpageAction: {
+					Type:         schema.TypeList,
+					MinItems:     0,
+					MaxItems:     1,
+					Optional:     true,
+					Description:  "The configuration of the synthetic alert of type webpage action",
+					ExactlyOneOf: syntheticTestConfigurationOptions,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							SyntheticTestFieldConfigMarkSyntheticCall: syntheticTestSchemaConfigMarkSyntheticCall,
+							SyntheticTestFieldConfigRetries:           syntheticTestSchemaConfigRetries,
+							SyntheticTestFieldConfigRetryInterval:     syntheticTestSchemaConfigRetryInterval,
+							SyntheticTestFieldConfigTimeout:           syntheticTestSchemaConfigTimeout,
+							SyntheticTestFieldConfigBrowser:           syntheticTestSchemaConfigBrowser,
+							SyntheticTestFieldConfigRecordVideo:       syntheticTestSchemaConfigRecordVideo,
+							SyntheticTestFieldConfigUrl: {
+								Type:         schema.TypeString,
+								Required:     true,
+								Description:  "The URL of the webpage which is being tested",
+								ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+							},
+						},
+					},
+				},
+				SyntheticTestFieldConfigWebpageScript: {
+					Type:         schema.TypeList,
+					MinItems:     0,
+					MaxItems:     1,
+					Optional:     true,
+					Description:  "The configuration of the synthetic alert of type webpage script",
+					ExactlyOneOf: syntheticTestConfigurationOptions,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							SyntheticTestFieldConfigMarkSyntheticCall: syntheticTestSchemaConfigMarkSyntheticCall,
+							SyntheticTestFieldConfigRetries:           syntheticTestSchemaConfigRetries,
+							SyntheticTestFieldConfigRetryInterval:     syntheticTestSchemaConfigRetryInterval,
+							SyntheticTestFieldConfigTimeout:           syntheticTestSchemaConfigTimeout,
+							SyntheticTestFieldConfigBrowser:           syntheticTestSchemaConfigBrowser,
+							SyntheticTestFieldConfigRecordVideo:       syntheticTestSchemaConfigRecordVideo,
+							SyntheticTestFieldConfigScript: {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The Selenium IDE script content in plain text",
+							},
+						},
+					},
+				},
+				SyntheticTestFieldConfigDNS: {
+					Type:         schema.TypeList,
+					MinItems:     0,
+					MaxItems:     1,
+					Optional:     true,
+					Description:  "The configuration of the synthetic alert of type DNS action",
+					ExactlyOneOf: syntheticTestConfigurationOptions,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							SyntheticTestFieldConfigMarkSyntheticCall: syntheticTestSchemaConfigMarkSyntheticCall,
+							SyntheticTestFieldConfigRetries:           syntheticTestSchemaConfigRetries,
+							SyntheticTestFieldConfigRetryInterval:     syntheticTestSchemaConfigRetryInterval,
+							SyntheticTestFieldConfigTimeout:           syntheticTestSchemaConfigTimeout,
+							SyntheticTestFieldConfigLookup: {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The name or IP address which should be looked up",
+							},
+							SyntheticTestFieldConfigServer: {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The IP address of the DNS server",
+							},
+							SyntheticTestFieldConfigQueryType: {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Default:      "A",
+								Description:  "The DNS query type",
+								ValidateFunc: validation.StringInSlice(syntheticTestSupportedDNSQueryTypes, false),
+							},
+							SyntheticTestFieldConfigPort: {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Default:      53,
+								Description:  "The port of the DNS server",
+								ValidateFunc: validation.IsPortNumber,
+							},
+							SyntheticTestFieldConfigAcceptCNAME: {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Default:     false,
+								Description: "Flag used to control if CNAME records are accepted as a valid response",
+							},
+							SyntheticTestFieldConfigLookupServerName: {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Default:     false,
+								Description: "Flag used to control if the server name of the DNS server should be looked up",
+							},
+							SyntheticTestFieldConfigRecursiveLookups: {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Default:     true,
+								Description: "Flag used to control if recursive DNS lookups are allowed",
+							},
+							SyntheticTestFieldConfigServerRetries: {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Default:      1,
+								Description:  "The number of times the DNS server is queried before the test fails",
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+						},
+					},
+				},
 				SyntheticTestFieldCustomProperties: {
 					Type:        schema.TypeMap,
 					Optional:    true,
@@ -281,21 +520,30 @@ func (r *syntheticTestResource) UpdateState(d *schema.ResourceData, syntheticTes
 	}
 	d.SetId(syntheticTest.ID)
 	return tfutils.UpdateState(d, map[string]interface{}{
-		SyntheticTestFieldLabel:            syntheticTest.Label,
-		SyntheticTestFieldActive:           syntheticTest.Active,
-		SyntheticTestFieldDescription:      syntheticTest.Description,
-		SyntheticTestFieldApplicationID:    syntheticTest.ApplicationID,
-		SyntheticTestFieldCustomProperties: syntheticTest.CustomProperties,
-		SyntheticTestFieldLocations:        syntheticTest.Locations,
-		SyntheticTestFieldPlaybackMode:     syntheticTest.PlaybackMode,
-		SyntheticTestFieldTestFrequency:    syntheticTest.TestFrequency,
-		SyntheticTestFieldConfigHttpAction: r.mapHttpActionConfig(&syntheticTest.Configuration),
-		SyntheticTestFieldConfigHttpScript: r.mapHttpScriptConfig(&syntheticTest.Configuration),
+		SyntheticTestFieldLabel:               syntheticTest.Label,
+		SyntheticTestFieldActive:              syntheticTest.Active,
+		SyntheticTestFieldDescription:         syntheticTest.Description,
+		SyntheticTestFieldApplicationID:       syntheticTest.ApplicationID,
+		SyntheticTestFieldCustomProperties:    syntheticTest.CustomProperties,
+		SyntheticTestFieldLocations:           syntheticTest.Locations,
+		SyntheticTestFieldPlaybackMode:        syntheticTest.PlaybackMode,
+		SyntheticTestFieldTestFrequency:       syntheticTest.TestFrequency,
+		SyntheticTestFieldConfigHttpAction:    r.mapHttpActionConfig(&syntheticTest.Configuration),
+		SyntheticTestFieldConfigHttpScript:    r.mapHttpScriptConfig(&syntheticTest.Configuration),
+		SyntheticTestFieldConfigBrowserScript: r.mapBrowserScriptConfig(&syntheticTest.Configuration),
+		SyntheticTestFieldConfigWebpageAction: r.mapWebpageActionConfig(&syntheticTest.Configuration),
+		SyntheticTestFieldConfigWebpageScript: r.mapWebpageScriptConfig(&syntheticTest.Configuration),
+		SyntheticTestFieldConfigDNS:           r.mapDNSConfig(&syntheticTest.Configuration),
 	})
 }
 
 func (r *syntheticTestResource) isSupportedConfigurationProvided(config *restapi.SyntheticTestConfig) bool {
-	return config.SyntheticType != SyntheticCheckTypeHttpAction && config.SyntheticType != SyntheticCheckTypeHttpScript
+	for _, t := range supportedSyntheticCheckTypes {
+		if config.SyntheticType == t {
+			return false
+		}
+	}
+	return true
 }
 
 func (r *syntheticTestResource) mapHttpActionConfig(config *restapi.SyntheticTestConfig) []interface{} {
@@ -324,6 +572,70 @@ func (r *syntheticTestResource) mapHttpScriptConfig(config *restapi.SyntheticTes
 	return []interface{}{}
 }
 
+func (r *syntheticTestResource) mapBrowserScriptConfig(config *restapi.SyntheticTestConfig) []interface{} {
+	if config.SyntheticType == SyntheticCheckTypeBrowserScript {
+		configuration := r.mapCommonBrowserConfigurationOptions(config)
+		configuration[SyntheticTestFieldConfigScript] = config.Script
+		configuration[SyntheticTestFieldConfigScriptType] = config.ScriptType
+		configuration[SyntheticTestFieldConfigScripts] = r.mapScriptFiles(config.Scripts)
+		return []interface{}{configuration}
+	}
+	return []interface{}{}
+}
+
+func (r *syntheticTestResource) mapScriptFiles(scripts *restapi.SyntheticTestScriptFiles) []interface{} {
+	if scripts != nil {
+		return []interface{}{
+			map[string]interface{}{
+				SyntheticTestFieldConfigScriptsBundle:     scripts.Bundle,
+				SyntheticTestFieldConfigScriptsScriptFile: scripts.ScriptFile,
+			},
+		}
+	}
+	return []interface{}{}
+}
+
+func (r *syntheticTestResource) mapWebpageActionConfig(config *restapi.SyntheticTestConfig) []interface{} {
+	if config.SyntheticType == SyntheticCheckTypeWebpageAction {
+		configuration := r.mapCommonBrowserConfigurationOptions(config)
+		configuration[SyntheticTestFieldConfigUrl] = config.URL
+		return []interface{}{configuration}
+	}
+	return []interface{}{}
+}
+
+func (r *syntheticTestResource) mapWebpageScriptConfig(config *restapi.SyntheticTestConfig) []interface{} {
+	if config.SyntheticType == SyntheticCheckTypeWebpageScript {
+		configuration := r.mapCommonBrowserConfigurationOptions(config)
+		configuration[SyntheticTestFieldConfigScript] = config.Script
+		return []interface{}{configuration}
+	}
+	return []interface{}{}
+}
+
+func (r *syntheticTestResource) mapDNSConfig(config *restapi.SyntheticTestConfig) []interface{} {
+	if config.SyntheticType == SyntheticCheckTypeDNS {
+		configuration := r.mapCommonConfigurationOptions(config)
+		configuration[SyntheticTestFieldConfigLookup] = config.Lookup
+		configuration[SyntheticTestFieldConfigServer] = config.Server
+		configuration[SyntheticTestFieldConfigQueryType] = config.QueryType
+		configuration[SyntheticTestFieldConfigPort] = config.Port
+		configuration[SyntheticTestFieldConfigAcceptCNAME] = config.AcceptCNAME
+		configuration[SyntheticTestFieldConfigLookupServerName] = config.LookupServerName
+		configuration[SyntheticTestFieldConfigRecursiveLookups] = config.RecursiveLookups
+		configuration[SyntheticTestFieldConfigServerRetries] = config.ServerRetries
+		return []interface{}{configuration}
+	}
+	return []interface{}{}
+}
+
+func (r *syntheticTestResource) mapCommonBrowserConfigurationOptions(config *restapi.SyntheticTestConfig) map[string]interface{} {
+	configuration := r.mapCommonConfigurationOptions(config)
+	configuration[SyntheticTestFieldConfigBrowser] = config.Browser
+	configuration[SyntheticTestFieldConfigRecordVideo] = config.RecordVideo
+	return configuration
+}
+
 func (r *syntheticTestResource) mapCommonConfigurationOptions(config *restapi.SyntheticTestConfig) map[string]interface{} {
 	configuration := make(map[string]interface{})
 	configuration[SyntheticTestFieldConfigMarkSyntheticCall] = config.MarkSyntheticCall
@@ -358,25 +670,29 @@ func (r *syntheticTestResource) MapStateToDataObject(d *schema.ResourceData) (*r
 	}, nil
 }
 
+var syntheticTestConfigurationFieldToCheckTypeMapping = map[string]string{
+	SyntheticTestFieldConfigHttpAction:    SyntheticCheckTypeHttpAction,
+	SyntheticTestFieldConfigHttpScript:    SyntheticCheckTypeHttpScript,
+	SyntheticTestFieldConfigBrowserScript: SyntheticCheckTypeBrowserScript,
+	SyntheticTestFieldConfigWebpageAction: SyntheticCheckTypeWebpageAction,
+	SyntheticTestFieldConfigWebpageScript: SyntheticCheckTypeWebpageScript,
+	SyntheticTestFieldConfigDNS:           SyntheticCheckTypeDNS,
+}
+
 func (r *syntheticTestResource) mapConfigurationFromSchema(d *schema.ResourceData) (restapi.SyntheticTestConfig, error) {
 	var syntheticTestType string
 	var syntheticTestConfigData map[string]interface{}
-	if val, ok := d.GetOk(SyntheticTestFieldConfigHttpAction); ok && len(val.([]interface{})) == 1 {
-		syntheticTestType = SyntheticCheckTypeHttpAction
-		syntheticTestConfigData = val.([]interface{})[0].(map[string]interface{})
-	} else if val, ok := d.GetOk(SyntheticTestFieldConfigHttpScript); ok && len(val.([]interface{})) == 1 {
-		syntheticTestType = SyntheticCheckTypeHttpScript
-		syntheticTestConfigData = val.([]interface{})[0].(map[string]interface{})
-	} else {
+	for _, field := range syntheticTestConfigurationOptions {
+		if val, ok := d.GetOk(field); ok && len(val.([]interface{})) == 1 {
+			syntheticTestType = syntheticTestConfigurationFieldToCheckTypeMapping[field]
+			syntheticTestConfigData = val.([]interface{})[0].(map[string]interface{})
+			break
+		}
+	}
+	if syntheticTestConfigData == nil {
 		return restapi.SyntheticTestConfig{}, errors.New("no supported synthetic test configuration provided")
 	}
 
-	expectedStatusAsInt := GetPointerFromMap[int](syntheticTestConfigData, SyntheticTestFieldConfigExpectStatus)
-	var expectedStatus *int32
-	if expectedStatusAsInt != nil {
-		v := int32(*expectedStatusAsInt)
-		expectedStatus = &v
-	}
 	headersRaw, ok := syntheticTestConfigData[SyntheticTestFieldConfigHeaders]
 	var headers map[string]interface{}
 	if ok {
@@ -395,8 +711,40 @@ func (r *syntheticTestResource) mapConfigurationFromSchema(d *schema.ResourceDat
 		ValidationString:  GetPointerFromMap[string](syntheticTestConfigData, SyntheticTestFieldConfigValidationString),
 		FollowRedirect:    GetPointerFromMap[bool](syntheticTestConfigData, SyntheticTestFieldConfigFollowRedirect),
 		AllowInsecure:     GetPointerFromMap[bool](syntheticTestConfigData, SyntheticTestFieldConfigAllowInsecure),
-		ExpectStatus:      expectedStatus,
+		ExpectStatus:      r.getInt32PointerFromMap(syntheticTestConfigData, SyntheticTestFieldConfigExpectStatus),
 		ExpectMatch:       GetPointerFromMap[string](syntheticTestConfigData, SyntheticTestFieldConfigExpectMatch),
 		Script:            GetPointerFromMap[string](syntheticTestConfigData, SyntheticTestFieldConfigScript),
+		Browser:           GetPointerFromMap[string](syntheticTestConfigData, SyntheticTestFieldConfigBrowser),
+		RecordVideo:       GetPointerFromMap[bool](syntheticTestConfigData, SyntheticTestFieldConfigRecordVideo),
+		ScriptType:        GetPointerFromMap[string](syntheticTestConfigData, SyntheticTestFieldConfigScriptType),
+		Scripts:           r.mapScriptFilesFromSchema(syntheticTestConfigData),
+		Lookup:            GetPointerFromMap[string](syntheticTestConfigData, SyntheticTestFieldConfigLookup),
+		Server:            GetPointerFromMap[string](syntheticTestConfigData, SyntheticTestFieldConfigServer),
+		QueryType:         GetPointerFromMap[string](syntheticTestConfigData, SyntheticTestFieldConfigQueryType),
+		Port:              r.getInt32PointerFromMap(syntheticTestConfigData, SyntheticTestFieldConfigPort),
+		AcceptCNAME:       GetPointerFromMap[bool](syntheticTestConfigData, SyntheticTestFieldConfigAcceptCNAME),
+		LookupServerName:  GetPointerFromMap[bool](syntheticTestConfigData, SyntheticTestFieldConfigLookupServerName),
+		RecursiveLookups:  GetPointerFromMap[bool](syntheticTestConfigData, SyntheticTestFieldConfigRecursiveLookups),
+		ServerRetries:     r.getInt32PointerFromMap(syntheticTestConfigData, SyntheticTestFieldConfigServerRetries),
 	}, nil
 }
+
+func (r *syntheticTestResource) mapScriptFilesFromSchema(syntheticTestConfigData map[string]interface{}) *restapi.SyntheticTestScriptFiles {
+	if val, ok := syntheticTestConfigData[SyntheticTestFieldConfigScripts]; ok && len(val.([]interface{})) == 1 {
+		scripts := val.([]interface{})[0].(map[string]interface{})
+		return &restapi.SyntheticTestScriptFiles{
+			Bundle:     GetPointerFromMap[string](scripts, SyntheticTestFieldConfigScriptsBundle),
+			ScriptFile: GetPointerFromMap[string](scripts, SyntheticTestFieldConfigScriptsScriptFile),
+		}
+	}
+	return nil
+}
+
+func (r *syntheticTestResource) getInt32PointerFromMap(syntheticTestConfigData map[string]interface{}, key string) *int32 {
+	valueAsInt := GetPointerFromMap[int](syntheticTestConfigData, key)
+	if valueAsInt != nil {
+		v := int32(*valueAsInt)
+		return &v
+	}
+	return nil
+}
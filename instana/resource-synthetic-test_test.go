@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/require"
 
 	. "github.com/gessnerfl/terraform-provider-instana/instana"
@@ -21,15 +22,24 @@ func TestSyntheticTestResource(t *testing.T) {
 	t.Run("CRUD integration test with HTTP Action configuration without application id", syntheticTestHttpActionWithoutApplicationIdIntegrationTest().testCrud)
 	t.Run("CRUD integration test with HTTP Action configuration with application id", syntheticTestHttpActionWithApplicationIdIntegrationTest().testCrud)
 	t.Run("CRUD integration test with HTTP Script", syntheticTestHttpScriptIntegrationTest().testCrud)
+	t.Run("CRUD integration test with Browser Script", syntheticTestBrowserScriptIntegrationTest().testCrud)
+	t.Run("CRUD integration test with DNS", syntheticTestDNSIntegrationTest().testCrud)
 	t.Run("should have valid schema", ut.resourceDefinitionShouldBeValid)
 	t.Run("should return correct resource name", ut.shouldReturnCorrectResourceName)
 	t.Run("should have schema version zero", ut.shouldHaveSchemaVersionZero)
 	t.Run("should have schema no state upgrader", ut.shouldHaveNoStateUpgrader)
 	t.Run("should update resource state for http script config", ut.shouldUpdateResourceStateForHttpScript)
 	t.Run("should update resource state for http action config", ut.shouldUpdateResourceStateForHttpAction)
+	t.Run("should update resource state for browser script config", ut.shouldUpdateResourceStateForBrowserScript)
+	t.Run("should update resource state for webpage action config", ut.shouldUpdateResourceStateForWebpageAction)
+	t.Run("should update resource state for webpage script config", ut.shouldUpdateResourceStateForWebpageScript)
+	t.Run("should update resource state for dns config", ut.shouldUpdateResourceStateForDNS)
 	t.Run("should return error when trying to update state and config type is not supported", ut.shouldReturnErrorWhenTryingToUpdateStateAndConfigTypeIsNotSupported)
 	t.Run("should map state to data model with http action config", ut.shouldMapStateToDataModelWithConfigOfTypeHttpAction)
 	t.Run("should map state to data model with http script config", ut.shouldMapStateToDataModelWithConfigOfTypeHttpScript)
+	t.Run("should map state to data model with browser script config", ut.shouldMapStateToDataModelWithConfigOfTypeBrowserScript)
+	t.Run("should map state to data model with dns config", ut.shouldMapStateToDataModelWithConfigOfTypeDNS)
+	t.Run("should require exactly one of script or scripts for browser script config", ut.shouldRequireExactlyOneOfScriptOrScriptsForBrowserScript)
 	t.Run("should return errror when trying to map state to model when no configuration is provided", ut.shouldReturnErrorWhenTryingToMapStateToModelWhenNoConfigurationIsProvided)
 }
 
@@ -248,6 +258,128 @@ resource "instana_synthetic_test" "example" {
 	return newSyntheticTestIntegrationTest(terraformTemplate, serverResponseTemplate, checks)
 }
 
+func syntheticTestBrowserScriptIntegrationTest() *syntheticTestResourceIntegrationTest {
+	const terraformTemplate = `
+resource "instana_synthetic_test" "example" {
+	label          = "label %d"
+	active         = true
+	locations      = ["location-id"]
+	test_frequency = 10
+	playback_mode  = "Staggered"
+
+	browser_script {
+		mark_synthetic_call = true
+		retries             = 0
+		retry_interval      = 1
+		timeout             = "3m"
+		browser             = "firefox"
+		record_video        = true
+		script              = "my-script"
+		script_type         = "Jest"
+	}
+}
+`
+
+	const serverResponseTemplate = `
+{
+    "id": "%s",
+    "label": "label %d",
+    "active": true,
+    "locations": ["location-id"],
+    "testFrequency": 10,
+    "playbackMode": "Staggered",
+
+    "configuration": {
+        "syntheticType": "BrowserScript",
+        "markSyntheticCall": true,
+		"retryInterval": 1,
+		"timeout": "3m",
+		"browser": "firefox",
+		"recordVideo": true,
+		"script": "my-script",
+		"scriptType": "Jest"
+    }
+}
+`
+	var checks = []resource.TestCheckFunc{
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigBrowserScript, SyntheticTestFieldConfigMarkSyntheticCall), "true"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigBrowserScript, SyntheticTestFieldConfigRetries), "0"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigBrowserScript, SyntheticTestFieldConfigRetryInterval), "1"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigBrowserScript, SyntheticTestFieldConfigTimeout), "3m"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigBrowserScript, SyntheticTestFieldConfigBrowser), "firefox"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigBrowserScript, SyntheticTestFieldConfigRecordVideo), "true"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigBrowserScript, SyntheticTestFieldConfigScript), "my-script"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigBrowserScript, SyntheticTestFieldConfigScriptType), "Jest"),
+	}
+	return newSyntheticTestIntegrationTest(terraformTemplate, serverResponseTemplate, checks)
+}
+
+func syntheticTestDNSIntegrationTest() *syntheticTestResourceIntegrationTest {
+	const terraformTemplate = `
+resource "instana_synthetic_test" "example" {
+	label          = "label %d"
+	active         = true
+	locations      = ["location-id"]
+	test_frequency = 10
+	playback_mode  = "Staggered"
+
+	dns {
+		mark_synthetic_call = true
+		retries             = 0
+		retry_interval      = 1
+		timeout             = "3m"
+		lookup              = "example.com"
+		server              = "8.8.8.8"
+		query_type          = "AAAA"
+		port                = 53
+		accept_cname        = true
+		lookup_server_name  = false
+		recursive_lookups   = true
+		server_retries      = 2
+	}
+}
+`
+
+	const serverResponseTemplate = `
+{
+    "id": "%s",
+    "label": "label %d",
+    "active": true,
+    "locations": ["location-id"],
+    "testFrequency": 10,
+    "playbackMode": "Staggered",
+
+    "configuration": {
+        "syntheticType": "DNSAction",
+        "markSyntheticCall": true,
+		"retryInterval": 1,
+		"timeout": "3m",
+		"lookup": "example.com",
+		"server": "8.8.8.8",
+		"queryType": "AAAA",
+		"port": 53,
+		"acceptCNAME": true,
+		"lookupServerName": false,
+		"recursiveLookups": true,
+		"serverRetries": 2
+    }
+}
+`
+	var checks = []resource.TestCheckFunc{
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigDNS, SyntheticTestFieldConfigMarkSyntheticCall), "true"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigDNS, SyntheticTestFieldConfigTimeout), "3m"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigDNS, SyntheticTestFieldConfigLookup), "example.com"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigDNS, SyntheticTestFieldConfigServer), "8.8.8.8"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigDNS, SyntheticTestFieldConfigQueryType), "AAAA"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigDNS, SyntheticTestFieldConfigPort), "53"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigDNS, SyntheticTestFieldConfigAcceptCNAME), "true"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigDNS, SyntheticTestFieldConfigLookupServerName), "false"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigDNS, SyntheticTestFieldConfigRecursiveLookups), "true"),
+		resource.TestCheckResourceAttr(syntheticTestDefinition, fmt.Sprintf(syntheticTestConfigPattern, SyntheticTestFieldConfigDNS, SyntheticTestFieldConfigServerRetries), "2"),
+	}
+	return newSyntheticTestIntegrationTest(terraformTemplate, serverResponseTemplate, checks)
+}
+
 func newSyntheticTestIntegrationTest(resourceTemplate string, serverResponseTemplate string, useCaseSpecificChecks []resource.TestCheckFunc) *syntheticTestResourceIntegrationTest {
 	return &syntheticTestResourceIntegrationTest{
 		resourceTemplate:       resourceTemplate,
@@ -348,7 +480,7 @@ func (ut *syntheticTestUnitTest) resourceDefinitionShouldBeValid(t *testing.T) {
 	schemaMap := resourceHandle.MetaData().Schema
 
 	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
-	require.Len(t, schemaMap, 10)
+	require.Len(t, schemaMap, 14)
 	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(SyntheticTestFieldLabel)
 	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(SyntheticTestFieldDescription)
 	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(SyntheticTestFieldActive, true)
@@ -359,11 +491,23 @@ func (ut *syntheticTestUnitTest) resourceDefinitionShouldBeValid(t *testing.T) {
 	schemaAssert.AssertSchemaIsOptionalAndOfTypeInt(SyntheticTestFieldTestFrequency)
 	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(SyntheticTestFieldConfigHttpAction)
 	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(SyntheticTestFieldConfigHttpScript)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(SyntheticTestFieldConfigBrowserScript)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(SyntheticTestFieldConfigWebpageAction)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(SyntheticTestFieldConfigWebpageScript)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(SyntheticTestFieldConfigDNS)
 
 	httpActionSchema := schemaMap[SyntheticTestFieldConfigHttpAction].Elem.(*schema.Resource).Schema
 	ut.verifyHttpActionSchema(t, httpActionSchema)
 	httpScriptSchema := schemaMap[SyntheticTestFieldConfigHttpScript].Elem.(*schema.Resource).Schema
 	ut.verifyHttpScriptSchema(t, httpScriptSchema)
+	browserScriptSchema := schemaMap[SyntheticTestFieldConfigBrowserScript].Elem.(*schema.Resource).Schema
+	ut.verifyBrowserScriptSchema(t, browserScriptSchema)
+	webpageActionSchema := schemaMap[SyntheticTestFieldConfigWebpageAction].Elem.(*schema.Resource).Schema
+	ut.verifyWebpageActionSchema(t, webpageActionSchema)
+	webpageScriptSchema := schemaMap[SyntheticTestFieldConfigWebpageScript].Elem.(*schema.Resource).Schema
+	ut.verifyWebpageScriptSchema(t, webpageScriptSchema)
+	dnsSchema := schemaMap[SyntheticTestFieldConfigDNS].Elem.(*schema.Resource).Schema
+	ut.verifyDNSSchema(t, dnsSchema)
 }
 
 func (ut *syntheticTestUnitTest) verifyHttpActionSchema(t *testing.T, schemaMap map[string]*schema.Schema) {
@@ -388,6 +532,80 @@ func (ut *syntheticTestUnitTest) verifyHttpScriptSchema(t *testing.T, schemaMap
 	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(SyntheticTestFieldConfigScript)
 }
 
+func (ut *syntheticTestUnitTest) verifyBrowserScriptSchema(t *testing.T, schemaMap map[string]*schema.Schema) {
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	require.Len(t, schemaMap, 9)
+	ut.verifyCommonConfigurationFields(schemaAssert)
+	ut.verifyCommonBrowserConfigurationFields(schemaAssert)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(SyntheticTestFieldConfigScript)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeStringWithDefault(SyntheticTestFieldConfigScriptType, "Basic")
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(SyntheticTestFieldConfigScripts)
+
+	scriptsSchemaAssert := testutils.NewTerraformSchemaAssert(schemaMap[SyntheticTestFieldConfigScripts].Elem.(*schema.Resource).Schema, t)
+	scriptsSchemaAssert.AssertSchemaIsRequiredAndOfTypeString(SyntheticTestFieldConfigScriptsBundle)
+	scriptsSchemaAssert.AssertSchemaIsRequiredAndOfTypeString(SyntheticTestFieldConfigScriptsScriptFile)
+}
+
+func (ut *syntheticTestUnitTest) shouldRequireExactlyOneOfScriptOrScriptsForBrowserScript(t *testing.T) {
+	testResource := &schema.Resource{Schema: NewSyntheticTestResourceHandle().MetaData().Schema}
+	createConfig := func(browserScript map[string]interface{}) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			SyntheticTestFieldLabel:               syntheticTestLabel,
+			SyntheticTestFieldLocations:           []interface{}{"location-id"},
+			SyntheticTestFieldConfigBrowserScript: []interface{}{browserScript},
+		})
+	}
+	script := map[string]interface{}{SyntheticTestFieldConfigScript: "script"}
+	scripts := map[string]interface{}{SyntheticTestFieldConfigScripts: []interface{}{map[string]interface{}{
+		SyntheticTestFieldConfigScriptsBundle:     "bundle",
+		SyntheticTestFieldConfigScriptsScriptFile: "index.js",
+	}}}
+	both := map[string]interface{}{
+		SyntheticTestFieldConfigScript:  script[SyntheticTestFieldConfigScript],
+		SyntheticTestFieldConfigScripts: scripts[SyntheticTestFieldConfigScripts],
+	}
+
+	require.False(t, testResource.Validate(createConfig(script)).HasError())
+	require.False(t, testResource.Validate(createConfig(scripts)).HasError())
+	require.True(t, testResource.Validate(createConfig(both)).HasError())
+	require.True(t, testResource.Validate(createConfig(map[string]interface{}{SyntheticTestFieldConfigBrowser: "chrome"})).HasError())
+}
+
+func (ut *syntheticTestUnitTest) verifyWebpageActionSchema(t *testing.T, schemaMap map[string]*schema.Schema) {
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	require.Len(t, schemaMap, 7)
+	ut.verifyCommonConfigurationFields(schemaAssert)
+	ut.verifyCommonBrowserConfigurationFields(schemaAssert)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(SyntheticTestFieldConfigUrl)
+}
+
+func (ut *syntheticTestUnitTest) verifyWebpageScriptSchema(t *testing.T, schemaMap map[string]*schema.Schema) {
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	require.Len(t, schemaMap, 7)
+	ut.verifyCommonConfigurationFields(schemaAssert)
+	ut.verifyCommonBrowserConfigurationFields(schemaAssert)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(SyntheticTestFieldConfigScript)
+}
+
+func (ut *syntheticTestUnitTest) verifyDNSSchema(t *testing.T, schemaMap map[string]*schema.Schema) {
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	require.Len(t, schemaMap, 12)
+	ut.verifyCommonConfigurationFields(schemaAssert)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(SyntheticTestFieldConfigLookup)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(SyntheticTestFieldConfigServer)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeStringWithDefault(SyntheticTestFieldConfigQueryType, "A")
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeIntWithDefault(SyntheticTestFieldConfigPort, 53)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(SyntheticTestFieldConfigAcceptCNAME, false)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(SyntheticTestFieldConfigLookupServerName, false)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(SyntheticTestFieldConfigRecursiveLookups, true)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeIntWithDefault(SyntheticTestFieldConfigServerRetries, 1)
+}
+
+func (ut *syntheticTestUnitTest) verifyCommonBrowserConfigurationFields(schemaAssert testutils.TerraformSchemaAssert) {
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeStringWithDefault(SyntheticTestFieldConfigBrowser, "chrome")
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(SyntheticTestFieldConfigRecordVideo, false)
+}
+
 func (ut *syntheticTestUnitTest) verifyCommonConfigurationFields(schemaAssert testutils.TerraformSchemaAssert) {
 	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(SyntheticTestFieldConfigMarkSyntheticCall, false)
 	schemaAssert.AssertSchemaIsOptionalAndOfTypeInt(SyntheticTestFieldConfigRetries)
@@ -570,6 +788,204 @@ func (ut *syntheticTestUnitTest) shouldUpdateResourceStateForHttpAction(t *testi
 	require.Equal(t, expectMatch, httpActionConfig[SyntheticTestFieldConfigExpectMatch])
 }
 
+func (ut *syntheticTestUnitTest) shouldUpdateResourceStateForBrowserScript(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.SyntheticTest](t)
+	resourceHandle := NewSyntheticTestResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+
+	timeout := "20s"
+	browser := "firefox"
+	recordVideo := true
+	scriptType := "Jest"
+	bundle := "bundle-content"
+	scriptFile := "index.js"
+	data := ut.createSyntheticTestWithConfiguration(restapi.SyntheticTestConfig{
+		SyntheticType:     SyntheticCheckTypeBrowserScript,
+		MarkSyntheticCall: true,
+		Retries:           1,
+		RetryInterval:     2,
+		Timeout:           &timeout,
+		Browser:           &browser,
+		RecordVideo:       &recordVideo,
+		ScriptType:        &scriptType,
+		Scripts: &restapi.SyntheticTestScriptFiles{
+			Bundle:     &bundle,
+			ScriptFile: &scriptFile,
+		},
+	})
+
+	err := resourceHandle.UpdateState(resourceData, data)
+
+	require.Nil(t, err)
+	ut.requireOnlyConfigurationOfTypeIsSet(t, resourceData, SyntheticTestFieldConfigBrowserScript)
+
+	config := resourceData.Get(SyntheticTestFieldConfigBrowserScript).([]interface{})[0].(map[string]interface{})
+	require.Len(t, config, 9)
+	require.Equal(t, true, config[SyntheticTestFieldConfigMarkSyntheticCall])
+	require.Equal(t, 1, config[SyntheticTestFieldConfigRetries])
+	require.Equal(t, 2, config[SyntheticTestFieldConfigRetryInterval])
+	require.Equal(t, timeout, config[SyntheticTestFieldConfigTimeout])
+	require.Equal(t, browser, config[SyntheticTestFieldConfigBrowser])
+	require.Equal(t, recordVideo, config[SyntheticTestFieldConfigRecordVideo])
+	require.Equal(t, "", config[SyntheticTestFieldConfigScript])
+	require.Equal(t, scriptType, config[SyntheticTestFieldConfigScriptType])
+	require.Equal(t, []interface{}{
+		map[string]interface{}{
+			SyntheticTestFieldConfigScriptsBundle:     bundle,
+			SyntheticTestFieldConfigScriptsScriptFile: scriptFile,
+		},
+	}, config[SyntheticTestFieldConfigScripts])
+}
+
+func (ut *syntheticTestUnitTest) shouldUpdateResourceStateForWebpageAction(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.SyntheticTest](t)
+	resourceHandle := NewSyntheticTestResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+
+	timeout := "20s"
+	browser := "chrome"
+	recordVideo := false
+	url := "https://app.example.com"
+	data := ut.createSyntheticTestWithConfiguration(restapi.SyntheticTestConfig{
+		SyntheticType:     SyntheticCheckTypeWebpageAction,
+		MarkSyntheticCall: true,
+		Retries:           1,
+		RetryInterval:     2,
+		Timeout:           &timeout,
+		Browser:           &browser,
+		RecordVideo:       &recordVideo,
+		URL:               &url,
+	})
+
+	err := resourceHandle.UpdateState(resourceData, data)
+
+	require.Nil(t, err)
+	ut.requireOnlyConfigurationOfTypeIsSet(t, resourceData, SyntheticTestFieldConfigWebpageAction)
+
+	config := resourceData.Get(SyntheticTestFieldConfigWebpageAction).([]interface{})[0].(map[string]interface{})
+	require.Len(t, config, 7)
+	require.Equal(t, true, config[SyntheticTestFieldConfigMarkSyntheticCall])
+	require.Equal(t, 1, config[SyntheticTestFieldConfigRetries])
+	require.Equal(t, 2, config[SyntheticTestFieldConfigRetryInterval])
+	require.Equal(t, timeout, config[SyntheticTestFieldConfigTimeout])
+	require.Equal(t, browser, config[SyntheticTestFieldConfigBrowser])
+	require.Equal(t, recordVideo, config[SyntheticTestFieldConfigRecordVideo])
+	require.Equal(t, url, config[SyntheticTestFieldConfigUrl])
+}
+
+func (ut *syntheticTestUnitTest) shouldUpdateResourceStateForWebpageScript(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.SyntheticTest](t)
+	resourceHandle := NewSyntheticTestResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+
+	timeout := "20s"
+	browser := "chrome"
+	recordVideo := true
+	script := "my-selenium-script"
+	data := ut.createSyntheticTestWithConfiguration(restapi.SyntheticTestConfig{
+		SyntheticType:     SyntheticCheckTypeWebpageScript,
+		MarkSyntheticCall: true,
+		Retries:           1,
+		RetryInterval:     2,
+		Timeout:           &timeout,
+		Browser:           &browser,
+		RecordVideo:       &recordVideo,
+		Script:            &script,
+	})
+
+	err := resourceHandle.UpdateState(resourceData, data)
+
+	require.Nil(t, err)
+	ut.requireOnlyConfigurationOfTypeIsSet(t, resourceData, SyntheticTestFieldConfigWebpageScript)
+
+	config := resourceData.Get(SyntheticTestFieldConfigWebpageScript).([]interface{})[0].(map[string]interface{})
+	require.Len(t, config, 7)
+	require.Equal(t, true, config[SyntheticTestFieldConfigMarkSyntheticCall])
+	require.Equal(t, 1, config[SyntheticTestFieldConfigRetries])
+	require.Equal(t, 2, config[SyntheticTestFieldConfigRetryInterval])
+	require.Equal(t, timeout, config[SyntheticTestFieldConfigTimeout])
+	require.Equal(t, browser, config[SyntheticTestFieldConfigBrowser])
+	require.Equal(t, recordVideo, config[SyntheticTestFieldConfigRecordVideo])
+	require.Equal(t, script, config[SyntheticTestFieldConfigScript])
+}
+
+func (ut *syntheticTestUnitTest) shouldUpdateResourceStateForDNS(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.SyntheticTest](t)
+	resourceHandle := NewSyntheticTestResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+
+	timeout := "20s"
+	lookup := "example.com"
+	server := "8.8.8.8"
+	queryType := "MX"
+	port := int32(5353)
+	acceptCNAME := true
+	lookupServerName := true
+	recursiveLookups := false
+	serverRetries := int32(3)
+	data := ut.createSyntheticTestWithConfiguration(restapi.SyntheticTestConfig{
+		SyntheticType:     SyntheticCheckTypeDNS,
+		MarkSyntheticCall: false,
+		Retries:           1,
+		RetryInterval:     2,
+		Timeout:           &timeout,
+		Lookup:            &lookup,
+		Server:            &server,
+		QueryType:         &queryType,
+		Port:              &port,
+		AcceptCNAME:       &acceptCNAME,
+		LookupServerName:  &lookupServerName,
+		RecursiveLookups:  &recursiveLookups,
+		ServerRetries:     &serverRetries,
+	})
+
+	err := resourceHandle.UpdateState(resourceData, data)
+
+	require.Nil(t, err)
+	ut.requireOnlyConfigurationOfTypeIsSet(t, resourceData, SyntheticTestFieldConfigDNS)
+
+	config := resourceData.Get(SyntheticTestFieldConfigDNS).([]interface{})[0].(map[string]interface{})
+	require.Len(t, config, 12)
+	require.Equal(t, false, config[SyntheticTestFieldConfigMarkSyntheticCall])
+	require.Equal(t, 1, config[SyntheticTestFieldConfigRetries])
+	require.Equal(t, 2, config[SyntheticTestFieldConfigRetryInterval])
+	require.Equal(t, timeout, config[SyntheticTestFieldConfigTimeout])
+	require.Equal(t, lookup, config[SyntheticTestFieldConfigLookup])
+	require.Equal(t, server, config[SyntheticTestFieldConfigServer])
+	require.Equal(t, queryType, config[SyntheticTestFieldConfigQueryType])
+	require.Equal(t, int(port), config[SyntheticTestFieldConfigPort])
+	require.Equal(t, acceptCNAME, config[SyntheticTestFieldConfigAcceptCNAME])
+	require.Equal(t, lookupServerName, config[SyntheticTestFieldConfigLookupServerName])
+	require.Equal(t, recursiveLookups, config[SyntheticTestFieldConfigRecursiveLookups])
+	require.Equal(t, int(serverRetries), config[SyntheticTestFieldConfigServerRetries])
+}
+
+func (ut *syntheticTestUnitTest) createSyntheticTestWithConfiguration(config restapi.SyntheticTestConfig) *restapi.SyntheticTest {
+	testFrequency := int32(2)
+	return &restapi.SyntheticTest{
+		ID:            syntheticTestID,
+		Label:         syntheticTestLabel,
+		Active:        syntheticTestActive,
+		Configuration: config,
+		Locations:     []string{"loc1"},
+		PlaybackMode:  "Simultaneous",
+		TestFrequency: &testFrequency,
+	}
+}
+
+func (ut *syntheticTestUnitTest) requireOnlyConfigurationOfTypeIsSet(t *testing.T, resourceData *schema.ResourceData, expectedConfigurationField string) {
+	require.Equal(t, syntheticTestID, resourceData.Id())
+	require.Equal(t, syntheticTestLabel, resourceData.Get(SyntheticTestFieldLabel))
+	for _, field := range []string{SyntheticTestFieldConfigHttpAction, SyntheticTestFieldConfigHttpScript, SyntheticTestFieldConfigBrowserScript, SyntheticTestFieldConfigWebpageAction, SyntheticTestFieldConfigWebpageScript, SyntheticTestFieldConfigDNS} {
+		require.IsType(t, []interface{}{}, resourceData.Get(field))
+		if field == expectedConfigurationField {
+			require.Len(t, resourceData.Get(field).([]interface{}), 1)
+		} else {
+			require.Len(t, resourceData.Get(field).([]interface{}), 0)
+		}
+	}
+}
+
 func (ut *syntheticTestUnitTest) shouldReturnErrorWhenTryingToUpdateStateAndConfigTypeIsNotSupported(t *testing.T) {
 	testHelper := NewTestHelper[*restapi.SyntheticTest](t)
 	resourceHandle := NewSyntheticTestResourceHandle()
@@ -730,6 +1146,105 @@ func (ut *syntheticTestUnitTest) shouldMapStateToDataModelWithConfigOfTypeHttpSc
 	}, model)
 }
 
+func (ut *syntheticTestUnitTest) shouldMapStateToDataModelWithConfigOfTypeBrowserScript(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.SyntheticTest](t)
+	resourceHandle := NewSyntheticTestResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+
+	timeout := "20s"
+	browser := "firefox"
+	recordVideo := true
+	scriptType := "Jest"
+	bundle := "bundle-content"
+	scriptFile := "index.js"
+	resourceData.SetId(syntheticTestID)
+	setValueOnResourceData(t, resourceData, SyntheticTestFieldLabel, syntheticTestLabel)
+	setValueOnResourceData(t, resourceData, SyntheticTestFieldLocations, []interface{}{"loc1"})
+	setValueOnResourceData(t, resourceData, SyntheticTestFieldConfigBrowserScript, []interface{}{
+		map[string]interface{}{
+			SyntheticTestFieldConfigMarkSyntheticCall: true,
+			SyntheticTestFieldConfigRetries:           1,
+			SyntheticTestFieldConfigRetryInterval:     2,
+			SyntheticTestFieldConfigTimeout:           timeout,
+			SyntheticTestFieldConfigBrowser:           browser,
+			SyntheticTestFieldConfigRecordVideo:       recordVideo,
+			SyntheticTestFieldConfigScriptType:        scriptType,
+			SyntheticTestFieldConfigScripts: []interface{}{
+				map[string]interface{}{
+					SyntheticTestFieldConfigScriptsBundle:     bundle,
+					SyntheticTestFieldConfigScriptsScriptFile: scriptFile,
+				},
+			},
+		},
+	})
+
+	model, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.Nil(t, err)
+	require.Equal(t, restapi.SyntheticTestConfig{
+		SyntheticType:     SyntheticCheckTypeBrowserScript,
+		MarkSyntheticCall: true,
+		Retries:           1,
+		RetryInterval:     2,
+		Timeout:           &timeout,
+		Browser:           &browser,
+		RecordVideo:       &recordVideo,
+		ScriptType:        &scriptType,
+		Scripts: &restapi.SyntheticTestScriptFiles{
+			Bundle:     &bundle,
+			ScriptFile: &scriptFile,
+		},
+	}, model.Configuration)
+}
+
+func (ut *syntheticTestUnitTest) shouldMapStateToDataModelWithConfigOfTypeDNS(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.SyntheticTest](t)
+	resourceHandle := NewSyntheticTestResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+
+	lookup := "example.com"
+	server := "8.8.8.8"
+	queryType := "MX"
+	port := int32(5353)
+	acceptCNAME := true
+	recursiveLookups := true
+	serverRetries := int32(3)
+	resourceData.SetId(syntheticTestID)
+	setValueOnResourceData(t, resourceData, SyntheticTestFieldLabel, syntheticTestLabel)
+	setValueOnResourceData(t, resourceData, SyntheticTestFieldLocations, []interface{}{"loc1"})
+	setValueOnResourceData(t, resourceData, SyntheticTestFieldConfigDNS, []interface{}{
+		map[string]interface{}{
+			SyntheticTestFieldConfigMarkSyntheticCall: false,
+			SyntheticTestFieldConfigRetries:           1,
+			SyntheticTestFieldConfigRetryInterval:     2,
+			SyntheticTestFieldConfigLookup:            lookup,
+			SyntheticTestFieldConfigServer:            server,
+			SyntheticTestFieldConfigQueryType:         queryType,
+			SyntheticTestFieldConfigPort:              int(port),
+			SyntheticTestFieldConfigAcceptCNAME:       acceptCNAME,
+			SyntheticTestFieldConfigLookupServerName:  false,
+			SyntheticTestFieldConfigRecursiveLookups:  recursiveLookups,
+			SyntheticTestFieldConfigServerRetries:     int(serverRetries),
+		},
+	})
+
+	model, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.Nil(t, err)
+	require.Equal(t, restapi.SyntheticTestConfig{
+		SyntheticType:    SyntheticCheckTypeDNS,
+		Retries:          1,
+		RetryInterval:    2,
+		Lookup:           &lookup,
+		Server:           &server,
+		QueryType:        &queryType,
+		Port:             &port,
+		AcceptCNAME:      &acceptCNAME,
+		RecursiveLookups: &recursiveLookups,
+		ServerRetries:    &serverRetries,
+	}, model.Configuration)
+}
+
 func (ut *syntheticTestUnitTest) shouldReturnErrorWhenTryingToMapStateToModelWhenNoConfigurationIsProvided(t *testing.T) {
 	testHelper := NewTestHelper[*restapi.SyntheticTest](t)
 	resourceHandle := NewSyntheticTestResourceHandle()
@@ -0,0 +1,105 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaUserInvitation the name of the terraform-provider-instana resource to manage user invitations
+const ResourceInstanaUserInvitation = "instana_user_invitation"
+
+const (
+	//UserInvitationFieldEmail constant value for the schema field email
+	UserInvitationFieldEmail = "email"
+	//UserInvitationFieldGroupIDs constant value for the schema field group_ids
+	UserInvitationFieldGroupIDs = "group_ids"
+	//UserInvitationFieldAccepted constant value for the computed schema field accepted
+	UserInvitationFieldAccepted = "accepted"
+	//UserInvitationFieldUserID constant value for the computed schema field user_id
+	UserInvitationFieldUserID = "user_id"
+)
+
+// NewUserInvitationResourceHandle creates the resource handle for user invitations
+func NewUserInvitationResourceHandle() ResourceHandle[*restapi.UserInvitation] {
+	return &userInvitationResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaUserInvitation,
+			Schema: map[string]*schema.Schema{
+				UserInvitationFieldEmail: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The email address of the user which is invited",
+				},
+				UserInvitationFieldGroupIDs: {
+					Type:        schema.TypeSet,
+					Required:    true,
+					ForceNew:    true,
+					MinItems:    1,
+					Description: "The IDs of the groups the user is invited into",
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				UserInvitationFieldAccepted: {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Flag to indicate whether the invitation is accepted by the user",
+				},
+				UserInvitationFieldUserID: {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The ID of the user once the invitation is accepted",
+				},
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+			CreateOnly:       true,
+		},
+	}
+}
+
+type userInvitationResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *userInvitationResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *userInvitationResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *userInvitationResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.UserInvitation] {
+	return api.UserInvitations()
+}
+
+func (r *userInvitationResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *userInvitationResource) UpdateState(d *schema.ResourceData, invitation *restapi.UserInvitation) error {
+	data := map[string]interface{}{
+		UserInvitationFieldEmail:    invitation.Email,
+		UserInvitationFieldAccepted: invitation.Accepted,
+		UserInvitationFieldUserID:   invitation.UserID,
+	}
+	//the groups of an invitation cannot be read from the Instana API; they are only known when the invitation is created
+	if invitation.GroupIDs != nil {
+		data[UserInvitationFieldGroupIDs] = invitation.GroupIDs
+	}
+
+	d.SetId(invitation.Email)
+	return tfutils.UpdateState(d, data)
+}
+
+func (r *userInvitationResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.UserInvitation, error) {
+	return &restapi.UserInvitation{
+		Email:    d.Get(UserInvitationFieldEmail).(string),
+		GroupIDs: ConvertInterfaceSlice[string](d.Get(UserInvitationFieldGroupIDs).(*schema.Set).List()),
+	}, nil
+}
@@ -0,0 +1,147 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+const userInvitationTerraformTemplate = `
+resource "instana_user_invitation" "example" {
+	email     = "john.doe@example.com"
+	group_ids = ["group-1", "group-2"]
+}
+`
+
+const userInvitationDefinition = "instana_user_invitation.example"
+const userInvitationEmail = "john.doe@example.com"
+
+func TestCRUDOfUserInvitation(t *testing.T) {
+	pendingInvitations := make([]*restapi.InvitationResult, 0)
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodPost, restapi.UserInvitationsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		invitations := make([]map[string]string, 0)
+		err := json.NewDecoder(r.Body).Decode(&invitations)
+		if err != nil {
+			httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		results := make([]*restapi.InvitationResult, len(invitations))
+		for i, invitation := range invitations {
+			results[i] = &restapi.InvitationResult{InvitationStatus: restapi.InvitationStatusSuccess, UserEmail: invitation["email"]}
+		}
+		pendingInvitations = []*restapi.InvitationResult{results[0]}
+		data, _ := json.Marshal([]*restapi.InvitationResponse{{InvitationResults: results}})
+		httpServer.WriteJSONResponse(w, data)
+	})
+	httpServer.AddRoute(http.MethodGet, restapi.UserInvitationsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		data, _ := json.Marshal(pendingInvitations)
+		httpServer.WriteJSONResponse(w, data)
+	})
+	httpServer.AddRoute(http.MethodDelete, restapi.UserInvitationsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		pendingInvitations = make([]*restapi.InvitationResult, 0)
+		testutils.EchoHandlerFunc(w, r)
+	})
+	httpServer.AddRoute(http.MethodGet, restapi.UsersResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		httpServer.WriteJSONResponse(w, []byte("[]"))
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: appendProviderConfig(userInvitationTerraformTemplate, httpServer.GetPort()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(userInvitationDefinition, "id", userInvitationEmail),
+					resource.TestCheckResourceAttr(userInvitationDefinition, UserInvitationFieldEmail, userInvitationEmail),
+					resource.TestCheckResourceAttr(userInvitationDefinition, UserInvitationFieldGroupIDs+".#", "2"),
+					resource.TestCheckResourceAttr(userInvitationDefinition, UserInvitationFieldAccepted, falseAsString),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceUserInvitationDefinition(t *testing.T) {
+	metaData := NewUserInvitationResourceHandle().MetaData()
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(metaData.Schema, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(UserInvitationFieldEmail)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeSetOfStrings(UserInvitationFieldGroupIDs)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeBool(UserInvitationFieldAccepted)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(UserInvitationFieldUserID)
+	require.True(t, metaData.Schema[UserInvitationFieldEmail].ForceNew)
+	require.True(t, metaData.Schema[UserInvitationFieldGroupIDs].ForceNew)
+	require.True(t, metaData.CreateOnly)
+	require.True(t, metaData.SkipIDGeneration)
+}
+
+func TestShouldUpdateResourceStateForAcceptedUserInvitation(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.UserInvitation](t)
+	resourceHandle := NewUserInvitationResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, UserInvitationFieldGroupIDs, []interface{}{"group-1"})
+	userID := "user-id"
+	data := restapi.UserInvitation{
+		Email:    userInvitationEmail,
+		Accepted: true,
+		UserID:   &userID,
+	}
+
+	err := resourceHandle.UpdateState(resourceData, &data)
+
+	require.NoError(t, err)
+	require.Equal(t, userInvitationEmail, resourceData.Id())
+	require.Equal(t, userInvitationEmail, resourceData.Get(UserInvitationFieldEmail))
+	require.True(t, resourceData.Get(UserInvitationFieldAccepted).(bool))
+	require.Equal(t, userID, resourceData.Get(UserInvitationFieldUserID))
+	require.Equal(t, []interface{}{"group-1"}, resourceData.Get(UserInvitationFieldGroupIDs).(*schema.Set).List(), "group ids must be kept when not provided by the API")
+}
+
+func TestShouldUpdateResourceStateForCreatedUserInvitation(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.UserInvitation](t)
+	resourceHandle := NewUserInvitationResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	data := restapi.UserInvitation{
+		Email:    userInvitationEmail,
+		GroupIDs: []string{"group-1", "group-2"},
+	}
+
+	err := resourceHandle.UpdateState(resourceData, &data)
+
+	require.NoError(t, err)
+	require.Equal(t, userInvitationEmail, resourceData.Id())
+	require.False(t, resourceData.Get(UserInvitationFieldAccepted).(bool))
+	require.Equal(t, "", resourceData.Get(UserInvitationFieldUserID))
+	require.ElementsMatch(t, []interface{}{"group-1", "group-2"}, resourceData.Get(UserInvitationFieldGroupIDs).(*schema.Set).List())
+}
+
+func TestShouldConvertStateOfUserInvitationToDataModel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.UserInvitation](t)
+	resourceHandle := NewUserInvitationResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	setValueOnResourceData(t, resourceData, UserInvitationFieldEmail, userInvitationEmail)
+	setValueOnResourceData(t, resourceData, UserInvitationFieldGroupIDs, []interface{}{"group-1", "group-2"})
+
+	model, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.Equal(t, userInvitationEmail, model.GetIDForResourcePath())
+	require.ElementsMatch(t, []string{"group-1", "group-2"}, model.GroupIDs)
+}
+
+func TestShouldReturnCorrectResourceNameForUserInvitation(t *testing.T) {
+	name := NewUserInvitationResourceHandle().MetaData().ResourceName
+
+	require.Equal(t, "instana_user_invitation", name)
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
 	"github.com/gessnerfl/terraform-provider-instana/instana/tagfilter"
 	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -19,6 +20,8 @@ const (
 	WebsiteAlertConfigFieldWebsiteID = "website_id"
 	//WebsiteAlertConfigFieldDescription constant value for field description of resource instana_website_alert_config
 	WebsiteAlertConfigFieldDescription = "description"
+	//WebsiteAlertConfigFieldEnabled constant value for field enabled of resource instana_website_alert_config
+	WebsiteAlertConfigFieldEnabled = "enabled"
 	//WebsiteAlertConfigFieldGranularity constant value for field granularity of resource instana_website_alert_config
 	WebsiteAlertConfigFieldGranularity = "granularity"
 	//WebsiteAlertConfigFieldName constant value for field name of resource instana_website_alert_config
@@ -267,6 +270,12 @@ var (
 			},
 		},
 	}
+	websiteAlertConfigSchemaEnabled = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Optional flag to indicate whether the alert configuration is enabled or not. The default is true",
+	}
 	websiteAlertConfigSchemaTriggering = &schema.Schema{
 		Type:        schema.TypeBool,
 		Optional:    true,
@@ -322,8 +331,10 @@ var (
 
 var websiteAlertConfigResourceSchema = map[string]*schema.Schema{
 	WebsiteAlertConfigFieldAlertChannelIDs: websiteAlertConfigSchemaAlertChannelIDs,
+	ResourceFieldBaselineRefreshTrigger:    baselineRefreshTriggerSchema,
 	DefaultCustomPayloadFieldsName:         buildCustomPayloadFields(),
 	WebsiteAlertConfigFieldDescription:     websiteAlertConfigSchemaDescription,
+	WebsiteAlertConfigFieldEnabled:         websiteAlertConfigSchemaEnabled,
 	WebsiteAlertConfigFieldGranularity:     websiteAlertConfigSchemaGranularity,
 	WebsiteAlertConfigFieldName:            websiteAlertConfigSchemaName,
 	WebsiteAlertConfigFieldRule:            websiteAlertConfigSchemaRule,
@@ -343,6 +354,10 @@ func NewWebsiteAlertConfigResourceHandle() ResourceHandle[*restapi.WebsiteAlertC
 			Schema:           websiteAlertConfigResourceSchema,
 			SkipIDGeneration: true,
 			SchemaVersion:    1,
+			TagCatalogValidation: &TagCatalogValidation{
+				Catalog:         WebsiteTagCatalog,
+				TagFilterFields: []string{WebsiteAlertConfigFieldTagFilter},
+			},
 		},
 	}
 }
@@ -387,7 +402,7 @@ func (r *websiteAlertConfigResource) UpdateState(d *schema.ResourceData, config
 	}
 
 	d.SetId(config.ID)
-	return tfutils.UpdateState(d, map[string]interface{}{
+	data := map[string]interface{}{
 		WebsiteAlertConfigFieldAlertChannelIDs: config.AlertChannelIDs,
 		DefaultCustomPayloadFieldsName:         mapCustomPayloadFieldsToSchema(config),
 		WebsiteAlertConfigFieldDescription:     config.Description,
@@ -400,7 +415,11 @@ func (r *websiteAlertConfigResource) UpdateState(d *schema.ResourceData, config
 		WebsiteAlertConfigFieldTimeThreshold:   r.mapTimeThresholdToSchema(config),
 		WebsiteAlertConfigFieldTriggering:      config.Triggering,
 		WebsiteAlertConfigFieldWebsiteID:       config.WebsiteID,
-	})
+	}
+	if config.Enabled != nil {
+		data[WebsiteAlertConfigFieldEnabled] = *config.Enabled
+	}
+	return tfutils.UpdateState(d, data)
 }
 
 func (r *websiteAlertConfigResource) mapRuleToSchema(config *restapi.WebsiteAlertConfig) []map[string]interface{} {
@@ -498,6 +517,7 @@ func (r *websiteAlertConfigResource) MapStateToDataObject(d *schema.ResourceData
 		AlertChannelIDs:       ReadStringSetParameterFromResource(d, WebsiteAlertConfigFieldAlertChannelIDs),
 		CustomerPayloadFields: customPayloadFields,
 		Description:           d.Get(WebsiteAlertConfigFieldDescription).(string),
+		Enabled:               utils.BoolPtr(d.Get(WebsiteAlertConfigFieldEnabled).(bool)),
 		Granularity:           restapi.Granularity(d.Get(WebsiteAlertConfigFieldGranularity).(int)),
 		Name:                  d.Get(WebsiteMonitoringConfigFieldName).(string),
 		Rule:                  *r.mapRuleFromSchema(d),
@@ -507,6 +527,7 @@ func (r *websiteAlertConfigResource) MapStateToDataObject(d *schema.ResourceData
 		TimeThreshold:         *r.mapTimeThresholdFromSchema(d),
 		Triggering:            d.Get(WebsiteAlertConfigFieldTriggering).(bool),
 		WebsiteID:             d.Get(WebsiteAlertConfigFieldWebsiteID).(string),
+		RefreshBaseline:       d.HasChange(ResourceFieldBaselineRefreshTrigger),
 	}, nil
 }
 
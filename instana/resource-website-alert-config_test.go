@@ -2,12 +2,15 @@ package instana_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
 	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"net/http"
 	"testing"
 
@@ -25,6 +28,58 @@ func TestWebsiteAlertConfig(t *testing.T) {
 	inst.run(t)
 }
 
+func TestWebsiteAlertConfigShouldSupportBaselineRefreshTrigger(t *testing.T) {
+	schemaMap := NewWebsiteAlertConfigResourceHandle().MetaData().Schema
+
+	testutils.NewTerraformSchemaAssert(schemaMap, t).AssertSchemaIsOptionalAndOfTypeString(ResourceFieldBaselineRefreshTrigger)
+}
+
+func TestWebsiteAlertConfigShouldTreatHistoricBaselineDataAsServerManaged(t *testing.T) {
+	thresholdResource := NewWebsiteAlertConfigResourceHandle().MetaData().Schema[ResourceFieldThreshold].Elem.(*schema.Resource)
+	historicBaselineSchema := thresholdResource.Schema[ResourceFieldThresholdHistoricBaseline].Elem.(*schema.Resource).Schema
+
+	require.True(t, historicBaselineSchema[ResourceFieldThresholdHistoricBaselineBaseline].Optional)
+	require.True(t, historicBaselineSchema[ResourceFieldThresholdHistoricBaselineBaseline].Computed)
+	lastUpdated := historicBaselineSchema[ResourceFieldThresholdLastUpdated]
+	require.True(t, lastUpdated.Computed)
+	require.True(t, lastUpdated.DiffSuppressFunc("threshold.0.historic_baseline.0.last_updated", "1234", "5678", nil))
+	require.False(t, lastUpdated.DiffSuppressFunc("threshold.0.historic_baseline.0.last_updated", "", "5678", nil))
+}
+
+func TestWebsiteAlertConfigShouldNotPlanChangesWhenHistoricBaselineIsChangedByServer(t *testing.T) {
+	thresholdResource := &schema.Resource{Schema: map[string]*schema.Schema{
+		ResourceFieldThreshold: NewWebsiteAlertConfigResourceHandle().MetaData().Schema[ResourceFieldThreshold],
+	}}
+	createHistoricBaselineThreshold := func(baseline []interface{}) []interface{} {
+		return []interface{}{map[string]interface{}{
+			ResourceFieldThresholdHistoricBaseline: []interface{}{map[string]interface{}{
+				ResourceFieldThresholdOperator:                    ">=",
+				ResourceFieldThresholdHistoricBaselineSeasonality: "DAILY",
+				ResourceFieldThresholdHistoricBaselineBaseline:    baseline,
+			}},
+		}}
+	}
+	createState := func(baseline []interface{}) *terraform.InstanceState {
+		resourceData := thresholdResource.Data(nil)
+		resourceData.SetId("id")
+		require.NoError(t, resourceData.Set(ResourceFieldThreshold, createHistoricBaselineThreshold(baseline)))
+		return resourceData.State()
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		ResourceFieldThreshold: createHistoricBaselineThreshold([]interface{}{[]interface{}{1.0, 2.0}}),
+	})
+
+	serverManagedDiff, err := thresholdResource.Diff(context.Background(), createState([]interface{}{[]interface{}{3.0, 4.0}}), config, nil)
+
+	require.NoError(t, err)
+	require.True(t, serverManagedDiff == nil || serverManagedDiff.Empty(), "expected empty plan but got %v", serverManagedDiff)
+
+	initialDiff, err := thresholdResource.Diff(context.Background(), createState([]interface{}{}), config, nil)
+
+	require.NoError(t, err)
+	require.False(t, initialDiff == nil || initialDiff.Empty())
+}
+
 type websiteAlertConfigTest struct {
 	terraformResourceInstanceName string
 	resourceHandle                ResourceHandle[*restapi.WebsiteAlertConfig]
@@ -84,6 +139,7 @@ var websiteAlertConfigServerResponseTemplate = `
     "websiteId": "website-id",
     "severity": 5,
     "triggering": false,
+    "enabled": true,
     "tagFilters": [],
     "tagFilterExpression": {
       "type": "TAG_FILTER",
@@ -181,6 +237,7 @@ func (test *websiteAlertConfigTest) createIntegrationTest() func(t *testing.T) {
 			testutils.EchoHandlerFunc(w, r)
 		})
 		httpServer.AddRoute(http.MethodDelete, resourceInstanceRestAPIPath, testutils.EchoHandlerFunc)
+		httpServer.AddRoute(http.MethodPut, resourceInstanceRestAPIPath+"/enable", testutils.EchoHandlerFunc)
 		httpServer.AddRoute(http.MethodGet, resourceInstanceRestAPIPath, func(w http.ResponseWriter, r *http.Request) {
 			modCount := httpServer.GetCallCount(http.MethodPost, resourceRestAPIPath+"/"+id)
 			jsonData := fmt.Sprintf(websiteAlertConfigServerResponseTemplate, id, modCount)
@@ -225,6 +282,7 @@ func (test *websiteAlertConfigTest) createIntegrationTestStep(httpPort int, iter
 			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, WebsiteAlertConfigFieldDescription, "test-alert-description"),
 			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, WebsiteAlertConfigFieldSeverity, restapi.SeverityWarning.GetTerraformRepresentation()),
 			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, WebsiteAlertConfigFieldTriggering, falseAsString),
+			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, WebsiteAlertConfigFieldEnabled, trueAsString),
 			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, WebsiteAlertConfigFieldAlertChannelIDs+".0", "alert-channel-id-1"),
 			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, WebsiteAlertConfigFieldAlertChannelIDs+".1", "alert-channel-id-2"),
 			resource.TestCheckResourceAttr(test.terraformResourceInstanceName, WebsiteAlertConfigFieldGranularity, "600000"),
@@ -557,6 +615,7 @@ func (test *websiteAlertConfigTest) createTestShouldUpdateTerraformResourceState
 			Threshold:           thresholdTestPair.input,
 			TimeThreshold:       timeThresholdTestPair.input,
 			Triggering:          true,
+			Enabled:             utils.BoolPtr(false),
 		}
 
 		testHelper := NewTestHelper[*restapi.WebsiteAlertConfig](t)
@@ -589,6 +648,7 @@ func (test *websiteAlertConfigTest) createTestShouldUpdateTerraformResourceState
 		test.requireWebsiteAlertConfigThresholdSetOnSchema(t, thresholdTestPair.expected, resourceData)
 		require.Equal(t, timeThresholdTestPair.expected, resourceData.Get(WebsiteAlertConfigFieldTimeThreshold))
 		require.True(t, resourceData.Get(WebsiteAlertConfigFieldTriggering).(bool))
+		require.False(t, resourceData.Get(WebsiteAlertConfigFieldEnabled).(bool))
 	}
 }
 
@@ -941,6 +1001,7 @@ func (test *websiteAlertConfigTest) createTestShouldMapTerraformResourceStateToM
 			Threshold:           thresholdTestPair.expected,
 			TimeThreshold:       timeThresholdTestPair.expected,
 			Triggering:          true,
+			Enabled:             utils.BoolPtr(false),
 		}
 
 		testHelper := NewTestHelper[*restapi.WebsiteAlertConfig](t)
@@ -968,6 +1029,7 @@ func (test *websiteAlertConfigTest) createTestShouldMapTerraformResourceStateToM
 		setValueOnResourceData(t, resourceData, ResourceFieldThreshold, thresholdTestPair.input)
 		setValueOnResourceData(t, resourceData, WebsiteAlertConfigFieldTimeThreshold, timeThresholdTestPair.input)
 		setValueOnResourceData(t, resourceData, WebsiteAlertConfigFieldTriggering, true)
+		setValueOnResourceData(t, resourceData, WebsiteAlertConfigFieldEnabled, false)
 		resourceData.SetId(websiteAlertConfigID)
 
 		result, err := sut.MapStateToDataObject(resourceData)
@@ -0,0 +1,240 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaWebsiteGeoLocationConfig the name of the terraform-provider-instana resource to manage the geo location configuration of websites
+const ResourceInstanaWebsiteGeoLocationConfig = "instana_website_geo_location_config"
+
+const (
+	//WebsiteGeoLocationConfigFieldWebsiteID constant value for the schema field website_id
+	WebsiteGeoLocationConfigFieldWebsiteID = "website_id"
+	//WebsiteGeoLocationConfigFieldGeoDetailRemoval constant value for the schema field geo_detail_removal
+	WebsiteGeoLocationConfigFieldGeoDetailRemoval = "geo_detail_removal"
+	//WebsiteGeoLocationConfigFieldGeoMappingRule constant value for the schema field geo_mapping_rule
+	WebsiteGeoLocationConfigFieldGeoMappingRule = "geo_mapping_rule"
+	//WebsiteGeoLocationConfigFieldGeoMappingRuleCIDR constant value for the schema field geo_mapping_rule.cidr
+	WebsiteGeoLocationConfigFieldGeoMappingRuleCIDR = "cidr"
+	//WebsiteGeoLocationConfigFieldGeoMappingRuleAccuracyRadius constant value for the schema field geo_mapping_rule.accuracy_radius
+	WebsiteGeoLocationConfigFieldGeoMappingRuleAccuracyRadius = "accuracy_radius"
+	//WebsiteGeoLocationConfigFieldGeoMappingRuleLatitude constant value for the schema field geo_mapping_rule.latitude
+	WebsiteGeoLocationConfigFieldGeoMappingRuleLatitude = "latitude"
+	//WebsiteGeoLocationConfigFieldGeoMappingRuleLongitude constant value for the schema field geo_mapping_rule.longitude
+	WebsiteGeoLocationConfigFieldGeoMappingRuleLongitude = "longitude"
+	//WebsiteGeoLocationConfigFieldGeoMappingRuleCity constant value for the schema field geo_mapping_rule.city
+	WebsiteGeoLocationConfigFieldGeoMappingRuleCity = "city"
+	//WebsiteGeoLocationConfigFieldGeoMappingRuleCountry constant value for the schema field geo_mapping_rule.country
+	WebsiteGeoLocationConfigFieldGeoMappingRuleCountry = "country"
+	//WebsiteGeoLocationConfigFieldGeoMappingRuleCountryCode constant value for the schema field geo_mapping_rule.country_code
+	WebsiteGeoLocationConfigFieldGeoMappingRuleCountryCode = "country_code"
+	//WebsiteGeoLocationConfigFieldGeoMappingRuleContinent constant value for the schema field geo_mapping_rule.continent
+	WebsiteGeoLocationConfigFieldGeoMappingRuleContinent = "continent"
+	//WebsiteGeoLocationConfigFieldGeoMappingRuleContinentCode constant value for the schema field geo_mapping_rule.continent_code
+	WebsiteGeoLocationConfigFieldGeoMappingRuleContinentCode = "continent_code"
+	//WebsiteGeoLocationConfigFieldGeoMappingRuleSubdivision constant value for the schema field geo_mapping_rule.subdivision
+	WebsiteGeoLocationConfigFieldGeoMappingRuleSubdivision = "subdivision"
+	//WebsiteGeoLocationConfigFieldGeoMappingRuleSubdivisionName constant value for the schema field geo_mapping_rule.subdivision.name
+	WebsiteGeoLocationConfigFieldGeoMappingRuleSubdivisionName = "name"
+	//WebsiteGeoLocationConfigFieldGeoMappingRuleSubdivisionCode constant value for the schema field geo_mapping_rule.subdivision.code
+	WebsiteGeoLocationConfigFieldGeoMappingRuleSubdivisionCode = "code"
+)
+
+func optionalGeoMappingRuleStringSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: description,
+	}
+}
+
+// NewWebsiteGeoLocationConfigResourceHandle creates the resource handle for the geo location configuration of websites
+func NewWebsiteGeoLocationConfigResourceHandle() ResourceHandle[*restapi.WebsiteGeoLocationConfig] {
+	return &websiteGeoLocationConfigResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaWebsiteGeoLocationConfig,
+			Schema: map[string]*schema.Schema{
+				WebsiteGeoLocationConfigFieldWebsiteID: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The ID of the website",
+				},
+				WebsiteGeoLocationConfigFieldGeoDetailRemoval: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(restapi.SupportedGeoDetailRemovalTypes.ToStringSlice(), false),
+					Description:  "The geo location details which are removed from the beacons of the website (NO_REMOVAL, REMOVE_COORDINATES, REMOVE_CITY, REMOVE_ALL)",
+				},
+				WebsiteGeoLocationConfigFieldGeoMappingRule: {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    512,
+					Description: "The custom geo mapping rules which map IP ranges to locations",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							WebsiteGeoLocationConfigFieldGeoMappingRuleCIDR: {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.IsCIDR,
+								Description:  "The IP range in CIDR notation to which the rule applies",
+							},
+							WebsiteGeoLocationConfigFieldGeoMappingRuleAccuracyRadius: {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(-1),
+								Description:  "The accuracy radius of the location in kilometers",
+							},
+							WebsiteGeoLocationConfigFieldGeoMappingRuleLatitude: {
+								Type:         schema.TypeFloat,
+								Optional:     true,
+								ValidateFunc: validation.FloatBetween(-90, 90),
+								Description:  "The latitude of the location",
+							},
+							WebsiteGeoLocationConfigFieldGeoMappingRuleLongitude: {
+								Type:         schema.TypeFloat,
+								Optional:     true,
+								ValidateFunc: validation.FloatBetween(-180, 180),
+								Description:  "The longitude of the location",
+							},
+							WebsiteGeoLocationConfigFieldGeoMappingRuleCity:          optionalGeoMappingRuleStringSchema("The city of the location"),
+							WebsiteGeoLocationConfigFieldGeoMappingRuleCountry:       optionalGeoMappingRuleStringSchema("The country of the location"),
+							WebsiteGeoLocationConfigFieldGeoMappingRuleCountryCode:   optionalGeoMappingRuleStringSchema("The ISO code of the country of the location"),
+							WebsiteGeoLocationConfigFieldGeoMappingRuleContinent:     optionalGeoMappingRuleStringSchema("The continent of the location"),
+							WebsiteGeoLocationConfigFieldGeoMappingRuleContinentCode: optionalGeoMappingRuleStringSchema("The code of the continent of the location"),
+							WebsiteGeoLocationConfigFieldGeoMappingRuleSubdivision: {
+								Type:        schema.TypeList,
+								Optional:    true,
+								MaxItems:    8,
+								Description: "The subdivisions (e.g. state or province) of the location ordered from the most to the least specific one",
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										WebsiteGeoLocationConfigFieldGeoMappingRuleSubdivisionName: {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringLenBetween(1, 256),
+											Description:  "The name of the subdivision",
+										},
+										WebsiteGeoLocationConfigFieldGeoMappingRuleSubdivisionCode: {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringLenBetween(1, 32),
+											Description:  "The code of the subdivision",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+		},
+	}
+}
+
+type websiteGeoLocationConfigResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *websiteGeoLocationConfigResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *websiteGeoLocationConfigResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *websiteGeoLocationConfigResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.WebsiteGeoLocationConfig] {
+	return api.WebsiteGeoLocationConfigs()
+}
+
+func (r *websiteGeoLocationConfigResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *websiteGeoLocationConfigResource) UpdateState(d *schema.ResourceData, config *restapi.WebsiteGeoLocationConfig) error {
+	rules := make([]interface{}, len(config.GeoMappingRules))
+	for i, rule := range config.GeoMappingRules {
+		rules[i] = r.mapGeoMappingRuleToState(rule)
+	}
+
+	d.SetId(config.WebsiteID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		WebsiteGeoLocationConfigFieldWebsiteID:        config.WebsiteID,
+		WebsiteGeoLocationConfigFieldGeoDetailRemoval: string(config.GeoDetailRemoval),
+		WebsiteGeoLocationConfigFieldGeoMappingRule:   rules,
+	})
+}
+
+func (r *websiteGeoLocationConfigResource) mapGeoMappingRuleToState(rule restapi.GeoMappingRule) map[string]interface{} {
+	subdivisions := make([]interface{}, len(rule.Subdivisions))
+	for i, s := range rule.Subdivisions {
+		subdivisions[i] = map[string]interface{}{
+			WebsiteGeoLocationConfigFieldGeoMappingRuleSubdivisionName: s.Name,
+			WebsiteGeoLocationConfigFieldGeoMappingRuleSubdivisionCode: s.Code,
+		}
+	}
+	return map[string]interface{}{
+		WebsiteGeoLocationConfigFieldGeoMappingRuleCIDR:           rule.CIDR,
+		WebsiteGeoLocationConfigFieldGeoMappingRuleAccuracyRadius: rule.AccuracyRadius,
+		WebsiteGeoLocationConfigFieldGeoMappingRuleLatitude:       rule.Latitude,
+		WebsiteGeoLocationConfigFieldGeoMappingRuleLongitude:      rule.Longitude,
+		WebsiteGeoLocationConfigFieldGeoMappingRuleCity:           rule.City,
+		WebsiteGeoLocationConfigFieldGeoMappingRuleCountry:        rule.Country,
+		WebsiteGeoLocationConfigFieldGeoMappingRuleCountryCode:    rule.CountryCode,
+		WebsiteGeoLocationConfigFieldGeoMappingRuleContinent:      rule.Continent,
+		WebsiteGeoLocationConfigFieldGeoMappingRuleContinentCode:  rule.ContinentCode,
+		WebsiteGeoLocationConfigFieldGeoMappingRuleSubdivision:    subdivisions,
+	}
+}
+
+func (r *websiteGeoLocationConfigResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.WebsiteGeoLocationConfig, error) {
+	rulesData := d.Get(WebsiteGeoLocationConfigFieldGeoMappingRule).([]interface{})
+	rules := make([]restapi.GeoMappingRule, len(rulesData))
+	for i, v := range rulesData {
+		rules[i] = r.mapGeoMappingRuleFromState(v.(map[string]interface{}))
+	}
+
+	return &restapi.WebsiteGeoLocationConfig{
+		WebsiteID:        d.Get(WebsiteGeoLocationConfigFieldWebsiteID).(string),
+		GeoDetailRemoval: restapi.GeoDetailRemovalType(d.Get(WebsiteGeoLocationConfigFieldGeoDetailRemoval).(string)),
+		GeoMappingRules:  rules,
+	}, nil
+}
+
+func (r *websiteGeoLocationConfigResource) mapGeoMappingRuleFromState(rule map[string]interface{}) restapi.GeoMappingRule {
+	subdivisionsData := rule[WebsiteGeoLocationConfigFieldGeoMappingRuleSubdivision].([]interface{})
+	subdivisions := make([]restapi.GeoSubdivision, len(subdivisionsData))
+	for i, v := range subdivisionsData {
+		subdivision := v.(map[string]interface{})
+		subdivisions[i] = restapi.GeoSubdivision{
+			Name: subdivision[WebsiteGeoLocationConfigFieldGeoMappingRuleSubdivisionName].(string),
+			Code: GetPointerFromMap[string](subdivision, WebsiteGeoLocationConfigFieldGeoMappingRuleSubdivisionCode),
+		}
+	}
+
+	var accuracyRadius *int64
+	if value := GetPointerFromMap[int](rule, WebsiteGeoLocationConfigFieldGeoMappingRuleAccuracyRadius); value != nil {
+		v := int64(*value)
+		accuracyRadius = &v
+	}
+
+	return restapi.GeoMappingRule{
+		CIDR:           rule[WebsiteGeoLocationConfigFieldGeoMappingRuleCIDR].(string),
+		AccuracyRadius: accuracyRadius,
+		Latitude:       GetPointerFromMap[float64](rule, WebsiteGeoLocationConfigFieldGeoMappingRuleLatitude),
+		Longitude:      GetPointerFromMap[float64](rule, WebsiteGeoLocationConfigFieldGeoMappingRuleLongitude),
+		City:           GetPointerFromMap[string](rule, WebsiteGeoLocationConfigFieldGeoMappingRuleCity),
+		Country:        GetPointerFromMap[string](rule, WebsiteGeoLocationConfigFieldGeoMappingRuleCountry),
+		CountryCode:    GetPointerFromMap[string](rule, WebsiteGeoLocationConfigFieldGeoMappingRuleCountryCode),
+		Continent:      GetPointerFromMap[string](rule, WebsiteGeoLocationConfigFieldGeoMappingRuleContinent),
+		ContinentCode:  GetPointerFromMap[string](rule, WebsiteGeoLocationConfigFieldGeoMappingRuleContinentCode),
+		Subdivisions:   subdivisions,
+	}
+}
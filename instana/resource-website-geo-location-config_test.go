@@ -0,0 +1,197 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+const websiteGeoLocationConfigTerraformTemplate = `
+resource "instana_website_geo_location_config" "example" {
+	website_id         = "website-id"
+	geo_detail_removal = "REMOVE_CITY"
+
+	geo_mapping_rule {
+		cidr         = "10.0.%d.0/24"
+		city         = "Berlin"
+		country_code = "DE"
+		latitude     = 52.52
+		longitude    = 13.405
+
+		subdivision {
+			name = "Berlin"
+			code = "BE"
+		}
+	}
+}
+`
+
+const websiteGeoLocationConfigDefinition = "instana_website_geo_location_config.example"
+
+func TestCRUDOfWebsiteGeoLocationConfig(t *testing.T) {
+	serverState := []byte(`{"geoDetailRemoval":"NO_REMOVAL","geoMappingRules":[]}`)
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodPut, restapi.WebsiteMonitoringConfigResourcePath+"/{websiteId}/geo-location", func(w http.ResponseWriter, r *http.Request) {
+		config := &restapi.WebsiteGeoLocationConfig{}
+		err := json.NewDecoder(r.Body).Decode(config)
+		if err != nil {
+			httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		serverState, _ = json.Marshal(config)
+		httpServer.WriteJSONResponse(w, serverState)
+	})
+	httpServer.AddRoute(http.MethodGet, restapi.WebsiteMonitoringConfigResourcePath+"/{websiteId}/geo-location", func(w http.ResponseWriter, r *http.Request) {
+		httpServer.WriteJSONResponse(w, serverState)
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			createWebsiteGeoLocationConfigTestStep(httpServer.GetPort(), 0),
+			testStepImportWithCustomID(websiteGeoLocationConfigDefinition, "website-id"),
+			createWebsiteGeoLocationConfigTestStep(httpServer.GetPort(), 1),
+			testStepImportWithCustomID(websiteGeoLocationConfigDefinition, "website-id"),
+		},
+	})
+}
+
+func createWebsiteGeoLocationConfigTestStep(httpPort int, iteration int) resource.TestStep {
+	ruleField := fmt.Sprintf("%s.0.", WebsiteGeoLocationConfigFieldGeoMappingRule)
+	subdivisionField := fmt.Sprintf("%s%s.0.", ruleField, WebsiteGeoLocationConfigFieldGeoMappingRuleSubdivision)
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(websiteGeoLocationConfigTerraformTemplate, iteration), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(websiteGeoLocationConfigDefinition, "id", "website-id"),
+			resource.TestCheckResourceAttr(websiteGeoLocationConfigDefinition, WebsiteGeoLocationConfigFieldWebsiteID, "website-id"),
+			resource.TestCheckResourceAttr(websiteGeoLocationConfigDefinition, WebsiteGeoLocationConfigFieldGeoDetailRemoval, "REMOVE_CITY"),
+			resource.TestCheckResourceAttr(websiteGeoLocationConfigDefinition, ruleField+WebsiteGeoLocationConfigFieldGeoMappingRuleCIDR, fmt.Sprintf("10.0.%d.0/24", iteration)),
+			resource.TestCheckResourceAttr(websiteGeoLocationConfigDefinition, ruleField+WebsiteGeoLocationConfigFieldGeoMappingRuleCity, "Berlin"),
+			resource.TestCheckResourceAttr(websiteGeoLocationConfigDefinition, ruleField+WebsiteGeoLocationConfigFieldGeoMappingRuleCountryCode, "DE"),
+			resource.TestCheckResourceAttr(websiteGeoLocationConfigDefinition, ruleField+WebsiteGeoLocationConfigFieldGeoMappingRuleLatitude, "52.52"),
+			resource.TestCheckResourceAttr(websiteGeoLocationConfigDefinition, ruleField+WebsiteGeoLocationConfigFieldGeoMappingRuleLongitude, "13.405"),
+			resource.TestCheckResourceAttr(websiteGeoLocationConfigDefinition, subdivisionField+WebsiteGeoLocationConfigFieldGeoMappingRuleSubdivisionName, "Berlin"),
+			resource.TestCheckResourceAttr(websiteGeoLocationConfigDefinition, subdivisionField+WebsiteGeoLocationConfigFieldGeoMappingRuleSubdivisionCode, "BE"),
+		),
+	}
+}
+
+func TestResourceWebsiteGeoLocationConfigDefinition(t *testing.T) {
+	schemaMap := NewWebsiteGeoLocationConfigResourceHandle().MetaData().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(WebsiteGeoLocationConfigFieldWebsiteID)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(WebsiteGeoLocationConfigFieldGeoDetailRemoval)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(WebsiteGeoLocationConfigFieldGeoMappingRule)
+
+	ruleSchemaAssert := testutils.NewTerraformSchemaAssert(schemaMap[WebsiteGeoLocationConfigFieldGeoMappingRule].Elem.(*schema.Resource).Schema, t)
+	ruleSchemaAssert.AssertSchemaIsRequiredAndOfTypeString(WebsiteGeoLocationConfigFieldGeoMappingRuleCIDR)
+	ruleSchemaAssert.AssertSchemaIsOptionalAndOfTypeInt(WebsiteGeoLocationConfigFieldGeoMappingRuleAccuracyRadius)
+	ruleSchemaAssert.AssertSchemaIsOptionalAndOfTypeFloat(WebsiteGeoLocationConfigFieldGeoMappingRuleLatitude)
+	ruleSchemaAssert.AssertSchemaIsOptionalAndOfTypeFloat(WebsiteGeoLocationConfigFieldGeoMappingRuleLongitude)
+	ruleSchemaAssert.AssertSchemaIsOptionalAndOfTypeString(WebsiteGeoLocationConfigFieldGeoMappingRuleCity)
+	ruleSchemaAssert.AssertSchemaIsOptionalAndOfTypeString(WebsiteGeoLocationConfigFieldGeoMappingRuleCountry)
+	ruleSchemaAssert.AssertSchemaIsOptionalAndOfTypeString(WebsiteGeoLocationConfigFieldGeoMappingRuleCountryCode)
+	ruleSchemaAssert.AssertSchemaIsOptionalAndOfTypeString(WebsiteGeoLocationConfigFieldGeoMappingRuleContinent)
+	ruleSchemaAssert.AssertSchemaIsOptionalAndOfTypeString(WebsiteGeoLocationConfigFieldGeoMappingRuleContinentCode)
+	ruleSchemaAssert.AssertSchemaIsOptionalAndOfTypeListOfResource(WebsiteGeoLocationConfigFieldGeoMappingRuleSubdivision)
+
+	subdivisionSchemaAssert := testutils.NewTerraformSchemaAssert(schemaMap[WebsiteGeoLocationConfigFieldGeoMappingRule].Elem.(*schema.Resource).Schema[WebsiteGeoLocationConfigFieldGeoMappingRuleSubdivision].Elem.(*schema.Resource).Schema, t)
+	subdivisionSchemaAssert.AssertSchemaIsRequiredAndOfTypeString(WebsiteGeoLocationConfigFieldGeoMappingRuleSubdivisionName)
+	subdivisionSchemaAssert.AssertSchemaIsOptionalAndOfTypeString(WebsiteGeoLocationConfigFieldGeoMappingRuleSubdivisionCode)
+}
+
+func TestShouldUpdateResourceStateForWebsiteGeoLocationConfig(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.WebsiteGeoLocationConfig](t)
+	resourceHandle := NewWebsiteGeoLocationConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	accuracyRadius := int64(50)
+	latitude := 52.52
+	city := "Berlin"
+	subdivisionCode := "BE"
+	data := restapi.WebsiteGeoLocationConfig{
+		WebsiteID:        "website-id",
+		GeoDetailRemoval: restapi.GeoDetailRemovalTypeRemoveCoordinates,
+		GeoMappingRules: []restapi.GeoMappingRule{
+			{
+				CIDR:           "10.0.0.0/24",
+				AccuracyRadius: &accuracyRadius,
+				Latitude:       &latitude,
+				City:           &city,
+				Subdivisions:   []restapi.GeoSubdivision{{Name: "Berlin", Code: &subdivisionCode}},
+			},
+		},
+	}
+
+	err := resourceHandle.UpdateState(resourceData, &data)
+
+	require.NoError(t, err)
+	require.Equal(t, "website-id", resourceData.Id())
+	require.Equal(t, "website-id", resourceData.Get(WebsiteGeoLocationConfigFieldWebsiteID))
+	require.Equal(t, "REMOVE_COORDINATES", resourceData.Get(WebsiteGeoLocationConfigFieldGeoDetailRemoval))
+	rules := resourceData.Get(WebsiteGeoLocationConfigFieldGeoMappingRule).([]interface{})
+	require.Len(t, rules, 1)
+	rule := rules[0].(map[string]interface{})
+	require.Equal(t, "10.0.0.0/24", rule[WebsiteGeoLocationConfigFieldGeoMappingRuleCIDR])
+	require.Equal(t, 50, rule[WebsiteGeoLocationConfigFieldGeoMappingRuleAccuracyRadius])
+	require.Equal(t, latitude, rule[WebsiteGeoLocationConfigFieldGeoMappingRuleLatitude])
+	require.Equal(t, 0.0, rule[WebsiteGeoLocationConfigFieldGeoMappingRuleLongitude])
+	require.Equal(t, city, rule[WebsiteGeoLocationConfigFieldGeoMappingRuleCity])
+	require.Equal(t, "", rule[WebsiteGeoLocationConfigFieldGeoMappingRuleCountry])
+	require.Equal(t, []interface{}{map[string]interface{}{
+		WebsiteGeoLocationConfigFieldGeoMappingRuleSubdivisionName: "Berlin",
+		WebsiteGeoLocationConfigFieldGeoMappingRuleSubdivisionCode: "BE",
+	}}, rule[WebsiteGeoLocationConfigFieldGeoMappingRuleSubdivision])
+}
+
+func TestShouldConvertStateOfWebsiteGeoLocationConfigToDataModel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.WebsiteGeoLocationConfig](t)
+	resourceHandle := NewWebsiteGeoLocationConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId("website-id")
+	setValueOnResourceData(t, resourceData, WebsiteGeoLocationConfigFieldWebsiteID, "website-id")
+	setValueOnResourceData(t, resourceData, WebsiteGeoLocationConfigFieldGeoDetailRemoval, "REMOVE_ALL")
+	setValueOnResourceData(t, resourceData, WebsiteGeoLocationConfigFieldGeoMappingRule, []interface{}{
+		map[string]interface{}{
+			WebsiteGeoLocationConfigFieldGeoMappingRuleCIDR:           "10.0.0.0/24",
+			WebsiteGeoLocationConfigFieldGeoMappingRuleAccuracyRadius: 50,
+			WebsiteGeoLocationConfigFieldGeoMappingRuleLongitude:      13.405,
+			WebsiteGeoLocationConfigFieldGeoMappingRuleCountryCode:    "DE",
+			WebsiteGeoLocationConfigFieldGeoMappingRuleSubdivision: []interface{}{
+				map[string]interface{}{WebsiteGeoLocationConfigFieldGeoMappingRuleSubdivisionName: "Berlin"},
+			},
+		},
+	})
+
+	model, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.Equal(t, "website-id", model.GetIDForResourcePath())
+	require.Equal(t, restapi.GeoDetailRemovalTypeRemoveAll, model.GeoDetailRemoval)
+	require.Len(t, model.GeoMappingRules, 1)
+	rule := model.GeoMappingRules[0]
+	require.Equal(t, "10.0.0.0/24", rule.CIDR)
+	require.Equal(t, int64(50), *rule.AccuracyRadius)
+	require.Nil(t, rule.Latitude)
+	require.Equal(t, 13.405, *rule.Longitude)
+	require.Nil(t, rule.City)
+	require.Equal(t, "DE", *rule.CountryCode)
+	require.Equal(t, []restapi.GeoSubdivision{{Name: "Berlin"}}, rule.Subdivisions)
+}
+
+func TestShouldReturnCorrectResourceNameForWebsiteGeoLocationConfig(t *testing.T) {
+	name := NewWebsiteGeoLocationConfigResourceHandle().MetaData().ResourceName
+
+	require.Equal(t, "instana_website_geo_location_config", name)
+}
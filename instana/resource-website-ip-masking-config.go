@@ -0,0 +1,79 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/tfutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceInstanaWebsiteIPMaskingConfig the name of the terraform-provider-instana resource to manage the IP masking configuration of websites
+const ResourceInstanaWebsiteIPMaskingConfig = "instana_website_ip_masking_config"
+
+const (
+	//WebsiteIPMaskingConfigFieldWebsiteID constant value for the schema field website_id
+	WebsiteIPMaskingConfigFieldWebsiteID = "website_id"
+	//WebsiteIPMaskingConfigFieldIPMasking constant value for the schema field ip_masking
+	WebsiteIPMaskingConfigFieldIPMasking = "ip_masking"
+)
+
+// NewWebsiteIPMaskingConfigResourceHandle creates the resource handle for the IP masking configuration of websites
+func NewWebsiteIPMaskingConfigResourceHandle() ResourceHandle[*restapi.WebsiteIPMaskingConfig] {
+	return &websiteIPMaskingConfigResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaWebsiteIPMaskingConfig,
+			Schema: map[string]*schema.Schema{
+				WebsiteIPMaskingConfigFieldWebsiteID: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The ID of the website",
+				},
+				WebsiteIPMaskingConfigFieldIPMasking: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(restapi.SupportedIPMaskingTypes.ToStringSlice(), false),
+					Description:  "The IP masking applied to the beacons of the website (DEFAULT, STRICT, REMOVE_ALL_DETAILS)",
+				},
+			},
+			SchemaVersion:    0,
+			SkipIDGeneration: true,
+		},
+	}
+}
+
+type websiteIPMaskingConfigResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *websiteIPMaskingConfigResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *websiteIPMaskingConfigResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *websiteIPMaskingConfigResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource[*restapi.WebsiteIPMaskingConfig] {
+	return api.WebsiteIPMaskingConfigs()
+}
+
+func (r *websiteIPMaskingConfigResource) SetComputedFields(_ *schema.ResourceData) error {
+	return nil
+}
+
+func (r *websiteIPMaskingConfigResource) UpdateState(d *schema.ResourceData, config *restapi.WebsiteIPMaskingConfig) error {
+	d.SetId(config.WebsiteID)
+	return tfutils.UpdateState(d, map[string]interface{}{
+		WebsiteIPMaskingConfigFieldWebsiteID: config.WebsiteID,
+		WebsiteIPMaskingConfigFieldIPMasking: string(config.IPMasking),
+	})
+}
+
+func (r *websiteIPMaskingConfigResource) MapStateToDataObject(d *schema.ResourceData) (*restapi.WebsiteIPMaskingConfig, error) {
+	return &restapi.WebsiteIPMaskingConfig{
+		WebsiteID: d.Get(WebsiteIPMaskingConfigFieldWebsiteID).(string),
+		IPMasking: restapi.IPMaskingType(d.Get(WebsiteIPMaskingConfigFieldIPMasking).(string)),
+	}, nil
+}
@@ -0,0 +1,111 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+const websiteIPMaskingConfigTerraformTemplate = `
+resource "instana_website_ip_masking_config" "example" {
+	website_id = "website-id"
+	ip_masking = "%s"
+}
+`
+
+const websiteIPMaskingConfigDefinition = "instana_website_ip_masking_config.example"
+
+func TestCRUDOfWebsiteIPMaskingConfig(t *testing.T) {
+	serverState := []byte(`{"ipMasking":"DEFAULT"}`)
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodPut, restapi.WebsiteMonitoringConfigResourcePath+"/{websiteId}/ip-masking", func(w http.ResponseWriter, r *http.Request) {
+		config := &restapi.WebsiteIPMaskingConfig{}
+		err := json.NewDecoder(r.Body).Decode(config)
+		if err != nil {
+			httpServer.WriteInternalServerError(w, err)
+			return
+		}
+		serverState, _ = json.Marshal(config)
+		httpServer.WriteJSONResponse(w, serverState)
+	})
+	httpServer.AddRoute(http.MethodGet, restapi.WebsiteMonitoringConfigResourcePath+"/{websiteId}/ip-masking", func(w http.ResponseWriter, r *http.Request) {
+		httpServer.WriteJSONResponse(w, serverState)
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			createWebsiteIPMaskingConfigTestStep(httpServer.GetPort(), "STRICT"),
+			testStepImportWithCustomID(websiteIPMaskingConfigDefinition, "website-id"),
+			createWebsiteIPMaskingConfigTestStep(httpServer.GetPort(), "REMOVE_ALL_DETAILS"),
+			testStepImportWithCustomID(websiteIPMaskingConfigDefinition, "website-id"),
+		},
+	})
+}
+
+func createWebsiteIPMaskingConfigTestStep(httpPort int, ipMasking string) resource.TestStep {
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(websiteIPMaskingConfigTerraformTemplate, ipMasking), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(websiteIPMaskingConfigDefinition, "id", "website-id"),
+			resource.TestCheckResourceAttr(websiteIPMaskingConfigDefinition, WebsiteIPMaskingConfigFieldWebsiteID, "website-id"),
+			resource.TestCheckResourceAttr(websiteIPMaskingConfigDefinition, WebsiteIPMaskingConfigFieldIPMasking, ipMasking),
+		),
+	}
+}
+
+func TestResourceWebsiteIPMaskingConfigDefinition(t *testing.T) {
+	schemaMap := NewWebsiteIPMaskingConfigResourceHandle().MetaData().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(WebsiteIPMaskingConfigFieldWebsiteID)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(WebsiteIPMaskingConfigFieldIPMasking)
+}
+
+func TestShouldUpdateResourceStateForWebsiteIPMaskingConfig(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.WebsiteIPMaskingConfig](t)
+	resourceHandle := NewWebsiteIPMaskingConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	data := restapi.WebsiteIPMaskingConfig{
+		WebsiteID: "website-id",
+		IPMasking: restapi.IPMaskingTypeStrict,
+	}
+
+	err := resourceHandle.UpdateState(resourceData, &data)
+
+	require.NoError(t, err)
+	require.Equal(t, "website-id", resourceData.Id())
+	require.Equal(t, "website-id", resourceData.Get(WebsiteIPMaskingConfigFieldWebsiteID))
+	require.Equal(t, "STRICT", resourceData.Get(WebsiteIPMaskingConfigFieldIPMasking))
+}
+
+func TestShouldConvertStateOfWebsiteIPMaskingConfigToDataModel(t *testing.T) {
+	testHelper := NewTestHelper[*restapi.WebsiteIPMaskingConfig](t)
+	resourceHandle := NewWebsiteIPMaskingConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId("website-id")
+	setValueOnResourceData(t, resourceData, WebsiteIPMaskingConfigFieldWebsiteID, "website-id")
+	setValueOnResourceData(t, resourceData, WebsiteIPMaskingConfigFieldIPMasking, "REMOVE_ALL_DETAILS")
+
+	model, err := resourceHandle.MapStateToDataObject(resourceData)
+
+	require.NoError(t, err)
+	require.Equal(t, "website-id", model.GetIDForResourcePath())
+	require.Equal(t, restapi.IPMaskingTypeRemoveAllDetails, model.IPMasking)
+}
+
+func TestShouldReturnCorrectResourceNameForWebsiteIPMaskingConfig(t *testing.T) {
+	name := NewWebsiteIPMaskingConfigResourceHandle().MetaData().ResourceName
+
+	require.Equal(t, "instana_website_ip_masking_config", name)
+}
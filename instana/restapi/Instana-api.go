@@ -27,9 +27,12 @@ const (
 type InstanaAPI interface {
 	CustomEventSpecifications() RestResource[*CustomEventSpecification]
 	BuiltinEventSpecifications() ReadOnlyRestResource[*BuiltinEventSpecification]
+	BuiltinEventSpecificationStates() RestResource[*BuiltinEventSpecificationState]
 	APITokens() RestResource[*APIToken]
 	ApplicationConfigs() RestResource[*ApplicationConfig]
 	ApplicationAlertConfigs() RestResource[*ApplicationAlertConfig]
+	ApplicationAlertConfigVersions() AlertConfigVersionRestResource
+	ApplicationAlertConfigRestores() RestResource[*AlertConfigRestore]
 	GlobalApplicationAlertConfigs() RestResource[*ApplicationAlertConfig]
 	AlertingChannels() RestResource[*AlertingChannel]
 	AlertingConfigurations() RestResource[*AlertingConfiguration]
@@ -40,6 +43,23 @@ type InstanaAPI interface {
 	CustomDashboards() RestResource[*CustomDashboard]
 	SyntheticTest() RestResource[*SyntheticTest]
 	SyntheticLocation() ReadOnlyRestResource[*SyntheticLocation]
+	ServiceConfigs() RestResource[*ServiceConfig]
+	ServiceConfigOrder() RestResource[*ServiceConfigOrder]
+	ManualServiceConfigs() RestResource[*ManualServiceConfig]
+	HttpEndpointConfigs() RestResource[*HttpEndpointConfig]
+	ApdexConfigs() RestResource[*ApdexConfig]
+	ApdexReports() ApdexReportRestResource
+	GroupMappings() RestResource[*GroupMapping]
+	Users() ReadOnlyRestResource[*User]
+	UserInvitations() RestResource[*UserInvitation]
+	GroupMemberships() RestResource[*GroupMembership]
+	GroupPermissions() RestResource[*GroupPermissions]
+	HostAgentConfigurations() RestResource[*HostAgentConfiguration]
+	WebsiteIPMaskingConfigs() RestResource[*WebsiteIPMaskingConfig]
+	WebsiteGeoLocationConfigs() RestResource[*WebsiteGeoLocationConfig]
+	Releases() RestResource[*Release]
+	ApplicationTagCatalog() ReadOnlyRestResource[*Tag]
+	WebsiteTagCatalog() ReadOnlyRestResource[*Tag]
 }
 
 // NewInstanaAPI creates a new instance of the instana API
@@ -62,6 +82,11 @@ func (api *baseInstanaAPI) BuiltinEventSpecifications() ReadOnlyRestResource[*Bu
 	return NewReadOnlyRestResource(BuiltinEventSpecificationResourcePath, NewDefaultJSONUnmarshaller(&BuiltinEventSpecification{}), api.client)
 }
 
+// BuiltinEventSpecificationStates implementation of InstanaAPI interface
+func (api *baseInstanaAPI) BuiltinEventSpecificationStates() RestResource[*BuiltinEventSpecificationState] {
+	return NewBuiltinEventSpecificationStateRestResource(NewDefaultJSONUnmarshaller(&BuiltinEventSpecification{}), api.client)
+}
+
 // APITokens implementation of InstanaAPI interface
 func (api *baseInstanaAPI) APITokens() RestResource[*APIToken] {
 	return NewCreatePOSTUpdatePUTRestResource(APITokensResourcePath, NewDefaultJSONUnmarshaller(&APIToken{}), api.client)
@@ -74,17 +99,30 @@ func (api *baseInstanaAPI) ApplicationConfigs() RestResource[*ApplicationConfig]
 
 // ApplicationAlertConfigs implementation of InstanaAPI interface
 func (api *baseInstanaAPI) ApplicationAlertConfigs() RestResource[*ApplicationAlertConfig] {
-	return NewCreatePOSTUpdatePOSTRestResource(ApplicationAlertConfigsResourcePath, NewCustomPayloadFieldsUnmarshallerAdapter(NewDefaultJSONUnmarshaller(&ApplicationAlertConfig{})), api.client)
+	resource := NewCreatePOSTUpdatePOSTRestResource(ApplicationAlertConfigsResourcePath, NewCustomPayloadFieldsUnmarshallerAdapter(NewDefaultJSONUnmarshaller(&ApplicationAlertConfig{})), api.client)
+	resource = NewEnabledStateRestResourceAdapter(ApplicationAlertConfigsResourcePath, resource, api.client)
+	return NewHistoricBaselineRefreshRestResourceAdapter(ApplicationAlertConfigsResourcePath, resource, api.client)
+}
+
+// ApplicationAlertConfigVersions implementation of InstanaAPI interface
+func (api *baseInstanaAPI) ApplicationAlertConfigVersions() AlertConfigVersionRestResource {
+	return NewAlertConfigVersionRestResource(ApplicationAlertConfigsResourcePath, NewDefaultJSONUnmarshaller(&AlertConfigVersion{}), api.client)
+}
+
+// ApplicationAlertConfigRestores implementation of InstanaAPI interface
+func (api *baseInstanaAPI) ApplicationAlertConfigRestores() RestResource[*AlertConfigRestore] {
+	return NewAlertConfigRestoreRestResource(ApplicationAlertConfigsResourcePath, api.client)
 }
 
 // GlobalApplicationAlertConfigs implementation of InstanaAPI interface
 func (api *baseInstanaAPI) GlobalApplicationAlertConfigs() RestResource[*ApplicationAlertConfig] {
-	return NewCreatePOSTUpdatePOSTRestResource(GlobalApplicationAlertConfigsResourcePath, NewCustomPayloadFieldsUnmarshallerAdapter(NewDefaultJSONUnmarshaller(&ApplicationAlertConfig{})), api.client)
+	return NewEnabledStateRestResourceAdapter(GlobalApplicationAlertConfigsResourcePath, NewCreatePOSTUpdatePOSTRestResource(GlobalApplicationAlertConfigsResourcePath, NewCustomPayloadFieldsUnmarshallerAdapter(NewDefaultJSONUnmarshaller(&ApplicationAlertConfig{})), api.client), api.client)
 }
 
 // AlertingChannels implementation of InstanaAPI interface
 func (api *baseInstanaAPI) AlertingChannels() RestResource[*AlertingChannel] {
-	return NewCreatePUTUpdatePUTRestResource(AlertingChannelsResourcePath, NewDefaultJSONUnmarshaller(&AlertingChannel{}), api.client)
+	resource := NewCreatePUTUpdatePUTRestResource(AlertingChannelsResourcePath, NewDefaultJSONUnmarshaller(&AlertingChannel{}), api.client)
+	return NewAlertingChannelVerificationRestResourceAdapter(resource, api.client)
 }
 
 // AlertingConfigurations implementation of InstanaAPI interface
@@ -101,7 +139,9 @@ func (api *baseInstanaAPI) WebsiteMonitoringConfig() RestResource[*WebsiteMonito
 }
 
 func (api *baseInstanaAPI) WebsiteAlertConfig() RestResource[*WebsiteAlertConfig] {
-	return NewCreatePOSTUpdatePOSTRestResource(WebsiteAlertConfigResourcePath, NewCustomPayloadFieldsUnmarshallerAdapter(NewDefaultJSONUnmarshaller(&WebsiteAlertConfig{})), api.client)
+	resource := NewCreatePOSTUpdatePOSTRestResource(WebsiteAlertConfigResourcePath, NewCustomPayloadFieldsUnmarshallerAdapter(NewDefaultJSONUnmarshaller(&WebsiteAlertConfig{})), api.client)
+	resource = NewEnabledStateRestResourceAdapter(WebsiteAlertConfigResourcePath, resource, api.client)
+	return NewHistoricBaselineRefreshRestResourceAdapter(WebsiteAlertConfigResourcePath, resource, api.client)
 }
 
 func (api *baseInstanaAPI) Groups() RestResource[*Group] {
@@ -120,3 +160,88 @@ func (api *baseInstanaAPI) SyntheticTest() RestResource[*SyntheticTest] {
 func (api *baseInstanaAPI) SyntheticLocation() ReadOnlyRestResource[*SyntheticLocation] {
 	return NewReadOnlyRestResource(SyntheticLocationResourcePath, NewDefaultJSONUnmarshaller(&SyntheticLocation{}), api.client)
 }
+
+// ServiceConfigs implementation of InstanaAPI interface
+func (api *baseInstanaAPI) ServiceConfigs() RestResource[*ServiceConfig] {
+	return NewCreatePOSTUpdatePUTRestResource(ServiceConfigsResourcePath, NewDefaultJSONUnmarshaller(&ServiceConfig{}), api.client)
+}
+
+// ServiceConfigOrder implementation of InstanaAPI interface
+func (api *baseInstanaAPI) ServiceConfigOrder() RestResource[*ServiceConfigOrder] {
+	return NewServiceConfigOrderRestResource(NewDefaultJSONUnmarshaller(&ServiceConfig{}), api.client)
+}
+
+// ManualServiceConfigs implementation of InstanaAPI interface
+func (api *baseInstanaAPI) ManualServiceConfigs() RestResource[*ManualServiceConfig] {
+	return NewGetOneFromListRestResource(NewCreatePOSTUpdatePUTRestResource(ManualServiceConfigsResourcePath, NewDefaultJSONUnmarshaller(&ManualServiceConfig{}), api.client))
+}
+
+// HttpEndpointConfigs implementation of InstanaAPI interface
+func (api *baseInstanaAPI) HttpEndpointConfigs() RestResource[*HttpEndpointConfig] {
+	return NewCreatePOSTUpdatePUTRestResource(HttpEndpointConfigsResourcePath, NewDefaultJSONUnmarshaller(&HttpEndpointConfig{}), api.client)
+}
+
+// ApdexConfigs implementation of InstanaAPI interface
+func (api *baseInstanaAPI) ApdexConfigs() RestResource[*ApdexConfig] {
+	return NewCreatePOSTUpdateNotSupportedRestResource(ApdexConfigResourcePath, NewDefaultJSONUnmarshaller(&ApdexConfig{}), api.client)
+}
+
+// ApdexReports implementation of InstanaAPI interface
+func (api *baseInstanaAPI) ApdexReports() ApdexReportRestResource {
+	return NewApdexReportRestResource(NewDefaultJSONUnmarshaller(&ApdexReport{}), api.client)
+}
+
+// GroupMappings implementation of InstanaAPI interface
+func (api *baseInstanaAPI) GroupMappings() RestResource[*GroupMapping] {
+	return NewGetOneFromListRestResource(NewCreatePOSTUpdatePUTRestResource(GroupMappingsResourcePath, NewDefaultJSONUnmarshaller(&GroupMapping{}), api.client))
+}
+
+// Users implementation of InstanaAPI interface
+func (api *baseInstanaAPI) Users() ReadOnlyRestResource[*User] {
+	return NewReadOnlyRestResource(UsersResourcePath, NewDefaultJSONUnmarshaller(&User{}), api.client)
+}
+
+// UserInvitations implementation of InstanaAPI interface
+func (api *baseInstanaAPI) UserInvitations() RestResource[*UserInvitation] {
+	return NewUserInvitationRestResource(api.client)
+}
+
+// GroupMemberships implementation of InstanaAPI interface
+func (api *baseInstanaAPI) GroupMemberships() RestResource[*GroupMembership] {
+	return NewGroupMembershipRestResource(NewDefaultJSONUnmarshaller(&Group{}), api.client)
+}
+
+// GroupPermissions implementation of InstanaAPI interface
+func (api *baseInstanaAPI) GroupPermissions() RestResource[*GroupPermissions] {
+	return NewGroupPermissionsRestResource(NewDefaultJSONUnmarshaller(&Group{}), api.client)
+}
+
+// HostAgentConfigurations implementation of InstanaAPI interface
+func (api *baseInstanaAPI) HostAgentConfigurations() RestResource[*HostAgentConfiguration] {
+	return NewHostAgentConfigurationRestResource(api.client)
+}
+
+// WebsiteIPMaskingConfigs implementation of InstanaAPI interface
+func (api *baseInstanaAPI) WebsiteIPMaskingConfigs() RestResource[*WebsiteIPMaskingConfig] {
+	return NewWebsiteIPMaskingConfigRestResource(NewDefaultJSONUnmarshaller(&WebsiteIPMaskingConfig{}), api.client)
+}
+
+// WebsiteGeoLocationConfigs implementation of InstanaAPI interface
+func (api *baseInstanaAPI) WebsiteGeoLocationConfigs() RestResource[*WebsiteGeoLocationConfig] {
+	return NewWebsiteGeoLocationConfigRestResource(NewDefaultJSONUnmarshaller(&WebsiteGeoLocationConfig{}), api.client)
+}
+
+// Releases implementation of InstanaAPI interface
+func (api *baseInstanaAPI) Releases() RestResource[*Release] {
+	return NewCreatePOSTUpdatePUTRestResource(ReleasesResourcePath, NewDefaultJSONUnmarshaller(&Release{}), api.client)
+}
+
+// ApplicationTagCatalog implementation of InstanaAPI interface
+func (api *baseInstanaAPI) ApplicationTagCatalog() ReadOnlyRestResource[*Tag] {
+	return NewReadOnlyRestResource(ApplicationTagCatalogResourcePath, NewDefaultJSONUnmarshaller(&Tag{}), api.client)
+}
+
+// WebsiteTagCatalog implementation of InstanaAPI interface
+func (api *baseInstanaAPI) WebsiteTagCatalog() ReadOnlyRestResource[*Tag] {
+	return NewReadOnlyRestResource(WebsiteTagCatalogResourcePath, NewDefaultJSONUnmarshaller(&Tag{}), api.client)
+}
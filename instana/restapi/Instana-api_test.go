@@ -21,6 +21,12 @@ func TestShouldReturnResourcesFromInstanaAPI(t *testing.T) {
 
 		require.NotNil(t, resource)
 	})
+
+	t.Run("Should return BuiltinEventSpecificationStates instance", func(t *testing.T) {
+		resource := api.BuiltinEventSpecificationStates()
+
+		require.NotNil(t, resource)
+	})
 	t.Run("Should return APITokens instance", func(t *testing.T) {
 		resource := api.APITokens()
 
@@ -36,6 +42,16 @@ func TestShouldReturnResourcesFromInstanaAPI(t *testing.T) {
 
 		require.NotNil(t, resource)
 	})
+	t.Run("Should return ApplicationAlertConfigVersions instance", func(t *testing.T) {
+		resource := api.ApplicationAlertConfigVersions()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return ApplicationAlertConfigRestores instance", func(t *testing.T) {
+		resource := api.ApplicationAlertConfigRestores()
+
+		require.NotNil(t, resource)
+	})
 	t.Run("Should return GlobalApplicationAlertConfig instance", func(t *testing.T) {
 		resource := api.GlobalApplicationAlertConfigs()
 
@@ -86,5 +102,90 @@ func TestShouldReturnResourcesFromInstanaAPI(t *testing.T) {
 
 		require.NotNil(t, resource)
 	})
+	t.Run("Should return ServiceConfigs instance", func(t *testing.T) {
+		resource := api.ServiceConfigs()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return ServiceConfigOrder instance", func(t *testing.T) {
+		resource := api.ServiceConfigOrder()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return ManualServiceConfigs instance", func(t *testing.T) {
+		resource := api.ManualServiceConfigs()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return HttpEndpointConfigs instance", func(t *testing.T) {
+		resource := api.HttpEndpointConfigs()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return ApdexConfigs instance", func(t *testing.T) {
+		resource := api.ApdexConfigs()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return ApdexReports instance", func(t *testing.T) {
+		resource := api.ApdexReports()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return GroupMappings instance", func(t *testing.T) {
+		resource := api.GroupMappings()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return Users instance", func(t *testing.T) {
+		resource := api.Users()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return UserInvitations instance", func(t *testing.T) {
+		resource := api.UserInvitations()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return GroupMemberships instance", func(t *testing.T) {
+		resource := api.GroupMemberships()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return GroupPermissions instance", func(t *testing.T) {
+		resource := api.GroupPermissions()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return HostAgentConfigurations instance", func(t *testing.T) {
+		resource := api.HostAgentConfigurations()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return WebsiteIPMaskingConfigs instance", func(t *testing.T) {
+		resource := api.WebsiteIPMaskingConfigs()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return WebsiteGeoLocationConfigs instance", func(t *testing.T) {
+		resource := api.WebsiteGeoLocationConfigs()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return Releases instance", func(t *testing.T) {
+		resource := api.Releases()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return ApplicationTagCatalog instance", func(t *testing.T) {
+		resource := api.ApplicationTagCatalog()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return WebsiteTagCatalog instance", func(t *testing.T) {
+		resource := api.WebsiteTagCatalog()
+
+		require.NotNil(t, resource)
+	})
 
 }
@@ -0,0 +1,49 @@
+package restapi
+
+import (
+	"errors"
+	"strconv"
+)
+
+// NewAlertConfigRestoreRestResource creates a new REST resource to restore alert configurations of the given resource
+// path to a prior version. A restore is a one time operation which cannot be read back from the Instana API.
+// Therefore, read operations are not supported and deleting a restore only stops managing it; the alert configuration
+// is not changed.
+func NewAlertConfigRestoreRestResource(resourcePath string, client RestClient) RestResource[*AlertConfigRestore] {
+	return &alertConfigRestoreRestResource{
+		resourcePath: resourcePath,
+		client:       client,
+	}
+}
+
+var errAlertConfigRestoreReadNotSupported = errors.New("reading alert configuration restores is not supported by the Instana API")
+
+type alertConfigRestoreRestResource struct {
+	resourcePath string
+	client       RestClient
+}
+
+func (r *alertConfigRestoreRestResource) GetAll() (*[]*AlertConfigRestore, error) {
+	return nil, errAlertConfigRestoreReadNotSupported
+}
+
+func (r *alertConfigRestoreRestResource) GetOne(_ string) (*AlertConfigRestore, error) {
+	return nil, errAlertConfigRestoreReadNotSupported
+}
+
+func (r *alertConfigRestoreRestResource) Create(data *AlertConfigRestore) (*AlertConfigRestore, error) {
+	return r.Update(data)
+}
+
+func (r *alertConfigRestoreRestResource) Update(data *AlertConfigRestore) (*AlertConfigRestore, error) {
+	_, err := r.client.PutByQuery(r.resourcePath+"/"+data.AlertConfigID+"/restore", strconv.FormatInt(data.Created, 10), map[string]string{})
+	return data, err
+}
+
+func (r *alertConfigRestoreRestResource) Delete(data *AlertConfigRestore) error {
+	return r.DeleteByID(data.GetIDForResourcePath())
+}
+
+func (r *alertConfigRestoreRestResource) DeleteByID(_ string) error {
+	return nil
+}
@@ -0,0 +1,65 @@
+package restapi_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestShouldRestoreAlertConfigToVersionOnCreate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restore := &AlertConfigRestore{ID: "restore-id", AlertConfigID: "alert-config-id", Created: 1234}
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().PutByQuery(ApplicationAlertConfigsResourcePath+"/alert-config-id/restore", "1234", map[string]string{}).Times(1).Return([]byte("response"), nil)
+
+	sut := NewAlertConfigRestoreRestResource(ApplicationAlertConfigsResourcePath, restClient)
+
+	result, err := sut.Create(restore)
+
+	require.NoError(t, err)
+	require.Equal(t, restore, result)
+}
+
+func TestShouldFailToRestoreAlertConfigWhenClientReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedError := errors.New("test")
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().PutByQuery(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, expectedError)
+
+	sut := NewAlertConfigRestoreRestResource(ApplicationAlertConfigsResourcePath, restClient)
+
+	_, err := sut.Update(&AlertConfigRestore{AlertConfigID: "alert-config-id", Created: 1234})
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldNotSupportReadingAlertConfigRestores(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sut := NewAlertConfigRestoreRestResource(ApplicationAlertConfigsResourcePath, mocks.NewMockRestClient(ctrl))
+
+	_, err := sut.GetOne("restore-id")
+	require.ErrorContains(t, err, "not supported")
+
+	_, err = sut.GetAll()
+	require.ErrorContains(t, err, "not supported")
+}
+
+func TestShouldNotCallInstanaAPIWhenDeletingAlertConfigRestore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sut := NewAlertConfigRestoreRestResource(ApplicationAlertConfigsResourcePath, mocks.NewMockRestClient(ctrl))
+
+	require.NoError(t, sut.Delete(&AlertConfigRestore{ID: "restore-id"}))
+	require.NoError(t, sut.DeleteByID("restore-id"))
+}
@@ -0,0 +1,40 @@
+package restapi
+
+// AlertConfigVersion is the representation of a version of an alert configuration in Instana
+type AlertConfigVersion struct {
+	ID            string                           `json:"id"`
+	Created       int64                            `json:"created"`
+	Deleted       bool                             `json:"deleted"`
+	Enabled       bool                             `json:"enabled"`
+	ChangeSummary *AlertConfigVersionChangeSummary `json:"changeSummary"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (v *AlertConfigVersion) GetIDForResourcePath() string {
+	return v.ID
+}
+
+// AlertConfigVersionChangeSummary is the representation of the change summary of a version of an alert configuration in Instana
+type AlertConfigVersionChangeSummary struct {
+	Author     AlertConfigVersionAuthor `json:"author"`
+	ChangeType string                   `json:"changeType"`
+}
+
+// AlertConfigVersionAuthor is the representation of the author of a version of an alert configuration in Instana
+type AlertConfigVersionAuthor struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+// AlertConfigRestore is the representation of the restore of an alert configuration to a prior version in Instana. The
+// version is identified by the created timestamp of the version.
+type AlertConfigRestore struct {
+	ID            string
+	AlertConfigID string
+	Created       int64
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (r *AlertConfigRestore) GetIDForResourcePath() string {
+	return r.ID
+}
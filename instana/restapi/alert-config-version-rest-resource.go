@@ -0,0 +1,25 @@
+package restapi
+
+// NewAlertConfigVersionRestResource creates a new instance of the AlertConfigVersionRestResource for the alert
+// configurations of the given resource path
+func NewAlertConfigVersionRestResource(resourcePath string, unmarshaller JSONUnmarshaller[*AlertConfigVersion], client RestClient) AlertConfigVersionRestResource {
+	return &alertConfigVersionRestResource{
+		resourcePath: resourcePath,
+		unmarshaller: unmarshaller,
+		client:       client,
+	}
+}
+
+type alertConfigVersionRestResource struct {
+	resourcePath string
+	unmarshaller JSONUnmarshaller[*AlertConfigVersion]
+	client       RestClient
+}
+
+func (r *alertConfigVersionRestResource) GetVersions(alertConfigID string) (*[]*AlertConfigVersion, error) {
+	data, err := r.client.Get(r.resourcePath + "/" + alertConfigID + "/versions")
+	if err != nil {
+		return nil, err
+	}
+	return r.unmarshaller.UnmarshalArray(data)
+}
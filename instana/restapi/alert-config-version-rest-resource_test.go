@@ -0,0 +1,61 @@
+package restapi_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const alertConfigVersionAlertConfigID = "alert-config-id"
+
+func TestShouldGetVersionsOfAlertConfig(t *testing.T) {
+	expectedVersions := &[]*AlertConfigVersion{
+		{
+			ID:      alertConfigVersionAlertConfigID,
+			Created: 1000,
+			Enabled: true,
+			ChangeSummary: &AlertConfigVersionChangeSummary{
+				Author:     AlertConfigVersionAuthor{ID: "user-id", Type: "USER"},
+				ChangeType: "UPDATE",
+			},
+		},
+	}
+	restResponseData := []byte("server-response")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Get(ApplicationAlertConfigsResourcePath+"/"+alertConfigVersionAlertConfigID+"/versions").Times(1).Return(restResponseData, nil)
+	jsonUnmarshaller := mocks.NewMockJSONUnmarshaller[*AlertConfigVersion](ctrl)
+	jsonUnmarshaller.EXPECT().UnmarshalArray(restResponseData).Times(1).Return(expectedVersions, nil)
+
+	sut := NewAlertConfigVersionRestResource(ApplicationAlertConfigsResourcePath, jsonUnmarshaller, restClient)
+
+	result, err := sut.GetVersions(alertConfigVersionAlertConfigID)
+
+	require.NoError(t, err)
+	require.Equal(t, expectedVersions, result)
+}
+
+func TestShouldFailToGetVersionsOfAlertConfigWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Get(gomock.Any()).Times(1).Return(nil, expectedError)
+	jsonUnmarshaller := mocks.NewMockJSONUnmarshaller[*AlertConfigVersion](ctrl)
+	jsonUnmarshaller.EXPECT().UnmarshalArray(gomock.Any()).Times(0)
+
+	sut := NewAlertConfigVersionRestResource(ApplicationAlertConfigsResourcePath, jsonUnmarshaller, restClient)
+
+	_, err := sut.GetVersions(alertConfigVersionAlertConfigID)
+
+	require.ErrorIs(t, err, expectedError)
+}
@@ -4,6 +4,10 @@ package restapi
 type AlertingChannelType string
 
 const (
+	//SlackAppChannelType constant value for alerting channel type BIDIRECTIONAL_SLACK
+	SlackAppChannelType = AlertingChannelType("BIDIRECTIONAL_SLACK")
+	//MsTeamsAppChannelType constant value for alerting channel type BIDIRECTIONAL_MS_TEAMS
+	MsTeamsAppChannelType = AlertingChannelType("BIDIRECTIONAL_MS_TEAMS")
 	//EmailChannelType constant value for alerting channel type EMAIL
 	EmailChannelType = AlertingChannelType("EMAIL")
 	//GoogleChatChannelType constant value for alerting channel type GOOGLE_CHAT
@@ -14,12 +18,20 @@ const (
 	OpsGenieChannelType = AlertingChannelType("OPS_GENIE")
 	//PagerDutyChannelType constant value for alerting channel type PAGER_DUTY
 	PagerDutyChannelType = AlertingChannelType("PAGER_DUTY")
+	//PrometheusWebhookChannelType constant value for alerting channel type PROMETHEUS_WEBHOOK
+	PrometheusWebhookChannelType = AlertingChannelType("PROMETHEUS_WEBHOOK")
+	//ServiceNowChannelType constant value for alerting channel type SERVICE_NOW_WEBHOOK
+	ServiceNowChannelType = AlertingChannelType("SERVICE_NOW_WEBHOOK")
 	//SlackChannelType constant value for alerting channel type SLACK
 	SlackChannelType = AlertingChannelType("SLACK")
 	//SplunkChannelType constant value for alerting channel type SPLUNK
 	SplunkChannelType = AlertingChannelType("SPLUNK")
 	//VictorOpsChannelType constant value for alerting channel type VICTOR_OPS
 	VictorOpsChannelType = AlertingChannelType("VICTOR_OPS")
+	//WatsonAIOpsWebhookChannelType constant value for alerting channel type WATSON_AIOPS_WEBHOOK
+	WatsonAIOpsWebhookChannelType = AlertingChannelType("WATSON_AIOPS_WEBHOOK")
+	//WebexTeamsWebhookChannelType constant value for alerting channel type WEBEX_TEAMS_WEBHOOK
+	WebexTeamsWebhookChannelType = AlertingChannelType("WEBEX_TEAMS_WEBHOOK")
 	//WebhookChannelType constant value for alerting channel type WEB_HOOK
 	WebhookChannelType = AlertingChannelType("WEB_HOOK")
 )
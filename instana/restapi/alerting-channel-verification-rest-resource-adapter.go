@@ -0,0 +1,73 @@
+package restapi
+
+import "fmt"
+
+// alertingChannelTestCallID the path segment of the test endpoint below the Alerting channels resource path
+const alertingChannelTestCallID = "test"
+
+// alertingChannelTestCall wraps an AlertingChannel so that it is sent to the test endpoint instead of the resource path
+// of the alerting channel itself
+type alertingChannelTestCall struct {
+	*AlertingChannel
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (c *alertingChannelTestCall) GetIDForResourcePath() string {
+	return alertingChannelTestCallID
+}
+
+// NewAlertingChannelVerificationRestResourceAdapter creates a new RestResource instance which can be added as an
+// adapter to the rest resource of alerting channels. When the verification is requested by the alerting channel, a
+// test notification is sent through the test endpoint of the Instana API before the channel is created or updated.
+// The create or update fails when the test notification cannot be delivered.
+func NewAlertingChannelVerificationRestResourceAdapter(resource RestResource[*AlertingChannel], client RestClient) RestResource[*AlertingChannel] {
+	return &alertingChannelVerificationRestResourceAdapter{
+		resource: resource,
+		client:   client,
+	}
+}
+
+type alertingChannelVerificationRestResourceAdapter struct {
+	resource RestResource[*AlertingChannel]
+	client   RestClient
+}
+
+func (a *alertingChannelVerificationRestResourceAdapter) GetAll() (*[]*AlertingChannel, error) {
+	return a.resource.GetAll()
+}
+
+func (a *alertingChannelVerificationRestResourceAdapter) GetOne(id string) (*AlertingChannel, error) {
+	return a.resource.GetOne(id)
+}
+
+func (a *alertingChannelVerificationRestResourceAdapter) Create(data *AlertingChannel) (*AlertingChannel, error) {
+	if err := a.verify(data); err != nil {
+		return nil, err
+	}
+	return a.resource.Create(data)
+}
+
+func (a *alertingChannelVerificationRestResourceAdapter) Update(data *AlertingChannel) (*AlertingChannel, error) {
+	if err := a.verify(data); err != nil {
+		return nil, err
+	}
+	return a.resource.Update(data)
+}
+
+func (a *alertingChannelVerificationRestResourceAdapter) verify(data *AlertingChannel) error {
+	if !data.VerifyOnApply {
+		return nil
+	}
+	if _, err := a.client.Put(&alertingChannelTestCall{AlertingChannel: data}, AlertingChannelsResourcePath); err != nil {
+		return fmt.Errorf("verification of alerting channel '%s' failed; the test notification could not be delivered: %w", data.Name, err)
+	}
+	return nil
+}
+
+func (a *alertingChannelVerificationRestResourceAdapter) Delete(data *AlertingChannel) error {
+	return a.resource.Delete(data)
+}
+
+func (a *alertingChannelVerificationRestResourceAdapter) DeleteByID(id string) error {
+	return a.resource.DeleteByID(id)
+}
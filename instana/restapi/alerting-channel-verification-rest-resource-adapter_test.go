@@ -0,0 +1,119 @@
+package restapi_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const alertingChannelVerificationID = "alerting-channel-id"
+
+func TestShouldDelegateReadAndDeleteOfAlertingChannelVerificationRestResourceAdapter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	object := &AlertingChannel{ID: alertingChannelVerificationID}
+	resource := mocks.NewMockRestResource[*AlertingChannel](ctrl)
+	resource.EXPECT().GetOne(alertingChannelVerificationID).Times(1).Return(object, nil)
+	resource.EXPECT().GetAll().Times(1).Return(&[]*AlertingChannel{object}, nil)
+	resource.EXPECT().Delete(object).Times(1).Return(nil)
+	resource.EXPECT().DeleteByID(alertingChannelVerificationID).Times(1).Return(nil)
+
+	sut := NewAlertingChannelVerificationRestResourceAdapter(resource, mocks.NewMockRestClient(ctrl))
+
+	result, err := sut.GetOne(alertingChannelVerificationID)
+	require.NoError(t, err)
+	require.Equal(t, object, result)
+	all, err := sut.GetAll()
+	require.NoError(t, err)
+	require.Equal(t, &[]*AlertingChannel{object}, all)
+	require.NoError(t, sut.Delete(object))
+	require.NoError(t, sut.DeleteByID(alertingChannelVerificationID))
+}
+
+func TestShouldNotVerifyAlertingChannelWhenVerificationIsNotRequested(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	object := &AlertingChannel{ID: alertingChannelVerificationID, Name: "name"}
+	resource := mocks.NewMockRestResource[*AlertingChannel](ctrl)
+	resource.EXPECT().Create(object).Times(1).Return(object, nil)
+	resource.EXPECT().Update(object).Times(1).Return(object, nil)
+
+	sut := NewAlertingChannelVerificationRestResourceAdapter(resource, mocks.NewMockRestClient(ctrl))
+
+	_, err := sut.Create(object)
+	require.NoError(t, err)
+	_, err = sut.Update(object)
+	require.NoError(t, err)
+}
+
+func TestShouldVerifyAlertingChannelBeforeCreateAndUpdateWhenVerificationIsRequested(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	object := &AlertingChannel{ID: alertingChannelVerificationID, Name: "name", Kind: SlackChannelType, VerifyOnApply: true}
+	resource := mocks.NewMockRestResource[*AlertingChannel](ctrl)
+	client := mocks.NewMockRestClient(ctrl)
+	gomock.InOrder(
+		client.EXPECT().Put(gomock.Any(), AlertingChannelsResourcePath).Times(1).DoAndReturn(func(data InstanaDataObject, _ string) ([]byte, error) {
+			require.Equal(t, "test", data.GetIDForResourcePath())
+			payload, err := json.Marshal(data)
+			require.NoError(t, err)
+			require.JSONEq(t, `{"id":"alerting-channel-id","name":"name","kind":"SLACK","emails":null,"webhookUrl":null,"apiKey":null,"tags":null,"region":null,"routingKey":null,"serviceIntegrationKey":null,"iconUrl":null,"channel":null,"url":null,"token":null,"webhookUrls":null,"headers":null,"receiver":null,"appId":null,"apiTokenId":null,"teamId":null,"teamName":null,"channelId":null,"channelName":null,"tenantId":null,"tenantName":null,"serviceUrl":null,"emojiRendering":null,"serviceNowUrl":null,"username":null,"password":null,"instanaUrl":null}`, string(payload))
+			return []byte{}, nil
+		}),
+		resource.EXPECT().Create(object).Times(1).Return(object, nil),
+		client.EXPECT().Put(gomock.Any(), AlertingChannelsResourcePath).Times(1).Return([]byte{}, nil),
+		resource.EXPECT().Update(object).Times(1).Return(object, nil),
+	)
+
+	sut := NewAlertingChannelVerificationRestResourceAdapter(resource, client)
+
+	result, err := sut.Create(object)
+	require.NoError(t, err)
+	require.Equal(t, object, result)
+	result, err = sut.Update(object)
+	require.NoError(t, err)
+	require.Equal(t, object, result)
+}
+
+func TestShouldFailToCreateAlertingChannelWhenVerificationFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedError := errors.New("test")
+	object := &AlertingChannel{ID: alertingChannelVerificationID, Name: "name", VerifyOnApply: true}
+	resource := mocks.NewMockRestResource[*AlertingChannel](ctrl)
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Put(gomock.Any(), AlertingChannelsResourcePath).Times(1).Return(nil, expectedError)
+
+	sut := NewAlertingChannelVerificationRestResourceAdapter(resource, client)
+
+	_, err := sut.Create(object)
+
+	require.ErrorIs(t, err, expectedError)
+	require.ErrorContains(t, err, "verification of alerting channel 'name' failed")
+}
+
+func TestShouldFailToUpdateAlertingChannelWhenVerificationFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedError := errors.New("test")
+	object := &AlertingChannel{ID: alertingChannelVerificationID, Name: "name", VerifyOnApply: true}
+	resource := mocks.NewMockRestResource[*AlertingChannel](ctrl)
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Put(gomock.Any(), AlertingChannelsResourcePath).Times(1).Return(nil, expectedError)
+
+	sut := NewAlertingChannelVerificationRestResourceAdapter(resource, client)
+
+	_, err := sut.Update(object)
+
+	require.ErrorIs(t, err, expectedError)
+}
@@ -21,6 +21,22 @@ type AlertingChannel struct {
 	Token                 *string             `json:"token"`
 	WebhookURLs           []string            `json:"webhookUrls"`
 	Headers               []string            `json:"headers"`
+	Receiver              *string             `json:"receiver"`
+	AppID                 *string             `json:"appId"`
+	APITokenID            *string             `json:"apiTokenId"`
+	TeamID                *string             `json:"teamId"`
+	TeamName              *string             `json:"teamName"`
+	ChannelID             *string             `json:"channelId"`
+	ChannelName           *string             `json:"channelName"`
+	TenantID              *string             `json:"tenantId"`
+	TenantName            *string             `json:"tenantName"`
+	ServiceURL            *string             `json:"serviceUrl"`
+	EmojiRendering        *bool               `json:"emojiRendering"`
+	ServiceNowURL         *string             `json:"serviceNowUrl"`
+	Username              *string             `json:"username"`
+	Password              *string             `json:"password"`
+	InstanaURL            *string             `json:"instanaUrl"`
+	VerifyOnApply         bool                `json:"-"`
 }
 
 // GetIDForResourcePath implementation of the interface InstanaDataObject
@@ -0,0 +1,30 @@
+package restapi
+
+import "strconv"
+
+// NewApdexReportRestResource creates a new instance of the ApdexReportRestResource
+func NewApdexReportRestResource(unmarshaller JSONUnmarshaller[*ApdexReport], client RestClient) ApdexReportRestResource {
+	return &apdexReportRestResource{
+		resourcePath: ApdexReportResourcePath,
+		unmarshaller: unmarshaller,
+		client:       client,
+	}
+}
+
+type apdexReportRestResource struct {
+	resourcePath string
+	unmarshaller JSONUnmarshaller[*ApdexReport]
+	client       RestClient
+}
+
+func (r *apdexReportRestResource) GetReport(apdexID string, from int64, to int64) (*[]*ApdexReport, error) {
+	queryParams := map[string]string{
+		"from": strconv.FormatInt(from, 10),
+		"to":   strconv.FormatInt(to, 10),
+	}
+	data, err := r.client.GetByQuery(r.resourcePath+"/"+apdexID, queryParams)
+	if err != nil {
+		return nil, err
+	}
+	return r.unmarshaller.UnmarshalArray(data)
+}
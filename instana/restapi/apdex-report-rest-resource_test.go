@@ -0,0 +1,70 @@
+package restapi_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const apdexID = "apdex-id"
+
+func TestShouldGetApdexReportsForTheGivenTimeWindow(t *testing.T) {
+	restResponseData := []byte(`[
+		{"apdexId":"apdex-id","apdexScore":[[1000,0.95],[1500,0.9]],"from":1000,"to":1500},
+		{"apdexId":"apdex-id","apdexScore":[[1500,0.8]],"from":1500,"to":2000}
+	]`)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().GetByQuery(ApdexReportResourcePath+"/"+apdexID, map[string]string{"from": "1000", "to": "2000"}).Times(1).Return(restResponseData, nil)
+
+	sut := NewApdexReportRestResource(NewDefaultJSONUnmarshaller(&ApdexReport{}), restClient)
+
+	result, err := sut.GetReport(apdexID, 1000, 2000)
+
+	require.NoError(t, err)
+	require.Equal(t, &[]*ApdexReport{
+		{ApdexID: apdexID, ApdexScore: [][]float64{{1000, 0.95}, {1500, 0.9}}, From: 1000, To: 1500},
+		{ApdexID: apdexID, ApdexScore: [][]float64{{1500, 0.8}}, From: 1500, To: 2000},
+	}, result)
+}
+
+func TestShouldFailToGetApdexReportsWhenResponseIsNotAList(t *testing.T) {
+	restResponseData := []byte(`{"apdexId":"apdex-id","apdexScore":[[1000,0.95]],"from":1000,"to":2000}`)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().GetByQuery(ApdexReportResourcePath+"/"+apdexID, gomock.Any()).Times(1).Return(restResponseData, nil)
+
+	sut := NewApdexReportRestResource(NewDefaultJSONUnmarshaller(&ApdexReport{}), restClient)
+
+	_, err := sut.GetReport(apdexID, 1000, 2000)
+
+	require.Error(t, err)
+}
+
+func TestShouldFailToGetApdexReportWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().GetByQuery(ApdexReportResourcePath+"/"+apdexID, gomock.Any()).Times(1).Return(nil, expectedError)
+	jsonUnmarshaller := mocks.NewMockJSONUnmarshaller[*ApdexReport](ctrl)
+	jsonUnmarshaller.EXPECT().UnmarshalArray(gomock.Any()).Times(0)
+
+	sut := NewApdexReportRestResource(jsonUnmarshaller, restClient)
+
+	_, err := sut.GetReport(apdexID, 1000, 2000)
+
+	require.ErrorIs(t, err, expectedError)
+}
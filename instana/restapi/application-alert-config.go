@@ -25,6 +25,8 @@ type ApplicationAlertConfig struct {
 	Rule                  ApplicationAlertRule           `json:"rule"`
 	Threshold             Threshold                      `json:"threshold"`
 	TimeThreshold         TimeThreshold                  `json:"timeThreshold"`
+	Enabled               *bool                          `json:"enabled,omitempty"`
+	RefreshBaseline       bool                           `json:"-"`
 }
 
 // GetIDForResourcePath implementation of the interface InstanaDataObject
@@ -41,3 +43,18 @@ func (a *ApplicationAlertConfig) GetCustomerPayloadFields() []CustomPayloadField
 func (a *ApplicationAlertConfig) SetCustomerPayloadFields(fields []CustomPayloadField[any]) {
 	a.CustomerPayloadFields = fields
 }
+
+// IsHistoricBaselineRefreshRequested implementation of the interface historicBaselineRefreshAwareInstanaDataObject
+func (a *ApplicationAlertConfig) IsHistoricBaselineRefreshRequested() bool {
+	return a.RefreshBaseline
+}
+
+// GetEnabled implementation of the interface enabledStateAwareInstanaDataObject
+func (a *ApplicationAlertConfig) GetEnabled() *bool {
+	return a.Enabled
+}
+
+// SetEnabled implementation of the interface enabledStateAwareInstanaDataObject
+func (a *ApplicationAlertConfig) SetEnabled(enabled *bool) {
+	a.Enabled = enabled
+}
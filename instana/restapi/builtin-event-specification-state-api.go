@@ -0,0 +1,17 @@
+package restapi
+
+// BuiltinEventSpecificationState is the representation of the enabled state of a builtin event specification in
+// Instana. The builtin event specification is referenced by the short plugin id and the name. OriginalEnabled holds the
+// enabled state of the builtin event specification before it was managed; it is nil when it is not known.
+type BuiltinEventSpecificationState struct {
+	ID              string
+	ShortPluginID   string
+	Name            string
+	Enabled         bool
+	OriginalEnabled *bool
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (s *BuiltinEventSpecificationState) GetIDForResourcePath() string {
+	return s.ID
+}
@@ -0,0 +1,112 @@
+package restapi
+
+import "fmt"
+
+// NewBuiltinEventSpecificationStateRestResource creates a new REST resource for the enabled state of builtin event
+// specifications. The state is changed through the enable and disable endpoints of the builtin event specification.
+// Deleting the state restores the original enabled state; when the original state is not known the builtin event
+// specification is enabled as builtin event specifications are enabled by default.
+func NewBuiltinEventSpecificationStateRestResource(unmarshaller JSONUnmarshaller[*BuiltinEventSpecification], client RestClient) RestResource[*BuiltinEventSpecificationState] {
+	return &builtinEventSpecificationStateRestResource{
+		resourcePath: BuiltinEventSpecificationResourcePath,
+		unmarshaller: unmarshaller,
+		client:       client,
+	}
+}
+
+type builtinEventSpecificationStateRestResource struct {
+	resourcePath string
+	unmarshaller JSONUnmarshaller[*BuiltinEventSpecification]
+	client       RestClient
+}
+
+func (r *builtinEventSpecificationStateRestResource) GetAll() (*[]*BuiltinEventSpecificationState, error) {
+	data, err := r.client.Get(r.resourcePath)
+	if err != nil {
+		return nil, err
+	}
+	specs, err := r.unmarshaller.UnmarshalArray(data)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*BuiltinEventSpecificationState, len(*specs))
+	for i, spec := range *specs {
+		result[i] = r.mapSpecificationToState(spec, nil)
+	}
+	return &result, nil
+}
+
+func (r *builtinEventSpecificationStateRestResource) GetOne(id string) (*BuiltinEventSpecificationState, error) {
+	data, err := r.client.GetOne(id, r.resourcePath)
+	if err != nil {
+		return nil, err
+	}
+	spec, err := r.unmarshaller.Unmarshal(data)
+	if err != nil {
+		return nil, err
+	}
+	return r.mapSpecificationToState(spec, nil), nil
+}
+
+func (r *builtinEventSpecificationStateRestResource) Create(data *BuiltinEventSpecificationState) (*BuiltinEventSpecificationState, error) {
+	specs, err := r.GetAll()
+	if err != nil {
+		return data, err
+	}
+	var current *BuiltinEventSpecificationState
+	for _, spec := range *specs {
+		if spec.ShortPluginID == data.ShortPluginID && spec.Name == data.Name {
+			current = spec
+			break
+		}
+	}
+	if current == nil {
+		return data, fmt.Errorf("no built in event found for name '%s' and short plugin ID '%s'", data.Name, data.ShortPluginID)
+	}
+
+	originalEnabled := current.Enabled
+	return r.updateEnabledState(current.ID, data.Enabled, &originalEnabled)
+}
+
+func (r *builtinEventSpecificationStateRestResource) Update(data *BuiltinEventSpecificationState) (*BuiltinEventSpecificationState, error) {
+	return r.updateEnabledState(data.ID, data.Enabled, data.OriginalEnabled)
+}
+
+func (r *builtinEventSpecificationStateRestResource) Delete(data *BuiltinEventSpecificationState) error {
+	enabled := true
+	if data.OriginalEnabled != nil {
+		enabled = *data.OriginalEnabled
+	}
+	_, err := r.updateEnabledState(data.ID, enabled, data.OriginalEnabled)
+	return err
+}
+
+func (r *builtinEventSpecificationStateRestResource) DeleteByID(id string) error {
+	return r.Delete(&BuiltinEventSpecificationState{ID: id})
+}
+
+func (r *builtinEventSpecificationStateRestResource) updateEnabledState(id string, enabled bool, originalEnabled *bool) (*BuiltinEventSpecificationState, error) {
+	operation := "disable"
+	if enabled {
+		operation = "enable"
+	}
+	response, err := r.client.PostByQuery(r.resourcePath+"/"+id+"/"+operation, map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+	spec, err := r.unmarshaller.Unmarshal(response)
+	if err != nil {
+		return nil, err
+	}
+	return r.mapSpecificationToState(spec, originalEnabled), nil
+}
+
+func (r *builtinEventSpecificationStateRestResource) mapSpecificationToState(spec *BuiltinEventSpecification, originalEnabled *bool) *BuiltinEventSpecificationState {
+	return &BuiltinEventSpecificationState{
+		ID:              spec.ID,
+		ShortPluginID:   spec.ShortPluginID,
+		Name:            spec.Name,
+		Enabled:         spec.Enabled,
+		OriginalEnabled: originalEnabled,
+	}
+}
@@ -0,0 +1,163 @@
+package restapi_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	builtinEventStateID            = "builtin-event-id"
+	builtinEventStateShortPluginID = "host"
+	builtinEventStateName          = "System load too high"
+)
+
+var (
+	builtinEventStateListResponse   = []byte("list-response")
+	builtinEventStateSingleResponse = []byte("single-response")
+)
+
+func TestShouldGetBuiltinEventSpecificationState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().GetOne(builtinEventStateID, BuiltinEventSpecificationResourcePath).Times(1).Return(builtinEventStateSingleResponse, nil)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*BuiltinEventSpecification](ctrl)
+	unmarshaller.EXPECT().Unmarshal(builtinEventStateSingleResponse).Times(1).Return(createTestBuiltinEventSpecification(false), nil)
+
+	sut := NewBuiltinEventSpecificationStateRestResource(unmarshaller, client)
+
+	result, err := sut.GetOne(builtinEventStateID)
+
+	require.NoError(t, err)
+	require.Equal(t, &BuiltinEventSpecificationState{ID: builtinEventStateID, ShortPluginID: builtinEventStateShortPluginID, Name: builtinEventStateName, Enabled: false}, result)
+}
+
+func TestShouldFailToGetBuiltinEventSpecificationStateWhenSpecificationCannotBeRetrieved(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().GetOne(builtinEventStateID, BuiltinEventSpecificationResourcePath).Times(1).Return(nil, ErrEntityNotFound)
+
+	sut := NewBuiltinEventSpecificationStateRestResource(mocks.NewMockJSONUnmarshaller[*BuiltinEventSpecification](ctrl), client)
+
+	_, err := sut.GetOne(builtinEventStateID)
+
+	require.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestShouldResolveBuiltinEventSpecificationAndDisableItOnCreate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Get(BuiltinEventSpecificationResourcePath).Times(1).Return(builtinEventStateListResponse, nil)
+	client.EXPECT().PostByQuery(BuiltinEventSpecificationResourcePath+"/"+builtinEventStateID+"/disable", map[string]string{}).Times(1).Return(builtinEventStateSingleResponse, nil)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*BuiltinEventSpecification](ctrl)
+	unmarshaller.EXPECT().UnmarshalArray(builtinEventStateListResponse).Times(1).Return(&[]*BuiltinEventSpecification{
+		{ID: "other", ShortPluginID: builtinEventStateShortPluginID, Name: "other", Enabled: true},
+		createTestBuiltinEventSpecification(true),
+	}, nil)
+	unmarshaller.EXPECT().Unmarshal(builtinEventStateSingleResponse).Times(1).Return(createTestBuiltinEventSpecification(false), nil)
+
+	sut := NewBuiltinEventSpecificationStateRestResource(unmarshaller, client)
+
+	result, err := sut.Create(&BuiltinEventSpecificationState{ShortPluginID: builtinEventStateShortPluginID, Name: builtinEventStateName, Enabled: false})
+
+	require.NoError(t, err)
+	originalEnabled := true
+	require.Equal(t, &BuiltinEventSpecificationState{ID: builtinEventStateID, ShortPluginID: builtinEventStateShortPluginID, Name: builtinEventStateName, Enabled: false, OriginalEnabled: &originalEnabled}, result)
+}
+
+func TestShouldFailToCreateBuiltinEventSpecificationStateWhenSpecificationDoesNotExist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Get(BuiltinEventSpecificationResourcePath).Times(1).Return(builtinEventStateListResponse, nil)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*BuiltinEventSpecification](ctrl)
+	unmarshaller.EXPECT().UnmarshalArray(builtinEventStateListResponse).Times(1).Return(&[]*BuiltinEventSpecification{}, nil)
+
+	sut := NewBuiltinEventSpecificationStateRestResource(unmarshaller, client)
+
+	_, err := sut.Create(&BuiltinEventSpecificationState{ShortPluginID: builtinEventStateShortPluginID, Name: builtinEventStateName, Enabled: false})
+
+	require.ErrorContains(t, err, "no built in event found")
+}
+
+func TestShouldEnableBuiltinEventSpecificationOnUpdateAndKeepOriginalState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().PostByQuery(BuiltinEventSpecificationResourcePath+"/"+builtinEventStateID+"/enable", map[string]string{}).Times(1).Return(builtinEventStateSingleResponse, nil)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*BuiltinEventSpecification](ctrl)
+	unmarshaller.EXPECT().Unmarshal(builtinEventStateSingleResponse).Times(1).Return(createTestBuiltinEventSpecification(true), nil)
+
+	sut := NewBuiltinEventSpecificationStateRestResource(unmarshaller, client)
+	originalEnabled := false
+
+	result, err := sut.Update(&BuiltinEventSpecificationState{ID: builtinEventStateID, Enabled: true, OriginalEnabled: &originalEnabled})
+
+	require.NoError(t, err)
+	require.True(t, result.Enabled)
+	require.Equal(t, &originalEnabled, result.OriginalEnabled)
+}
+
+func TestShouldReturnErrorWhenEnabledStateOfBuiltinEventSpecificationCannotBeChanged(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedError := errors.New("test")
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().PostByQuery(BuiltinEventSpecificationResourcePath+"/"+builtinEventStateID+"/disable", map[string]string{}).Times(1).Return(nil, expectedError)
+
+	sut := NewBuiltinEventSpecificationStateRestResource(mocks.NewMockJSONUnmarshaller[*BuiltinEventSpecification](ctrl), client)
+
+	_, err := sut.Update(&BuiltinEventSpecificationState{ID: builtinEventStateID, Enabled: false})
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldRestoreOriginalEnabledStateOfBuiltinEventSpecificationOnDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().PostByQuery(BuiltinEventSpecificationResourcePath+"/"+builtinEventStateID+"/disable", map[string]string{}).Times(1).Return(builtinEventStateSingleResponse, nil)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*BuiltinEventSpecification](ctrl)
+	unmarshaller.EXPECT().Unmarshal(builtinEventStateSingleResponse).Times(1).Return(createTestBuiltinEventSpecification(false), nil)
+
+	sut := NewBuiltinEventSpecificationStateRestResource(unmarshaller, client)
+	originalEnabled := false
+
+	err := sut.Delete(&BuiltinEventSpecificationState{ID: builtinEventStateID, Enabled: true, OriginalEnabled: &originalEnabled})
+
+	require.NoError(t, err)
+}
+
+func TestShouldEnableBuiltinEventSpecificationOnDeleteWhenOriginalStateIsNotKnown(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().PostByQuery(BuiltinEventSpecificationResourcePath+"/"+builtinEventStateID+"/enable", map[string]string{}).Times(1).Return(builtinEventStateSingleResponse, nil)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*BuiltinEventSpecification](ctrl)
+	unmarshaller.EXPECT().Unmarshal(builtinEventStateSingleResponse).Times(1).Return(createTestBuiltinEventSpecification(true), nil)
+
+	sut := NewBuiltinEventSpecificationStateRestResource(unmarshaller, client)
+
+	err := sut.DeleteByID(builtinEventStateID)
+
+	require.NoError(t, err)
+}
+
+func createTestBuiltinEventSpecification(enabled bool) *BuiltinEventSpecification {
+	return &BuiltinEventSpecification{ID: builtinEventStateID, ShortPluginID: builtinEventStateShortPluginID, Name: builtinEventStateName, Enabled: enabled}
+}
@@ -0,0 +1,85 @@
+package restapi
+
+// EnabledStateAware interface definition of data objects which provide an enabled state managed through the enable
+// and disable endpoints of the Instana API
+type EnabledStateAware interface {
+	GetEnabled() *bool
+	SetEnabled(enabled *bool)
+}
+
+type enabledStateAwareInstanaDataObject interface {
+	EnabledStateAware
+	InstanaDataObject
+}
+
+// NewEnabledStateRestResourceAdapter creates a new RestResource instance which can be added as an adapter to rest
+// resources of data objects supporting the enable and disable endpoints of the Instana API. The enabled state is not
+// part of the create or update payload. It is reconciled after the upsert by calling the enable or disable endpoint
+// when the requested state differs from the state returned by the API.
+func NewEnabledStateRestResourceAdapter[T enabledStateAwareInstanaDataObject](resourcePath string, resource RestResource[T], client RestClient) RestResource[T] {
+	return &enabledStateRestResourceAdapter[T]{
+		resourcePath: resourcePath,
+		resource:     resource,
+		client:       client,
+	}
+}
+
+type enabledStateRestResourceAdapter[T enabledStateAwareInstanaDataObject] struct {
+	resourcePath string
+	resource     RestResource[T]
+	client       RestClient
+}
+
+func (a *enabledStateRestResourceAdapter[T]) GetAll() (*[]T, error) {
+	return a.resource.GetAll()
+}
+
+func (a *enabledStateRestResourceAdapter[T]) GetOne(id string) (T, error) {
+	return a.resource.GetOne(id)
+}
+
+func (a *enabledStateRestResourceAdapter[T]) Create(data T) (T, error) {
+	return a.upsert(data, a.resource.Create)
+}
+
+func (a *enabledStateRestResourceAdapter[T]) Update(data T) (T, error) {
+	return a.upsert(data, a.resource.Update)
+}
+
+func (a *enabledStateRestResourceAdapter[T]) upsert(data T, operation func(T) (T, error)) (T, error) {
+	requestedState := data.GetEnabled()
+	data.SetEnabled(nil)
+	result, err := operation(data)
+	data.SetEnabled(requestedState)
+	if err != nil || requestedState == nil {
+		return result, err
+	}
+
+	currentState := result.GetEnabled()
+	if currentState != nil && *currentState == *requestedState {
+		return result, nil
+	}
+	err = a.updateEnabledState(result.GetIDForResourcePath(), *requestedState)
+	if err != nil {
+		return result, err
+	}
+	result.SetEnabled(requestedState)
+	return result, nil
+}
+
+func (a *enabledStateRestResourceAdapter[T]) updateEnabledState(id string, enabled bool) error {
+	operation := "disable"
+	if enabled {
+		operation = "enable"
+	}
+	_, err := a.client.PutByQuery(a.resourcePath+"/"+id, operation, map[string]string{})
+	return err
+}
+
+func (a *enabledStateRestResourceAdapter[T]) Delete(data T) error {
+	return a.resource.Delete(data)
+}
+
+func (a *enabledStateRestResourceAdapter[T]) DeleteByID(id string) error {
+	return a.resource.DeleteByID(id)
+}
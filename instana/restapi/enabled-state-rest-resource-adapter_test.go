@@ -0,0 +1,156 @@
+package restapi_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	enabledStateAdapterID           = "alert-config-id"
+	enabledStateAdapterResourcePath = "/api/test/alert-configs"
+)
+
+func TestShouldDelegateGetOneOfEnabledStateRestResourceAdapter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expected := &WebsiteAlertConfig{ID: enabledStateAdapterID, Enabled: utils.BoolPtr(true)}
+	resource := mocks.NewMockRestResource[*WebsiteAlertConfig](ctrl)
+	resource.EXPECT().GetOne(enabledStateAdapterID).Times(1).Return(expected, nil)
+
+	sut := NewEnabledStateRestResourceAdapter[*WebsiteAlertConfig](enabledStateAdapterResourcePath, resource, mocks.NewMockRestClient(ctrl))
+
+	result, err := sut.GetOne(enabledStateAdapterID)
+
+	require.NoError(t, err)
+	require.Equal(t, expected, result)
+}
+
+func TestShouldDelegateGetAllAndDeleteOfEnabledStateRestResourceAdapter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	object := &WebsiteAlertConfig{ID: enabledStateAdapterID}
+	resource := mocks.NewMockRestResource[*WebsiteAlertConfig](ctrl)
+	resource.EXPECT().GetAll().Times(1).Return(&[]*WebsiteAlertConfig{object}, nil)
+	resource.EXPECT().Delete(object).Times(1).Return(nil)
+	resource.EXPECT().DeleteByID(enabledStateAdapterID).Times(1).Return(nil)
+
+	sut := NewEnabledStateRestResourceAdapter[*WebsiteAlertConfig](enabledStateAdapterResourcePath, resource, mocks.NewMockRestClient(ctrl))
+
+	result, err := sut.GetAll()
+	require.NoError(t, err)
+	require.Equal(t, &[]*WebsiteAlertConfig{object}, result)
+	require.NoError(t, sut.Delete(object))
+	require.NoError(t, sut.DeleteByID(enabledStateAdapterID))
+}
+
+func TestShouldNotCallEnabledStateEndpointsOnCreateWhenStateIsNotRequested(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	input := &WebsiteAlertConfig{Name: "test"}
+	expected := &WebsiteAlertConfig{ID: enabledStateAdapterID, Name: "test", Enabled: utils.BoolPtr(true)}
+	resource := mocks.NewMockRestResource[*WebsiteAlertConfig](ctrl)
+	resource.EXPECT().Create(input).Times(1).Return(expected, nil)
+
+	sut := NewEnabledStateRestResourceAdapter[*WebsiteAlertConfig](enabledStateAdapterResourcePath, resource, mocks.NewMockRestClient(ctrl))
+
+	result, err := sut.Create(input)
+
+	require.NoError(t, err)
+	require.Equal(t, expected, result)
+}
+
+func TestShouldNotCallEnabledStateEndpointsOnCreateWhenRequestedStateMatchesTheStateOfTheAPI(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expected := &WebsiteAlertConfig{ID: enabledStateAdapterID, Enabled: utils.BoolPtr(true)}
+	resource := mocks.NewMockRestResource[*WebsiteAlertConfig](ctrl)
+	resource.EXPECT().Create(gomock.Any()).Times(1).DoAndReturn(func(data *WebsiteAlertConfig) (*WebsiteAlertConfig, error) {
+		require.Nil(t, data.Enabled)
+		return expected, nil
+	})
+
+	sut := NewEnabledStateRestResourceAdapter[*WebsiteAlertConfig](enabledStateAdapterResourcePath, resource, mocks.NewMockRestClient(ctrl))
+
+	input := &WebsiteAlertConfig{Enabled: utils.BoolPtr(true)}
+	result, err := sut.Create(input)
+
+	require.NoError(t, err)
+	require.Equal(t, expected, result)
+	require.Equal(t, utils.BoolPtr(true), input.Enabled)
+}
+
+func TestShouldDisableObjectOnCreateWhenDisabledStateIsRequested(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	resource := mocks.NewMockRestResource[*WebsiteAlertConfig](ctrl)
+	resource.EXPECT().Create(gomock.Any()).Times(1).Return(&WebsiteAlertConfig{ID: enabledStateAdapterID, Enabled: utils.BoolPtr(true)}, nil)
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().PutByQuery(enabledStateAdapterResourcePath+"/"+enabledStateAdapterID, "disable", map[string]string{}).Times(1).Return([]byte{}, nil)
+
+	sut := NewEnabledStateRestResourceAdapter[*WebsiteAlertConfig](enabledStateAdapterResourcePath, resource, client)
+
+	result, err := sut.Create(&WebsiteAlertConfig{Enabled: utils.BoolPtr(false)})
+
+	require.NoError(t, err)
+	require.Equal(t, &WebsiteAlertConfig{ID: enabledStateAdapterID, Enabled: utils.BoolPtr(false)}, result)
+}
+
+func TestShouldEnableObjectOnUpdateWhenEnabledStateIsRequestedAndAPIDoesNotProvideTheState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	resource := mocks.NewMockRestResource[*WebsiteAlertConfig](ctrl)
+	resource.EXPECT().Update(gomock.Any()).Times(1).Return(&WebsiteAlertConfig{ID: enabledStateAdapterID}, nil)
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().PutByQuery(enabledStateAdapterResourcePath+"/"+enabledStateAdapterID, "enable", map[string]string{}).Times(1).Return([]byte{}, nil)
+
+	sut := NewEnabledStateRestResourceAdapter[*WebsiteAlertConfig](enabledStateAdapterResourcePath, resource, client)
+
+	result, err := sut.Update(&WebsiteAlertConfig{ID: enabledStateAdapterID, Enabled: utils.BoolPtr(true)})
+
+	require.NoError(t, err)
+	require.Equal(t, &WebsiteAlertConfig{ID: enabledStateAdapterID, Enabled: utils.BoolPtr(true)}, result)
+}
+
+func TestShouldReturnErrorOfUpsertWithoutCallingEnabledStateEndpoints(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedError := errors.New("test")
+	resource := mocks.NewMockRestResource[*WebsiteAlertConfig](ctrl)
+	resource.EXPECT().Update(gomock.Any()).Times(1).Return(nil, expectedError)
+
+	sut := NewEnabledStateRestResourceAdapter[*WebsiteAlertConfig](enabledStateAdapterResourcePath, resource, mocks.NewMockRestClient(ctrl))
+
+	_, err := sut.Update(&WebsiteAlertConfig{ID: enabledStateAdapterID, Enabled: utils.BoolPtr(false)})
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldReturnErrorWhenEnabledStateCannotBeUpdated(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedError := errors.New("test")
+	resource := mocks.NewMockRestResource[*WebsiteAlertConfig](ctrl)
+	resource.EXPECT().Update(gomock.Any()).Times(1).Return(&WebsiteAlertConfig{ID: enabledStateAdapterID, Enabled: utils.BoolPtr(true)}, nil)
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().PutByQuery(enabledStateAdapterResourcePath+"/"+enabledStateAdapterID, "disable", map[string]string{}).Times(1).Return(nil, expectedError)
+
+	sut := NewEnabledStateRestResourceAdapter[*WebsiteAlertConfig](enabledStateAdapterResourcePath, resource, client)
+
+	_, err := sut.Update(&WebsiteAlertConfig{ID: enabledStateAdapterID, Enabled: utils.BoolPtr(false)})
+
+	require.ErrorIs(t, err, expectedError)
+}
@@ -0,0 +1,28 @@
+package restapi
+
+// NewGetOneFromListRestResource creates a new REST resource which delegates all operations to the given RestResource
+// except GetOne. Some resources of the Instana API do not provide an endpoint to read a single object. For those,
+// GetOne is implemented by reading all objects and filtering them by ID.
+func NewGetOneFromListRestResource[T InstanaDataObject](delegate RestResource[T]) RestResource[T] {
+	return &getOneFromListRestResource[T]{
+		RestResource: delegate,
+	}
+}
+
+type getOneFromListRestResource[T InstanaDataObject] struct {
+	RestResource[T]
+}
+
+func (r *getOneFromListRestResource[T]) GetOne(id string) (T, error) {
+	var result T
+	objects, err := r.GetAll()
+	if err != nil {
+		return result, err
+	}
+	for _, o := range *objects {
+		if o.GetIDForResourcePath() == id {
+			return o, nil
+		}
+	}
+	return result, ErrEntityNotFound
+}
@@ -0,0 +1,90 @@
+package restapi_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const getOneFromListObjectID = "object-id"
+
+func TestShouldGetOneObjectByFilteringAllObjects(t *testing.T) {
+	expectedObject := &ManualServiceConfig{ID: getOneFromListObjectID}
+	allObjects := []*ManualServiceConfig{{ID: "other-id"}, expectedObject}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	delegate := mocks.NewMockRestResource[*ManualServiceConfig](ctrl)
+	delegate.EXPECT().GetAll().Times(1).Return(&allObjects, nil)
+	delegate.EXPECT().GetOne(gomock.Any()).Times(0)
+
+	sut := NewGetOneFromListRestResource[*ManualServiceConfig](delegate)
+
+	result, err := sut.GetOne(getOneFromListObjectID)
+
+	require.NoError(t, err)
+	require.Equal(t, expectedObject, result)
+}
+
+func TestShouldReturnNotFoundErrorWhenObjectIsNotContainedInListOfAllObjects(t *testing.T) {
+	allObjects := []*ManualServiceConfig{{ID: "other-id"}}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	delegate := mocks.NewMockRestResource[*ManualServiceConfig](ctrl)
+	delegate.EXPECT().GetAll().Times(1).Return(&allObjects, nil)
+
+	sut := NewGetOneFromListRestResource[*ManualServiceConfig](delegate)
+
+	_, err := sut.GetOne(getOneFromListObjectID)
+
+	require.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestShouldFailToGetOneObjectWhenAllObjectsCannotBeRetrieved(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	delegate := mocks.NewMockRestResource[*ManualServiceConfig](ctrl)
+	delegate.EXPECT().GetAll().Times(1).Return(nil, expectedError)
+
+	sut := NewGetOneFromListRestResource[*ManualServiceConfig](delegate)
+
+	_, err := sut.GetOne(getOneFromListObjectID)
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldDelegateAllOtherOperationsOfGetOneFromListRestResource(t *testing.T) {
+	object := &ManualServiceConfig{ID: getOneFromListObjectID}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	delegate := mocks.NewMockRestResource[*ManualServiceConfig](ctrl)
+	delegate.EXPECT().Create(object).Times(1).Return(object, nil)
+	delegate.EXPECT().Update(object).Times(1).Return(object, nil)
+	delegate.EXPECT().Delete(object).Times(1).Return(nil)
+	delegate.EXPECT().DeleteByID(getOneFromListObjectID).Times(1).Return(nil)
+
+	sut := NewGetOneFromListRestResource[*ManualServiceConfig](delegate)
+
+	created, err := sut.Create(object)
+	require.NoError(t, err)
+	require.Equal(t, object, created)
+
+	updated, err := sut.Update(object)
+	require.NoError(t, err)
+	require.Equal(t, object, updated)
+
+	require.NoError(t, sut.Delete(object))
+	require.NoError(t, sut.DeleteByID(getOneFromListObjectID))
+}
@@ -0,0 +1,27 @@
+package restapi
+
+import "sync"
+
+// groupLocks serializes the modifications of a single group which are performed through the different group
+// endpoints. Modifications which rewrite the full group (read, modify, write) would otherwise overwrite concurrent
+// modifications of the members or permissions of the same group which are applied in parallel by terraform.
+var groupLocks = &groupLockRegistry{locks: make(map[string]*sync.Mutex)}
+
+type groupLockRegistry struct {
+	mutex sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+// lock acquires the lock of the group with the given ID and returns the function to release it
+func (r *groupLockRegistry) lock(groupID string) func() {
+	r.mutex.Lock()
+	groupLock, ok := r.locks[groupID]
+	if !ok {
+		groupLock = &sync.Mutex{}
+		r.locks[groupID] = groupLock
+	}
+	r.mutex.Unlock()
+
+	groupLock.Lock()
+	return groupLock.Unlock
+}
@@ -0,0 +1,18 @@
+package restapi
+
+// GroupMappingsResourcePath path to the group mapping resource of Instana RESTful API
+const GroupMappingsResourcePath = RBACSettingsBasePath + "/mappings"
+
+// GroupMapping is the representation of a mapping of an identity provider group to an Instana RBAC group. Users
+// which are assigned to the identity provider group with the given key and value are added to the Instana group.
+type GroupMapping struct {
+	ID      string `json:"id"`
+	GroupID string `json:"groupId"`
+	Key     string `json:"key"`
+	Value   string `json:"value"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (m *GroupMapping) GetIDForResourcePath() string {
+	return m.ID
+}
@@ -0,0 +1,54 @@
+package restapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	groupMembershipIDSeparator = ":"
+	groupUsersPathElement      = "users"
+	groupUserPathElement       = "/user"
+)
+
+// NewGroupMembershipID creates the ID of a group membership from the given group ID and user ID
+func NewGroupMembershipID(groupID string, userID string) string {
+	return groupID + groupMembershipIDSeparator + userID
+}
+
+// ParseGroupMembershipID splits the ID of a group membership into the group ID and the user ID
+func ParseGroupMembershipID(id string) (string, string, error) {
+	parts := strings.Split(id, groupMembershipIDSeparator)
+	if len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+		return "", "", fmt.Errorf("invalid group membership id %s; expected <group_id>%s<user_id>", id, groupMembershipIDSeparator)
+	}
+	return parts[0], parts[1], nil
+}
+
+// GroupMembership is the representation of the membership of a single user in a group for role based access control
+type GroupMembership struct {
+	GroupID string
+	UserID  string
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (m *GroupMembership) GetIDForResourcePath() string {
+	return NewGroupMembershipID(m.GroupID, m.UserID)
+}
+
+// groupUsers is the payload to add users to a group. The users are added through the resource path
+// <group_path>/users; therefore the ID for the resource path is the users path element.
+type groupUsers struct {
+	userIDs []string
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (u *groupUsers) GetIDForResourcePath() string {
+	return groupUsersPathElement
+}
+
+// MarshalJSON custom marshalling of the group users as plain list of user IDs
+func (u *groupUsers) MarshalJSON() ([]byte, error) {
+	return json.Marshal(u.userIDs)
+}
@@ -0,0 +1,91 @@
+package restapi
+
+import "fmt"
+
+// NewGroupMembershipRestResource creates a new REST resource for the membership of single users in groups. Memberships
+// are read from the members of the group, created via the users endpoint of the group and deleted via the user
+// endpoint of the group. Modifications are serialized with other modifications of the same group. Update is not
+// supported.
+func NewGroupMembershipRestResource(unmarshaller JSONUnmarshaller[*Group], client RestClient) RestResource[*GroupMembership] {
+	return &groupMembershipRestResource{
+		resourcePath: GroupsResourcePath,
+		unmarshaller: unmarshaller,
+		client:       client,
+	}
+}
+
+type groupMembershipRestResource struct {
+	resourcePath string
+	unmarshaller JSONUnmarshaller[*Group]
+	client       RestClient
+}
+
+func (r *groupMembershipRestResource) GetAll() (*[]*GroupMembership, error) {
+	data, err := r.client.Get(r.resourcePath)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := r.unmarshaller.UnmarshalArray(data)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*GroupMembership, 0)
+	for _, g := range *groups {
+		for _, m := range g.Members {
+			result = append(result, &GroupMembership{GroupID: g.ID, UserID: m.UserID})
+		}
+	}
+	return &result, nil
+}
+
+func (r *groupMembershipRestResource) GetOne(id string) (*GroupMembership, error) {
+	groupID, userID, err := ParseGroupMembershipID(id)
+	if err != nil {
+		return nil, err
+	}
+	data, err := r.client.GetOne(groupID, r.resourcePath)
+	if err != nil {
+		return nil, err
+	}
+	group, err := r.unmarshaller.Unmarshal(data)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range group.Members {
+		if m.UserID == userID {
+			return &GroupMembership{GroupID: groupID, UserID: userID}, nil
+		}
+	}
+	return nil, ErrEntityNotFound
+}
+
+func (r *groupMembershipRestResource) Create(data *GroupMembership) (*GroupMembership, error) {
+	unlock := groupLocks.lock(data.GroupID)
+	_, err := r.client.Put(&groupUsers{userIDs: []string{data.UserID}}, r.groupPath(data.GroupID))
+	unlock()
+	if err != nil {
+		return data, err
+	}
+	return r.GetOne(data.GetIDForResourcePath())
+}
+
+func (r *groupMembershipRestResource) Update(_ *GroupMembership) (*GroupMembership, error) {
+	return nil, fmt.Errorf("update is not supported for group memberships")
+}
+
+func (r *groupMembershipRestResource) Delete(data *GroupMembership) error {
+	return r.DeleteByID(data.GetIDForResourcePath())
+}
+
+func (r *groupMembershipRestResource) DeleteByID(id string) error {
+	groupID, userID, err := ParseGroupMembershipID(id)
+	if err != nil {
+		return err
+	}
+	defer groupLocks.lock(groupID)()
+	return r.client.Delete(userID, r.groupPath(groupID)+groupUserPathElement)
+}
+
+func (r *groupMembershipRestResource) groupPath(groupID string) string {
+	return r.resourcePath + "/" + groupID
+}
@@ -0,0 +1,196 @@
+package restapi_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	groupMembershipGroupID = "group-id"
+	groupMembershipUserID  = "user-id"
+	groupMembershipID      = groupMembershipGroupID + ":" + groupMembershipUserID
+)
+
+var groupMembershipGroupResponse = []byte("group-response")
+
+func TestShouldCreateAndParseGroupMembershipID(t *testing.T) {
+	id := NewGroupMembershipID(groupMembershipGroupID, groupMembershipUserID)
+
+	groupID, userID, err := ParseGroupMembershipID(id)
+
+	require.NoError(t, err)
+	require.Equal(t, groupMembershipID, id)
+	require.Equal(t, groupMembershipGroupID, groupID)
+	require.Equal(t, groupMembershipUserID, userID)
+}
+
+func TestShouldFailToParseInvalidGroupMembershipID(t *testing.T) {
+	for _, id := range []string{"", "group-id", "group-id:", ":user-id", "a:b:c"} {
+		t.Run(id, func(t *testing.T) {
+			_, _, err := ParseGroupMembershipID(id)
+
+			require.ErrorContains(t, err, "invalid group membership id")
+		})
+	}
+}
+
+func TestShouldGetGroupMembershipFromMembersOfGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().GetOne(groupMembershipGroupID, GroupsResourcePath).Times(1).Return(groupMembershipGroupResponse, nil)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*Group](ctrl)
+	unmarshaller.EXPECT().Unmarshal(groupMembershipGroupResponse).Times(1).Return(&Group{ID: groupMembershipGroupID, Members: []APIMember{{UserID: "other"}, {UserID: groupMembershipUserID}}}, nil)
+
+	sut := NewGroupMembershipRestResource(unmarshaller, client)
+
+	result, err := sut.GetOne(groupMembershipID)
+
+	require.NoError(t, err)
+	require.Equal(t, &GroupMembership{GroupID: groupMembershipGroupID, UserID: groupMembershipUserID}, result)
+}
+
+func TestShouldReturnNotFoundErrorWhenUserIsNotAMemberOfTheGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().GetOne(groupMembershipGroupID, GroupsResourcePath).Times(1).Return(groupMembershipGroupResponse, nil)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*Group](ctrl)
+	unmarshaller.EXPECT().Unmarshal(groupMembershipGroupResponse).Times(1).Return(&Group{ID: groupMembershipGroupID, Members: []APIMember{{UserID: "other"}}}, nil)
+
+	sut := NewGroupMembershipRestResource(unmarshaller, client)
+
+	_, err := sut.GetOne(groupMembershipID)
+
+	require.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestShouldFailToGetGroupMembershipWhenGroupCannotBeRetrieved(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().GetOne(groupMembershipGroupID, GroupsResourcePath).Times(1).Return(nil, ErrEntityNotFound)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*Group](ctrl)
+
+	sut := NewGroupMembershipRestResource(unmarshaller, client)
+
+	_, err := sut.GetOne(groupMembershipID)
+
+	require.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestShouldGetAllGroupMembershipsFromAllGroups(t *testing.T) {
+	groups := []*Group{
+		{ID: "group-1", Members: []APIMember{{UserID: "user-1"}, {UserID: "user-2"}}},
+		{ID: "group-2", Members: []APIMember{{UserID: "user-1"}}},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Get(GroupsResourcePath).Times(1).Return(groupMembershipGroupResponse, nil)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*Group](ctrl)
+	unmarshaller.EXPECT().UnmarshalArray(groupMembershipGroupResponse).Times(1).Return(&groups, nil)
+
+	sut := NewGroupMembershipRestResource(unmarshaller, client)
+
+	result, err := sut.GetAll()
+
+	require.NoError(t, err)
+	require.Equal(t, &[]*GroupMembership{
+		{GroupID: "group-1", UserID: "user-1"},
+		{GroupID: "group-1", UserID: "user-2"},
+		{GroupID: "group-2", UserID: "user-1"},
+	}, result)
+}
+
+func TestShouldCreateGroupMembershipViaUsersEndpointOfGroup(t *testing.T) {
+	membership := &GroupMembership{GroupID: groupMembershipGroupID, UserID: groupMembershipUserID}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	gomock.InOrder(
+		client.EXPECT().Put(gomock.Any(), GroupsResourcePath+"/"+groupMembershipGroupID).Times(1).DoAndReturn(func(data InstanaDataObject, _ string) ([]byte, error) {
+			require.Equal(t, "users", data.GetIDForResourcePath())
+			payload, err := json.Marshal(data)
+			require.NoError(t, err)
+			require.JSONEq(t, `["user-id"]`, string(payload))
+			return groupMembershipGroupResponse, nil
+		}),
+		client.EXPECT().GetOne(groupMembershipGroupID, GroupsResourcePath).Times(1).Return(groupMembershipGroupResponse, nil),
+	)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*Group](ctrl)
+	unmarshaller.EXPECT().Unmarshal(groupMembershipGroupResponse).Times(1).Return(&Group{ID: groupMembershipGroupID, Members: []APIMember{{UserID: groupMembershipUserID}}}, nil)
+
+	sut := NewGroupMembershipRestResource(unmarshaller, client)
+
+	result, err := sut.Create(membership)
+
+	require.NoError(t, err)
+	require.Equal(t, membership, result)
+}
+
+func TestShouldFailToCreateGroupMembershipWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+	membership := &GroupMembership{GroupID: groupMembershipGroupID, UserID: groupMembershipUserID}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Put(gomock.Any(), GroupsResourcePath+"/"+groupMembershipGroupID).Times(1).Return(nil, expectedError)
+
+	sut := NewGroupMembershipRestResource(mocks.NewMockJSONUnmarshaller[*Group](ctrl), client)
+
+	_, err := sut.Create(membership)
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldNotSupportUpdateOfGroupMembership(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sut := NewGroupMembershipRestResource(mocks.NewMockJSONUnmarshaller[*Group](ctrl), mocks.NewMockRestClient(ctrl))
+
+	_, err := sut.Update(&GroupMembership{GroupID: groupMembershipGroupID, UserID: groupMembershipUserID})
+
+	require.ErrorContains(t, err, "update is not supported")
+}
+
+func TestShouldDeleteGroupMembershipViaUserEndpointOfGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Delete(groupMembershipUserID, GroupsResourcePath+"/"+groupMembershipGroupID+"/user").Times(1).Return(nil)
+
+	sut := NewGroupMembershipRestResource(mocks.NewMockJSONUnmarshaller[*Group](ctrl), client)
+
+	err := sut.Delete(&GroupMembership{GroupID: groupMembershipGroupID, UserID: groupMembershipUserID})
+
+	require.NoError(t, err)
+}
+
+func TestShouldFailToDeleteGroupMembershipWhenIDIsInvalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sut := NewGroupMembershipRestResource(mocks.NewMockJSONUnmarshaller[*Group](ctrl), mocks.NewMockRestClient(ctrl))
+
+	err := sut.DeleteByID("invalid")
+
+	require.ErrorContains(t, err, "invalid group membership id")
+}
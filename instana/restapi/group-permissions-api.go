@@ -0,0 +1,36 @@
+package restapi
+
+import "encoding/json"
+
+const groupPermissionsPathElement = "permissions"
+
+// GroupPermissions is the representation of the list of permissions of a group for role based access control which are
+// managed independently of the group. The permissions are identified by the ID of the group. RevokedPermissions are
+// not part of the Instana API model. They define the permissions which were managed before and have to be removed
+// from the group when the permissions are updated.
+type GroupPermissions struct {
+	GroupID            string
+	Permissions        []InstanaPermission
+	RevokedPermissions []InstanaPermission
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (p *GroupPermissions) GetIDForResourcePath() string {
+	return p.GroupID
+}
+
+// groupPermissionsPayload is the payload to add permissions to a group. The permissions are added through the
+// resource path <group_path>/permissions; therefore the ID for the resource path is the permissions path element.
+type groupPermissionsPayload struct {
+	permissions []InstanaPermission
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (p *groupPermissionsPayload) GetIDForResourcePath() string {
+	return groupPermissionsPathElement
+}
+
+// MarshalJSON custom marshalling of the group permissions as plain list of permissions
+func (p *groupPermissionsPayload) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.permissions)
+}
@@ -0,0 +1,144 @@
+package restapi
+
+// NewGroupPermissionsRestResource creates a new REST resource for the permissions of groups. The permissions are read
+// from the group and granted via the permissions endpoint of the group. The permissions endpoint only adds permissions.
+// Revoked permissions are therefore removed by rewriting the permission set of the group through the group endpoint.
+// The group is read and written while holding the lock of the group, so that concurrent membership changes of the
+// provider are not overwritten. All other permissions of the group are preserved. Therefore, created and updated
+// permissions only contain the granted permissions which are assigned to the group.
+func NewGroupPermissionsRestResource(unmarshaller JSONUnmarshaller[*Group], client RestClient) RestResource[*GroupPermissions] {
+	return &groupPermissionsRestResource{
+		resourcePath: GroupsResourcePath,
+		unmarshaller: unmarshaller,
+		client:       client,
+	}
+}
+
+type groupPermissionsRestResource struct {
+	resourcePath string
+	unmarshaller JSONUnmarshaller[*Group]
+	client       RestClient
+}
+
+func (r *groupPermissionsRestResource) GetAll() (*[]*GroupPermissions, error) {
+	data, err := r.client.Get(r.resourcePath)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := r.unmarshaller.UnmarshalArray(data)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*GroupPermissions, len(*groups))
+	for i, g := range *groups {
+		result[i] = &GroupPermissions{GroupID: g.ID, Permissions: g.PermissionSet.Permissions}
+	}
+	return &result, nil
+}
+
+func (r *groupPermissionsRestResource) GetOne(groupID string) (*GroupPermissions, error) {
+	group, err := r.getGroup(groupID)
+	if err != nil {
+		return nil, err
+	}
+	return &GroupPermissions{GroupID: group.ID, Permissions: group.PermissionSet.Permissions}, nil
+}
+
+func (r *groupPermissionsRestResource) getGroup(groupID string) (*Group, error) {
+	data, err := r.client.GetOne(groupID, r.resourcePath)
+	if err != nil {
+		return nil, err
+	}
+	return r.unmarshaller.Unmarshal(data)
+}
+
+func (r *groupPermissionsRestResource) Create(data *GroupPermissions) (*GroupPermissions, error) {
+	return r.Update(data)
+}
+
+func (r *groupPermissionsRestResource) Update(data *GroupPermissions) (*GroupPermissions, error) {
+	_, err := r.client.Put(&groupPermissionsPayload{permissions: data.Permissions}, r.groupPath(data.GroupID))
+	if err != nil {
+		return data, err
+	}
+
+	var group *Group
+	if len(data.RevokedPermissions) > 0 {
+		group, err = r.revokePermissions(data.GroupID, data.RevokedPermissions, data.Permissions)
+	} else {
+		group, err = r.getGroup(data.GroupID)
+	}
+	if err != nil {
+		return data, err
+	}
+	return &GroupPermissions{GroupID: group.ID, Permissions: r.filterPermissions(group.PermissionSet.Permissions, data.Permissions)}, nil
+}
+
+func (r *groupPermissionsRestResource) Delete(data *GroupPermissions) error {
+	_, err := r.revokePermissions(data.GroupID, data.Permissions, []InstanaPermission{})
+	return err
+}
+
+// DeleteByID revokes all permissions of the group as the permissions which are managed by the resource are not known
+func (r *groupPermissionsRestResource) DeleteByID(groupID string) error {
+	unlock := groupLocks.lock(groupID)
+	defer unlock()
+
+	group, err := r.getGroup(groupID)
+	if err != nil {
+		return err
+	}
+	group.PermissionSet.Permissions = []InstanaPermission{}
+	_, err = r.client.Put(group, r.resourcePath)
+	return err
+}
+
+// revokePermissions reads the group, removes the revoked permissions which are not granted and writes the group back
+// through the group endpoint. The group is locked in between so that concurrent membership changes are not overwritten.
+func (r *groupPermissionsRestResource) revokePermissions(groupID string, revoked []InstanaPermission, granted []InstanaPermission) (*Group, error) {
+	unlock := groupLocks.lock(groupID)
+	defer unlock()
+
+	group, err := r.getGroup(groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions := make([]InstanaPermission, 0, len(group.PermissionSet.Permissions))
+	for _, p := range group.PermissionSet.Permissions {
+		if !r.containsPermission(revoked, p) || r.containsPermission(granted, p) {
+			permissions = append(permissions, p)
+		}
+	}
+	group.PermissionSet.Permissions = permissions
+
+	data, err := r.client.Put(group, r.resourcePath)
+	if err != nil {
+		return nil, err
+	}
+	return r.unmarshaller.Unmarshal(data)
+}
+
+func (r *groupPermissionsRestResource) groupPath(groupID string) string {
+	return r.resourcePath + "/" + groupID
+}
+
+// filterPermissions returns the permissions which are assigned and managed
+func (r *groupPermissionsRestResource) filterPermissions(assigned []InstanaPermission, managed []InstanaPermission) []InstanaPermission {
+	result := make([]InstanaPermission, 0, len(managed))
+	for _, p := range assigned {
+		if r.containsPermission(managed, p) {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+func (r *groupPermissionsRestResource) containsPermission(permissions []InstanaPermission, permission InstanaPermission) bool {
+	for _, p := range permissions {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,319 @@
+package restapi_test
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const groupPermissionsGroupID = "group-id"
+
+var groupPermissionsGroupResponse = []byte("group-response")
+
+func TestShouldGetGroupPermissionsFromPermissionSetOfGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().GetOne(groupPermissionsGroupID, GroupsResourcePath).Times(1).Return(groupPermissionsGroupResponse, nil)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*Group](ctrl)
+	unmarshaller.EXPECT().Unmarshal(groupPermissionsGroupResponse).Times(1).Return(&Group{
+		ID:            groupPermissionsGroupID,
+		PermissionSet: APIPermissionSetWithRoles{Permissions: []InstanaPermission{PermissionCanConfigureAgents}},
+	}, nil)
+
+	sut := NewGroupPermissionsRestResource(unmarshaller, client)
+
+	result, err := sut.GetOne(groupPermissionsGroupID)
+
+	require.NoError(t, err)
+	require.Equal(t, &GroupPermissions{GroupID: groupPermissionsGroupID, Permissions: []InstanaPermission{PermissionCanConfigureAgents}}, result)
+}
+
+func TestShouldFailToGetGroupPermissionsWhenGroupCannotBeRetrieved(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().GetOne(groupPermissionsGroupID, GroupsResourcePath).Times(1).Return(nil, ErrEntityNotFound)
+
+	sut := NewGroupPermissionsRestResource(mocks.NewMockJSONUnmarshaller[*Group](ctrl), client)
+
+	_, err := sut.GetOne(groupPermissionsGroupID)
+
+	require.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestShouldGetAllGroupPermissions(t *testing.T) {
+	groups := []*Group{
+		{ID: "group-1", PermissionSet: APIPermissionSetWithRoles{Permissions: []InstanaPermission{PermissionCanConfigureAgents}}},
+		{ID: "group-2"},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Get(GroupsResourcePath).Times(1).Return(groupPermissionsGroupResponse, nil)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*Group](ctrl)
+	unmarshaller.EXPECT().UnmarshalArray(groupPermissionsGroupResponse).Times(1).Return(&groups, nil)
+
+	sut := NewGroupPermissionsRestResource(unmarshaller, client)
+
+	result, err := sut.GetAll()
+
+	require.NoError(t, err)
+	require.Equal(t, &[]*GroupPermissions{
+		{GroupID: "group-1", Permissions: []InstanaPermission{PermissionCanConfigureAgents}},
+		{GroupID: "group-2"},
+	}, result)
+}
+
+func TestShouldCreateGroupPermissionsThroughThePermissionsEndpointOfTheGroup(t *testing.T) {
+	permissions := &GroupPermissions{GroupID: groupPermissionsGroupID, Permissions: []InstanaPermission{PermissionCanConfigureAgents, PermissionCanViewLogs}}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*Group](ctrl)
+	gomock.InOrder(
+		client.EXPECT().Put(gomock.Any(), GroupsResourcePath+"/"+groupPermissionsGroupID).Times(1).DoAndReturn(func(data InstanaDataObject, _ string) ([]byte, error) {
+			require.Equal(t, "permissions", data.GetIDForResourcePath())
+			payload, err := json.Marshal(data)
+			require.NoError(t, err)
+			require.JSONEq(t, `["CAN_CONFIGURE_AGENTS","CAN_VIEW_LOGS"]`, string(payload))
+			return []byte{}, nil
+		}),
+		client.EXPECT().GetOne(groupPermissionsGroupID, GroupsResourcePath).Times(1).Return(groupPermissionsGroupResponse, nil),
+		unmarshaller.EXPECT().Unmarshal(groupPermissionsGroupResponse).Times(1).Return(&Group{
+			ID:            groupPermissionsGroupID,
+			PermissionSet: APIPermissionSetWithRoles{Permissions: []InstanaPermission{PermissionCanViewAuditLog, PermissionCanViewLogs, PermissionCanConfigureAgents}},
+		}, nil),
+	)
+	client.EXPECT().Put(gomock.Any(), GroupsResourcePath).Times(0)
+
+	sut := NewGroupPermissionsRestResource(unmarshaller, client)
+
+	result, err := sut.Create(permissions)
+
+	require.NoError(t, err)
+	require.Equal(t, &GroupPermissions{GroupID: groupPermissionsGroupID, Permissions: []InstanaPermission{PermissionCanViewLogs, PermissionCanConfigureAgents}}, result)
+}
+
+func TestShouldRevokeRemovedPermissionsAndPreserveUnmanagedPermissionsWhenGroupPermissionsAreUpdated(t *testing.T) {
+	permissions := &GroupPermissions{
+		GroupID:            groupPermissionsGroupID,
+		Permissions:        []InstanaPermission{PermissionCanViewLogs},
+		RevokedPermissions: []InstanaPermission{PermissionCanConfigureAgents},
+	}
+	updatedGroupResponse := []byte("updated-group-response")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*Group](ctrl)
+	gomock.InOrder(
+		client.EXPECT().Put(gomock.Any(), GroupsResourcePath+"/"+groupPermissionsGroupID).Times(1).Return([]byte{}, nil),
+		client.EXPECT().GetOne(groupPermissionsGroupID, GroupsResourcePath).Times(1).Return(groupPermissionsGroupResponse, nil),
+		unmarshaller.EXPECT().Unmarshal(groupPermissionsGroupResponse).Times(1).Return(&Group{
+			ID:            groupPermissionsGroupID,
+			Name:          "group",
+			Members:       []APIMember{{UserID: "user-id"}},
+			PermissionSet: APIPermissionSetWithRoles{Permissions: []InstanaPermission{PermissionCanViewAuditLog, PermissionCanConfigureAgents, PermissionCanViewLogs}},
+		}, nil),
+		client.EXPECT().Put(gomock.Any(), GroupsResourcePath).Times(1).DoAndReturn(func(data InstanaDataObject, _ string) ([]byte, error) {
+			require.Equal(t, groupPermissionsGroupID, data.GetIDForResourcePath())
+			payload, err := json.Marshal(data)
+			require.NoError(t, err)
+			require.JSONEq(t, `{"id":"group-id","name":"group","members":[{"userId":"user-id","email":null}],"permissionSet":{"applicationIds":null,"infraDfqFilter":null,"kubernetesClusterUUIDs":null,"kubernetesNamespaceUIDs":null,"mobileAppIds":null,"websiteIds":null,"permissions":["CAN_VIEW_AUDIT_LOG","CAN_VIEW_LOGS"]}}`, string(payload))
+			return updatedGroupResponse, nil
+		}),
+		unmarshaller.EXPECT().Unmarshal(updatedGroupResponse).Times(1).Return(&Group{
+			ID:            groupPermissionsGroupID,
+			PermissionSet: APIPermissionSetWithRoles{Permissions: []InstanaPermission{PermissionCanViewAuditLog, PermissionCanViewLogs}},
+		}, nil),
+	)
+
+	sut := NewGroupPermissionsRestResource(unmarshaller, client)
+
+	result, err := sut.Update(permissions)
+
+	require.NoError(t, err)
+	require.Equal(t, &GroupPermissions{GroupID: groupPermissionsGroupID, Permissions: []InstanaPermission{PermissionCanViewLogs}}, result)
+}
+
+func TestShouldNotOverwriteConcurrentMembershipChangeWhenPermissionsAreRevoked(t *testing.T) {
+	permissions := &GroupPermissions{
+		GroupID:            groupPermissionsGroupID,
+		Permissions:        []InstanaPermission{PermissionCanViewLogs},
+		RevokedPermissions: []InstanaPermission{PermissionCanConfigureAgents},
+	}
+	var serverMutex sync.Mutex
+	serverGroup := &Group{
+		ID:            groupPermissionsGroupID,
+		Members:       []APIMember{{UserID: "user-1"}},
+		PermissionSet: APIPermissionSetWithRoles{Permissions: []InstanaPermission{PermissionCanConfigureAgents, PermissionCanViewLogs}},
+	}
+	readGroup := func() []byte {
+		serverMutex.Lock()
+		defer serverMutex.Unlock()
+		data, err := json.Marshal(serverGroup)
+		require.NoError(t, err)
+		return data
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	membershipSut := NewGroupMembershipRestResource(NewDefaultJSONUnmarshaller(&Group{}), client)
+	membershipDone := make(chan error, 1)
+	var membershipChange sync.Once
+	client.EXPECT().Put(gomock.Any(), GroupsResourcePath+"/"+groupPermissionsGroupID).AnyTimes().DoAndReturn(func(data InstanaDataObject, _ string) ([]byte, error) {
+		serverMutex.Lock()
+		defer serverMutex.Unlock()
+		if data.GetIDForResourcePath() == "users" {
+			serverGroup.Members = append(serverGroup.Members, APIMember{UserID: "user-2"})
+		}
+		return []byte{}, nil
+	})
+	client.EXPECT().GetOne(groupPermissionsGroupID, GroupsResourcePath).AnyTimes().DoAndReturn(func(_ string, _ string) ([]byte, error) {
+		data := readGroup()
+		//the membership is changed between the read and the write of the group
+		membershipChange.Do(func() {
+			go func() {
+				_, err := membershipSut.Create(&GroupMembership{GroupID: groupPermissionsGroupID, UserID: "user-2"})
+				membershipDone <- err
+			}()
+			select {
+			case err := <-membershipDone:
+				membershipDone <- err
+			case <-time.After(100 * time.Millisecond):
+			}
+		})
+		return data, nil
+	})
+	client.EXPECT().Put(gomock.Any(), GroupsResourcePath).Times(1).DoAndReturn(func(data InstanaDataObject, _ string) ([]byte, error) {
+		serverMutex.Lock()
+		defer serverMutex.Unlock()
+		serverGroup = data.(*Group)
+		return json.Marshal(serverGroup)
+	})
+
+	sut := NewGroupPermissionsRestResource(NewDefaultJSONUnmarshaller(&Group{}), client)
+
+	_, err := sut.Update(permissions)
+
+	require.NoError(t, err)
+	require.NoError(t, <-membershipDone)
+	require.Equal(t, []APIMember{{UserID: "user-1"}, {UserID: "user-2"}}, serverGroup.Members)
+	require.Equal(t, []InstanaPermission{PermissionCanViewLogs}, serverGroup.PermissionSet.Permissions)
+}
+
+func TestShouldFailToUpdateGroupPermissionsWhenPermissionsCannotBeAdded(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Put(gomock.Any(), GroupsResourcePath+"/"+groupPermissionsGroupID).Times(1).Return(nil, expectedError)
+	client.EXPECT().GetOne(gomock.Any(), gomock.Any()).Times(0)
+
+	sut := NewGroupPermissionsRestResource(mocks.NewMockJSONUnmarshaller[*Group](ctrl), client)
+
+	_, err := sut.Update(&GroupPermissions{GroupID: groupPermissionsGroupID, Permissions: []InstanaPermission{PermissionCanViewLogs}})
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldFailToUpdateGroupPermissionsWhenGroupCannotBeRetrieved(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Put(gomock.Any(), GroupsResourcePath+"/"+groupPermissionsGroupID).Times(1).Return([]byte{}, nil)
+	client.EXPECT().GetOne(groupPermissionsGroupID, GroupsResourcePath).Times(1).Return(nil, ErrEntityNotFound)
+	client.EXPECT().Put(gomock.Any(), GroupsResourcePath).Times(0)
+
+	sut := NewGroupPermissionsRestResource(mocks.NewMockJSONUnmarshaller[*Group](ctrl), client)
+
+	_, err := sut.Update(&GroupPermissions{GroupID: groupPermissionsGroupID, RevokedPermissions: []InstanaPermission{PermissionCanViewLogs}})
+
+	require.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestShouldFailToUpdateGroupPermissionsWhenRevokedPermissionsCannotBeWritten(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Put(gomock.Any(), GroupsResourcePath+"/"+groupPermissionsGroupID).Times(1).Return([]byte{}, nil)
+	client.EXPECT().GetOne(groupPermissionsGroupID, GroupsResourcePath).Times(1).Return(groupPermissionsGroupResponse, nil)
+	client.EXPECT().Put(gomock.Any(), GroupsResourcePath).Times(1).Return(nil, expectedError)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*Group](ctrl)
+	unmarshaller.EXPECT().Unmarshal(groupPermissionsGroupResponse).Times(1).Return(&Group{ID: groupPermissionsGroupID}, nil)
+
+	sut := NewGroupPermissionsRestResource(unmarshaller, client)
+
+	_, err := sut.Update(&GroupPermissions{GroupID: groupPermissionsGroupID, RevokedPermissions: []InstanaPermission{PermissionCanViewLogs}})
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldOnlyRevokeManagedPermissionsWhenGroupPermissionsAreDeleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().GetOne(groupPermissionsGroupID, GroupsResourcePath).Times(1).Return(groupPermissionsGroupResponse, nil)
+	client.EXPECT().Put(gomock.Any(), GroupsResourcePath).Times(1).DoAndReturn(func(data InstanaDataObject, _ string) ([]byte, error) {
+		require.Equal(t, []InstanaPermission{PermissionCanViewAuditLog}, data.(*Group).PermissionSet.Permissions)
+		return groupPermissionsGroupResponse, nil
+	})
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*Group](ctrl)
+	unmarshaller.EXPECT().Unmarshal(groupPermissionsGroupResponse).Times(2).Return(&Group{
+		ID:            groupPermissionsGroupID,
+		PermissionSet: APIPermissionSetWithRoles{Permissions: []InstanaPermission{PermissionCanViewAuditLog, PermissionCanConfigureAgents}},
+	}, nil)
+
+	sut := NewGroupPermissionsRestResource(unmarshaller, client)
+
+	err := sut.Delete(&GroupPermissions{GroupID: groupPermissionsGroupID, Permissions: []InstanaPermission{PermissionCanConfigureAgents}})
+
+	require.NoError(t, err)
+}
+
+func TestShouldRevokeAllPermissionsOfGroupWhenGroupPermissionsAreDeletedByID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().GetOne(groupPermissionsGroupID, GroupsResourcePath).Times(1).Return(groupPermissionsGroupResponse, nil)
+	client.EXPECT().Put(gomock.Any(), GroupsResourcePath).Times(1).DoAndReturn(func(data InstanaDataObject, _ string) ([]byte, error) {
+		require.Empty(t, data.(*Group).PermissionSet.Permissions)
+		return groupPermissionsGroupResponse, nil
+	})
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*Group](ctrl)
+	unmarshaller.EXPECT().Unmarshal(groupPermissionsGroupResponse).Times(1).Return(&Group{
+		ID:            groupPermissionsGroupID,
+		PermissionSet: APIPermissionSetWithRoles{Permissions: []InstanaPermission{PermissionCanViewAuditLog, PermissionCanConfigureAgents}},
+	}, nil)
+
+	sut := NewGroupPermissionsRestResource(unmarshaller, client)
+
+	err := sut.DeleteByID(groupPermissionsGroupID)
+
+	require.NoError(t, err)
+}
@@ -0,0 +1,58 @@
+package restapi
+
+// HistoricBaselineRefreshAware interface definition of data objects which support the refresh of the historic baseline
+// through the update-baseline endpoint of the Instana API
+type HistoricBaselineRefreshAware interface {
+	IsHistoricBaselineRefreshRequested() bool
+}
+
+type historicBaselineRefreshAwareInstanaDataObject interface {
+	HistoricBaselineRefreshAware
+	InstanaDataObject
+}
+
+// NewHistoricBaselineRefreshRestResourceAdapter creates a new RestResource instance which can be added as an adapter to
+// rest resources of data objects supporting the update-baseline endpoint of the Instana API. The historic baseline is
+// refreshed after an update when it is requested by the data object.
+func NewHistoricBaselineRefreshRestResourceAdapter[T historicBaselineRefreshAwareInstanaDataObject](resourcePath string, resource RestResource[T], client RestClient) RestResource[T] {
+	return &historicBaselineRefreshRestResourceAdapter[T]{
+		resourcePath: resourcePath,
+		resource:     resource,
+		client:       client,
+	}
+}
+
+type historicBaselineRefreshRestResourceAdapter[T historicBaselineRefreshAwareInstanaDataObject] struct {
+	resourcePath string
+	resource     RestResource[T]
+	client       RestClient
+}
+
+func (a *historicBaselineRefreshRestResourceAdapter[T]) GetAll() (*[]T, error) {
+	return a.resource.GetAll()
+}
+
+func (a *historicBaselineRefreshRestResourceAdapter[T]) GetOne(id string) (T, error) {
+	return a.resource.GetOne(id)
+}
+
+func (a *historicBaselineRefreshRestResourceAdapter[T]) Create(data T) (T, error) {
+	return a.resource.Create(data)
+}
+
+func (a *historicBaselineRefreshRestResourceAdapter[T]) Update(data T) (T, error) {
+	result, err := a.resource.Update(data)
+	if err != nil || !data.IsHistoricBaselineRefreshRequested() {
+		return result, err
+	}
+	_, err = a.client.PostByQuery(a.resourcePath+"/"+result.GetIDForResourcePath()+"/update-baseline", map[string]string{})
+	return result, err
+}
+
+func (a *historicBaselineRefreshRestResourceAdapter[T]) Delete(data T) error {
+	return a.resource.Delete(data)
+}
+
+func (a *historicBaselineRefreshRestResourceAdapter[T]) DeleteByID(id string) error {
+	return a.resource.DeleteByID(id)
+}
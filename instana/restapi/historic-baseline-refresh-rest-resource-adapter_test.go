@@ -0,0 +1,123 @@
+package restapi_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	baselineRefreshAdapterID           = "alert-config-id"
+	baselineRefreshAdapterResourcePath = "/api/test/alert-configs"
+)
+
+func TestShouldDelegateReadAndDeleteOperationsOfHistoricBaselineRefreshRestResourceAdapter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	object := &ApplicationAlertConfig{ID: baselineRefreshAdapterID}
+	resource := mocks.NewMockRestResource[*ApplicationAlertConfig](ctrl)
+	resource.EXPECT().GetOne(baselineRefreshAdapterID).Times(1).Return(object, nil)
+	resource.EXPECT().GetAll().Times(1).Return(&[]*ApplicationAlertConfig{object}, nil)
+	resource.EXPECT().Delete(object).Times(1).Return(nil)
+	resource.EXPECT().DeleteByID(baselineRefreshAdapterID).Times(1).Return(nil)
+
+	sut := NewHistoricBaselineRefreshRestResourceAdapter[*ApplicationAlertConfig](baselineRefreshAdapterResourcePath, resource, mocks.NewMockRestClient(ctrl))
+
+	one, err := sut.GetOne(baselineRefreshAdapterID)
+	require.NoError(t, err)
+	require.Equal(t, object, one)
+	all, err := sut.GetAll()
+	require.NoError(t, err)
+	require.Equal(t, &[]*ApplicationAlertConfig{object}, all)
+	require.NoError(t, sut.Delete(object))
+	require.NoError(t, sut.DeleteByID(baselineRefreshAdapterID))
+}
+
+func TestShouldNotRefreshHistoricBaselineOnCreate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	input := &ApplicationAlertConfig{RefreshBaseline: true}
+	expected := &ApplicationAlertConfig{ID: baselineRefreshAdapterID}
+	resource := mocks.NewMockRestResource[*ApplicationAlertConfig](ctrl)
+	resource.EXPECT().Create(input).Times(1).Return(expected, nil)
+
+	sut := NewHistoricBaselineRefreshRestResourceAdapter[*ApplicationAlertConfig](baselineRefreshAdapterResourcePath, resource, mocks.NewMockRestClient(ctrl))
+
+	result, err := sut.Create(input)
+
+	require.NoError(t, err)
+	require.Equal(t, expected, result)
+}
+
+func TestShouldNotRefreshHistoricBaselineOnUpdateWhenRefreshIsNotRequested(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	input := &ApplicationAlertConfig{ID: baselineRefreshAdapterID}
+	resource := mocks.NewMockRestResource[*ApplicationAlertConfig](ctrl)
+	resource.EXPECT().Update(input).Times(1).Return(input, nil)
+
+	sut := NewHistoricBaselineRefreshRestResourceAdapter[*ApplicationAlertConfig](baselineRefreshAdapterResourcePath, resource, mocks.NewMockRestClient(ctrl))
+
+	result, err := sut.Update(input)
+
+	require.NoError(t, err)
+	require.Equal(t, input, result)
+}
+
+func TestShouldRefreshHistoricBaselineOnUpdateWhenRefreshIsRequested(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	input := &ApplicationAlertConfig{ID: baselineRefreshAdapterID, RefreshBaseline: true}
+	expected := &ApplicationAlertConfig{ID: baselineRefreshAdapterID}
+	resource := mocks.NewMockRestResource[*ApplicationAlertConfig](ctrl)
+	resource.EXPECT().Update(input).Times(1).Return(expected, nil)
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().PostByQuery(baselineRefreshAdapterResourcePath+"/"+baselineRefreshAdapterID+"/update-baseline", map[string]string{}).Times(1).Return([]byte{}, nil)
+
+	sut := NewHistoricBaselineRefreshRestResourceAdapter[*ApplicationAlertConfig](baselineRefreshAdapterResourcePath, resource, client)
+
+	result, err := sut.Update(input)
+
+	require.NoError(t, err)
+	require.Equal(t, expected, result)
+}
+
+func TestShouldReturnErrorOfUpdateWithoutRefreshingHistoricBaseline(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedError := errors.New("test")
+	resource := mocks.NewMockRestResource[*ApplicationAlertConfig](ctrl)
+	resource.EXPECT().Update(gomock.Any()).Times(1).Return(nil, expectedError)
+
+	sut := NewHistoricBaselineRefreshRestResourceAdapter[*ApplicationAlertConfig](baselineRefreshAdapterResourcePath, resource, mocks.NewMockRestClient(ctrl))
+
+	_, err := sut.Update(&ApplicationAlertConfig{ID: baselineRefreshAdapterID, RefreshBaseline: true})
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldReturnErrorWhenHistoricBaselineCannotBeRefreshed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedError := errors.New("test")
+	resource := mocks.NewMockRestResource[*ApplicationAlertConfig](ctrl)
+	resource.EXPECT().Update(gomock.Any()).Times(1).Return(&ApplicationAlertConfig{ID: baselineRefreshAdapterID}, nil)
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().PostByQuery(gomock.Any(), gomock.Any()).Times(1).Return(nil, expectedError)
+
+	sut := NewHistoricBaselineRefreshRestResourceAdapter[*ApplicationAlertConfig](baselineRefreshAdapterResourcePath, resource, client)
+
+	_, err := sut.Update(&ApplicationAlertConfig{ID: baselineRefreshAdapterID, RefreshBaseline: true})
+
+	require.ErrorIs(t, err, expectedError)
+}
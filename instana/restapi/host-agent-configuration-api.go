@@ -0,0 +1,40 @@
+package restapi
+
+const (
+	//HostAgentResourcePath path to the host agent resource of Instana RESTful API
+	HostAgentResourcePath = InstanaAPIBasePath + "/host-agent"
+	//HostAgentConfigurationResourcePath path to the configuration resource of the host agents selected by query
+	HostAgentConfigurationResourcePath = HostAgentResourcePath + hostAgentConfigurationPathElement
+
+	hostAgentConfigurationPathElement = "/configuration"
+	hostAgentConfigurationQueryParam  = "query"
+)
+
+// HostAgentConfiguration is the representation of the configuration management settings of host agents. The host
+// agents are either selected by the host id or by a dynamic focus query. When neither is defined the configuration
+// applies to all host agents. The agents pull their configuration from the configured git remote.
+type HostAgentConfiguration struct {
+	ID           string
+	HostID       *string
+	Query        *string
+	RemoteURI    string
+	RemoteName   *string
+	RemoteBranch *string
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (c *HostAgentConfiguration) GetIDForResourcePath() string {
+	return c.ID
+}
+
+// agentConfigurationUpdate is the payload to update the configuration management settings of host agents
+type agentConfigurationUpdate struct {
+	RemoteURI    string  `json:"remoteUri"`
+	RemoteName   *string `json:"remoteName,omitempty"`
+	RemoteBranch *string `json:"remoteBranch,omitempty"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (u *agentConfigurationUpdate) GetIDForResourcePath() string {
+	return ""
+}
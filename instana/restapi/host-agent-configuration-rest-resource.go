@@ -0,0 +1,57 @@
+package restapi
+
+import "errors"
+
+// NewHostAgentConfigurationRestResource creates a new REST resource for the configuration management settings of host
+// agents. The Instana API only supports updating the settings; they cannot be read back. Therefore, read operations
+// are not supported and deleting the settings only stops managing them; the agents keep their current settings.
+func NewHostAgentConfigurationRestResource(client RestClient) RestResource[*HostAgentConfiguration] {
+	return &hostAgentConfigurationRestResource{
+		client: client,
+	}
+}
+
+var errHostAgentConfigurationReadNotSupported = errors.New("reading host agent configurations is not supported by the Instana API")
+
+type hostAgentConfigurationRestResource struct {
+	client RestClient
+}
+
+func (r *hostAgentConfigurationRestResource) GetAll() (*[]*HostAgentConfiguration, error) {
+	return nil, errHostAgentConfigurationReadNotSupported
+}
+
+func (r *hostAgentConfigurationRestResource) GetOne(_ string) (*HostAgentConfiguration, error) {
+	return nil, errHostAgentConfigurationReadNotSupported
+}
+
+func (r *hostAgentConfigurationRestResource) Create(data *HostAgentConfiguration) (*HostAgentConfiguration, error) {
+	return r.Update(data)
+}
+
+func (r *hostAgentConfigurationRestResource) Update(data *HostAgentConfiguration) (*HostAgentConfiguration, error) {
+	payload := &agentConfigurationUpdate{
+		RemoteURI:    data.RemoteURI,
+		RemoteName:   data.RemoteName,
+		RemoteBranch: data.RemoteBranch,
+	}
+	var err error
+	if data.HostID != nil {
+		_, err = r.client.Post(payload, HostAgentResourcePath+"/"+*data.HostID+hostAgentConfigurationPathElement)
+	} else {
+		queryParams := map[string]string{}
+		if data.Query != nil {
+			queryParams[hostAgentConfigurationQueryParam] = *data.Query
+		}
+		_, err = r.client.PostWithQuery(payload, HostAgentConfigurationResourcePath, queryParams)
+	}
+	return data, err
+}
+
+func (r *hostAgentConfigurationRestResource) Delete(data *HostAgentConfiguration) error {
+	return r.DeleteByID(data.GetIDForResourcePath())
+}
+
+func (r *hostAgentConfigurationRestResource) DeleteByID(_ string) error {
+	return nil
+}
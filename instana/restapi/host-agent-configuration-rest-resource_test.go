@@ -0,0 +1,124 @@
+package restapi_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	hostAgentConfigurationID        = "host-agent-configuration-id"
+	hostAgentConfigurationHostID    = "host-id"
+	hostAgentConfigurationQuery     = "entity.zone:production"
+	hostAgentConfigurationRemoteURI = "https://git.example.com/agent-config.git"
+)
+
+func TestShouldPostHostAgentConfigurationForHostWhenHostIDIsDefined(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	hostID := hostAgentConfigurationHostID
+	branch := "main"
+	data := &HostAgentConfiguration{ID: hostAgentConfigurationID, HostID: &hostID, RemoteURI: hostAgentConfigurationRemoteURI, RemoteBranch: &branch}
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Post(gomock.Any(), HostAgentResourcePath+"/"+hostAgentConfigurationHostID+"/configuration").Times(1).DoAndReturn(func(payload InstanaDataObject, _ string) ([]byte, error) {
+		serialized, err := json.Marshal(payload)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"remoteUri":"`+hostAgentConfigurationRemoteURI+`","remoteBranch":"main"}`, string(serialized))
+		return []byte{}, nil
+	})
+
+	sut := NewHostAgentConfigurationRestResource(client)
+
+	result, err := sut.Create(data)
+
+	require.NoError(t, err)
+	require.Equal(t, data, result)
+}
+
+func TestShouldPostHostAgentConfigurationByQueryWhenQueryIsDefined(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	query := hostAgentConfigurationQuery
+	remoteName := "origin"
+	data := &HostAgentConfiguration{ID: hostAgentConfigurationID, Query: &query, RemoteURI: hostAgentConfigurationRemoteURI, RemoteName: &remoteName}
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().PostWithQuery(gomock.Any(), HostAgentConfigurationResourcePath, map[string]string{"query": hostAgentConfigurationQuery}).Times(1).DoAndReturn(func(payload InstanaDataObject, _ string, _ map[string]string) ([]byte, error) {
+		serialized, err := json.Marshal(payload)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"remoteUri":"`+hostAgentConfigurationRemoteURI+`","remoteName":"origin"}`, string(serialized))
+		return []byte{}, nil
+	})
+
+	sut := NewHostAgentConfigurationRestResource(client)
+
+	result, err := sut.Update(data)
+
+	require.NoError(t, err)
+	require.Equal(t, data, result)
+}
+
+func TestShouldPostHostAgentConfigurationForAllAgentsWhenNoSelectorIsDefined(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	data := &HostAgentConfiguration{ID: hostAgentConfigurationID, RemoteURI: hostAgentConfigurationRemoteURI}
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().PostWithQuery(gomock.Any(), HostAgentConfigurationResourcePath, map[string]string{}).Times(1).Return([]byte{}, nil)
+
+	sut := NewHostAgentConfigurationRestResource(client)
+
+	_, err := sut.Create(data)
+
+	require.NoError(t, err)
+}
+
+func TestShouldReturnErrorWhenHostAgentConfigurationCannotBePosted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedError := errors.New("test")
+	data := &HostAgentConfiguration{ID: hostAgentConfigurationID, RemoteURI: hostAgentConfigurationRemoteURI}
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().PostWithQuery(gomock.Any(), HostAgentConfigurationResourcePath, gomock.Any()).Times(1).Return(nil, expectedError)
+
+	sut := NewHostAgentConfigurationRestResource(client)
+
+	_, err := sut.Create(data)
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldReturnErrorWhenReadingHostAgentConfigurations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sut := NewHostAgentConfigurationRestResource(mocks.NewMockRestClient(ctrl))
+
+	_, err := sut.GetOne(hostAgentConfigurationID)
+	require.Error(t, err)
+
+	_, err = sut.GetAll()
+	require.Error(t, err)
+}
+
+func TestShouldNotCallInstanaAPIWhenHostAgentConfigurationIsDeleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sut := NewHostAgentConfigurationRestResource(mocks.NewMockRestClient(ctrl))
+
+	err := sut.Delete(&HostAgentConfiguration{ID: hostAgentConfigurationID})
+
+	require.NoError(t, err)
+}
@@ -0,0 +1,57 @@
+package restapi
+
+// HttpEndpointConfigsResourcePath path to http endpoint config resource of Instana RESTful API
+const HttpEndpointConfigsResourcePath = ApplicationMonitoringSettingsBasePath + "/http-endpoint"
+
+// HttpPathSegmentMatchingRuleType custom type for the type of http path segment matching rules
+type HttpPathSegmentMatchingRuleType string
+
+// HttpPathSegmentMatchingRuleTypes custom type for a slice of HttpPathSegmentMatchingRuleType
+type HttpPathSegmentMatchingRuleTypes []HttpPathSegmentMatchingRuleType
+
+// ToStringSlice Returns the corresponding string representations
+func (types HttpPathSegmentMatchingRuleTypes) ToStringSlice() []string {
+	result := make([]string, len(types))
+	for i, v := range types {
+		result[i] = string(v)
+	}
+	return result
+}
+
+const (
+	//HttpPathSegmentMatchingRuleTypeFixed constant value for the http path segment matching rule type FIXED
+	HttpPathSegmentMatchingRuleTypeFixed = HttpPathSegmentMatchingRuleType("FIXED")
+	//HttpPathSegmentMatchingRuleTypeParameter constant value for the http path segment matching rule type PARAMETER
+	HttpPathSegmentMatchingRuleTypeParameter = HttpPathSegmentMatchingRuleType("PARAMETER")
+	//HttpPathSegmentMatchingRuleTypeMatchAll constant value for the http path segment matching rule type MATCH_ALL
+	HttpPathSegmentMatchingRuleTypeMatchAll = HttpPathSegmentMatchingRuleType("MATCH_ALL")
+)
+
+// SupportedHttpPathSegmentMatchingRuleTypes list of all supported HttpPathSegmentMatchingRuleType
+var SupportedHttpPathSegmentMatchingRuleTypes = HttpPathSegmentMatchingRuleTypes{HttpPathSegmentMatchingRuleTypeFixed, HttpPathSegmentMatchingRuleTypeParameter, HttpPathSegmentMatchingRuleTypeMatchAll}
+
+// HttpPathSegmentMatchingRule is the representation of a single path segment of a http endpoint rule in Instana
+type HttpPathSegmentMatchingRule struct {
+	Type HttpPathSegmentMatchingRuleType `json:"type"`
+	Name *string                         `json:"name,omitempty"`
+}
+
+// HttpEndpointRule is the representation of a http endpoint rule in Instana
+type HttpEndpointRule struct {
+	Enabled      bool                          `json:"enabled"`
+	PathSegments []HttpPathSegmentMatchingRule `json:"pathSegments"`
+	TestCases    []string                      `json:"testCases"`
+}
+
+// HttpEndpointConfig is the representation of the http endpoint configuration of a service in Instana
+type HttpEndpointConfig struct {
+	ServiceID                                      string             `json:"serviceId"`
+	EndpointNameByCollectedPathTemplateRuleEnabled bool               `json:"endpointNameByCollectedPathTemplateRuleEnabled"`
+	EndpointNameByFirstPathSegmentRuleEnabled      bool               `json:"endpointNameByFirstPathSegmentRuleEnabled"`
+	Rules                                          []HttpEndpointRule `json:"rules"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (c *HttpEndpointConfig) GetIDForResourcePath() string {
+	return c.ServiceID
+}
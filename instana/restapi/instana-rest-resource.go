@@ -25,6 +25,18 @@ type ReadOnlyRestResource[T InstanaDataObject] interface {
 	GetOne(id string) (T, error)
 }
 
+// ApdexReportRestResource interface definition of the read only REST resource to request the apdex reports of an apdex
+// configuration for a given time window. The Instana API returns a list of reports for the requested time window.
+type ApdexReportRestResource interface {
+	GetReport(apdexID string, from int64, to int64) (*[]*ApdexReport, error)
+}
+
+// AlertConfigVersionRestResource interface definition of the read only REST resource to request the versions of an
+// alert configuration
+type AlertConfigVersionRestResource interface {
+	GetVersions(alertConfigID string) (*[]*AlertConfigVersion, error)
+}
+
 // JSONUnmarshaller interface definition for unmarshalling that unmarshalls JSON to go data structures
 type JSONUnmarshaller[T any] interface {
 	//Unmarshal converts the provided json bytes into the go data structure as provided in the target
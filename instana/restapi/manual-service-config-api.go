@@ -0,0 +1,20 @@
+package restapi
+
+// ManualServiceConfigsResourcePath path to manual service config resource of Instana RESTful API
+const ManualServiceConfigsResourcePath = ApplicationMonitoringSettingsBasePath + "/manual-service"
+
+// ManualServiceConfig is the representation of a manual service mapping in Instana. Calls matching the tag filter
+// expression are either mapped to an existing service or to a new unmonitored service.
+type ManualServiceConfig struct {
+	ID                     string     `json:"id"`
+	Description            *string    `json:"description"`
+	Enabled                bool       `json:"enabled"`
+	ExistingServiceID      *string    `json:"existingServiceId"`
+	UnmonitoredServiceName *string    `json:"unmonitoredServiceName"`
+	TagFilterExpression    *TagFilter `json:"tagFilterExpression"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (c *ManualServiceConfig) GetIDForResourcePath() string {
+	return c.ID
+}
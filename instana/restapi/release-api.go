@@ -0,0 +1,34 @@
+package restapi
+
+// ReleasesResourcePath path to the releases resource of Instana RESTful API
+const ReleasesResourcePath = InstanaAPIBasePath + "/releases"
+
+// ReleaseApplicationScope data structure of an application to which a release is scoped
+type ReleaseApplicationScope struct {
+	Name string `json:"name"`
+}
+
+// ReleaseServiceScopedTo data structure of the applications to which the service scope of a release is limited
+type ReleaseServiceScopedTo struct {
+	Applications []ReleaseApplicationScope `json:"applications"`
+}
+
+// ReleaseServiceScope data structure of a service to which a release is scoped
+type ReleaseServiceScope struct {
+	Name     string                  `json:"name"`
+	ScopedTo *ReleaseServiceScopedTo `json:"scopedTo,omitempty"`
+}
+
+// Release data structure of a release (deployment marker) of the Instana API
+type Release struct {
+	ID           string                    `json:"id,omitempty"`
+	Name         string                    `json:"name"`
+	Start        int64                     `json:"start"`
+	Applications []ReleaseApplicationScope `json:"applications"`
+	Services     []ReleaseServiceScope     `json:"services"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (r *Release) GetIDForResourcePath() string {
+	return r.ID
+}
@@ -22,10 +22,13 @@ const encodingApplicationJSON = "application/json; charset=utf-8"
 type RestClient interface {
 	Get(resourcePath string) ([]byte, error)
 	GetOne(id string, resourcePath string) ([]byte, error)
+	GetByQuery(resourcePath string, queryParams map[string]string) ([]byte, error)
 	Post(data InstanaDataObject, resourcePath string) ([]byte, error)
 	PostWithID(data InstanaDataObject, resourcePath string) ([]byte, error)
+	PostWithQuery(data InstanaDataObject, resourcePath string, queryParams map[string]string) ([]byte, error)
 	Put(data InstanaDataObject, resourcePath string) ([]byte, error)
 	Delete(resourceID string, resourceBasePath string) error
+	DeleteByQuery(resourcePath string, queryParams map[string]string) error
 	PostByQuery(resourcePath string, queryParams map[string]string) ([]byte, error)
 	PutByQuery(resourcePath string, is string, queryParams map[string]string) ([]byte, error)
 }
@@ -88,6 +91,14 @@ func (client *restClientImpl) GetOne(id string, resourcePath string) ([]byte, er
 	return client.executeRequest(resty.MethodGet, url, req)
 }
 
+// GetByQuery request data via HTTP GET for the given resourcePath and query parameters
+func (client *restClientImpl) GetByQuery(resourcePath string, queryParams map[string]string) ([]byte, error) {
+	url := client.buildURL(resourcePath)
+	req := client.createRequest()
+	client.appendQueryParameters(req, queryParams)
+	return client.executeRequest(resty.MethodGet, url, req)
+}
+
 // Post executes a HTTP PUT request to create or update the given resource
 func (client *restClientImpl) Post(data InstanaDataObject, resourcePath string) ([]byte, error) {
 	url := client.buildURL(resourcePath)
@@ -102,6 +113,14 @@ func (client *restClientImpl) PostWithID(data InstanaDataObject, resourcePath st
 	return client.executeRequestWithThrottling(resty.MethodPost, url, req)
 }
 
+// PostWithQuery executes a HTTP POST request with the given resource as payload and the given query parameters
+func (client *restClientImpl) PostWithQuery(data InstanaDataObject, resourcePath string, queryParams map[string]string) ([]byte, error) {
+	url := client.buildURL(resourcePath)
+	req := client.createRequest().SetHeader(contentTypeHeader, encodingApplicationJSON).SetBody(data)
+	client.appendQueryParameters(req, queryParams)
+	return client.executeRequestWithThrottling(resty.MethodPost, url, req)
+}
+
 // Put executes a HTTP PUT request to create or update the given resource
 func (client *restClientImpl) Put(data InstanaDataObject, resourcePath string) ([]byte, error) {
 	url := client.buildResourceURL(resourcePath, data.GetIDForResourcePath())
@@ -117,6 +136,15 @@ func (client *restClientImpl) Delete(resourceID string, resourceBasePath string)
 	return err
 }
 
+// DeleteByQuery executes a HTTP DELETE request to delete the resource identified by the given query parameters
+func (client *restClientImpl) DeleteByQuery(resourcePath string, queryParams map[string]string) error {
+	url := client.buildURL(resourcePath)
+	req := client.createRequest()
+	client.appendQueryParameters(req, queryParams)
+	_, err := client.executeRequestWithThrottling(resty.MethodDelete, url, req)
+	return err
+}
+
 // PostByQuery executes a HTTP POST request to create the resource by providing the data a query parameters
 func (client *restClientImpl) PostByQuery(resourcePath string, queryParams map[string]string) ([]byte, error) {
 	url := client.buildURL(resourcePath)
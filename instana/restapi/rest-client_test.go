@@ -88,6 +88,42 @@ func TestShouldReturnNotFoundErrorMessageForGetOneRequestWhenStatusIsNotEntityNo
 	verifyNotFoundResponse(data, err, t)
 }
 
+func TestShouldReturnDataForSuccessfulGetByQueryRequestWhenNoQueryParametersAreProvided(t *testing.T) {
+	queryParameters := map[string]string{}
+	shouldReturnDataForSuccessfulGetByQueryRequest(t, queryParameters)
+}
+
+func TestShouldReturnDataForSuccessfulGetByQueryRequestWhenQueryParametersAreProvided(t *testing.T) {
+	queryParameters := map[string]string{
+		"a": "b",
+		"c": "d",
+	}
+	shouldReturnDataForSuccessfulGetByQueryRequest(t, queryParameters)
+}
+
+func shouldReturnDataForSuccessfulGetByQueryRequest(t *testing.T, queryParameters map[string]string) {
+	httpServer := setupAndStartHttpServerWithQueryParamerterCheck(http.MethodGet, testPath, queryParameters, http.StatusOK)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	response, err := restClient.GetByQuery(testPath, queryParameters)
+
+	verifySuccessResponseData(response, err, t)
+}
+
+func TestShouldReturnNotFoundErrorMessageForGetByQueryRequestWhenStatusIsNotEntityNotFound(t *testing.T) {
+	queryParameters := map[string]string{
+		"a": "b",
+	}
+	httpServer := setupAndStartHttpServerWithQueryParamerterCheck(http.MethodGet, testPath, queryParameters, http.StatusNotFound)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	data, err := restClient.GetByQuery(testPath, queryParameters)
+
+	verifyNotFoundResponse(data, err, t)
+}
+
 func TestShouldReturnDataForSuccessfulPostRequest(t *testing.T) {
 	httpServer := setupAndStartHttpServerWithOKResponseCode(http.MethodPost, testPath)
 	defer httpServer.Close()
@@ -130,6 +166,33 @@ func TestShouldReturnErrorMessageForPostWithIDRequestWhenStatusIsNotASuccessStat
 	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
 }
 
+func TestShouldReturnDataForSuccessfulPostWithQueryRequest(t *testing.T) {
+	queryParameters := map[string]string{
+		"a": "b",
+	}
+	httpServer := setupAndStartHttpServerWithQueryParamerterCheck(http.MethodPost, testPath, queryParameters, http.StatusOK)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	response, err := restClient.PostWithQuery(testDataObject{id: testID}, testPath, queryParameters)
+
+	verifySuccessResponseData(response, err, t)
+}
+
+func TestShouldReturnErrorMessageForPostWithQueryRequestWhenStatusIsNotASuccessStatusAndNotEntityNotFound(t *testing.T) {
+	queryParameters := map[string]string{
+		"a": "b",
+	}
+	statusCode := http.StatusBadRequest
+	httpServer := setupAndStartHttpServerWithQueryParamerterCheck(http.MethodPost, testPath, queryParameters, statusCode)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	_, err := restClient.PostWithQuery(testDataObject{id: testID}, testPath, queryParameters)
+
+	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
+}
+
 func TestShouldReturnDataForSuccessfulPutRequest(t *testing.T) {
 	httpServer := setupAndStartHttpServerWithOKResponseCode(http.MethodPut, testPathWithID)
 	defer httpServer.Close()
@@ -272,6 +335,33 @@ func TestShouldReturnErrorMessageForDeleteRequestWhenStatusIsNotASuccessStatusAn
 	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
 }
 
+func TestShouldReturnNothingForSuccessfulDeleteByQueryRequest(t *testing.T) {
+	queryParameters := map[string]string{
+		"a": "b",
+	}
+	httpServer := setupAndStartHttpServerWithQueryParamerterCheck(http.MethodDelete, testPath, queryParameters, http.StatusOK)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	err := restClient.DeleteByQuery(testPath, queryParameters)
+
+	require.Nil(t, err)
+}
+
+func TestShouldReturnErrorMessageForDeleteByQueryRequestWhenStatusIsNotASuccessStatusAndNotEntityNotFound(t *testing.T) {
+	queryParameters := map[string]string{
+		"a": "b",
+	}
+	statusCode := http.StatusBadRequest
+	httpServer := setupAndStartHttpServerWithQueryParamerterCheck(http.MethodDelete, testPath, queryParameters, statusCode)
+	defer httpServer.Close()
+
+	restClient := createSut(httpServer)
+	err := restClient.DeleteByQuery(testPath, queryParameters)
+
+	verifyFailedCallWithStatusCodeIsResponse(err, statusCode, t)
+}
+
 func setupAndStartHttpServerWithOKResponseCode(httpMethod string, fullPath string) testutils.TestHTTPServer {
 	return setupAndStartHttpServer(httpMethod, fullPath, 200)
 }
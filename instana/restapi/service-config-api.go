@@ -0,0 +1,50 @@
+package restapi
+
+import "encoding/json"
+
+const (
+	//ServiceConfigsResourcePath path to service config resource of Instana RESTful API
+	ServiceConfigsResourcePath = ApplicationMonitoringSettingsBasePath + "/service"
+	//ServiceConfigOrderID the static ID of the service config order. It is used as the path element of the order endpoint of the service configs
+	ServiceConfigOrderID = "order"
+)
+
+// ServiceMatchingRule is the representation of a key/value match specification of a service config in Instana
+type ServiceMatchingRule struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// ServiceConfig is the representation of a service mapping rule in Instana
+type ServiceConfig struct {
+	ID                 string                `json:"id"`
+	Name               string                `json:"name"`
+	Label              string                `json:"label"`
+	Comment            *string               `json:"comment"`
+	Enabled            bool                  `json:"enabled"`
+	MatchSpecification []ServiceMatchingRule `json:"matchSpecification"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (c *ServiceConfig) GetIDForResourcePath() string {
+	return c.ID
+}
+
+// ServiceConfigOrder is the representation of the order in which the service configs are evaluated by Instana
+type ServiceConfigOrder struct {
+	ServiceConfigIDs []string
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (o *ServiceConfigOrder) GetIDForResourcePath() string {
+	return ServiceConfigOrderID
+}
+
+// MarshalJSON custom json marshaller for ServiceConfigOrder as the Instana API expects the plain list of ids
+func (o *ServiceConfigOrder) MarshalJSON() ([]byte, error) {
+	ids := o.ServiceConfigIDs
+	if ids == nil {
+		ids = []string{}
+	}
+	return json.Marshal(ids)
+}
@@ -0,0 +1,62 @@
+package restapi
+
+// NewServiceConfigOrderRestResource creates a new REST resource for the order of the service configs. The order is
+// read from the list of service configs and updated via the order endpoint of the service configs. The order
+// cannot be deleted; a delete operation is a no-op.
+func NewServiceConfigOrderRestResource(unmarshaller JSONUnmarshaller[*ServiceConfig], client RestClient) RestResource[*ServiceConfigOrder] {
+	return &serviceConfigOrderRestResource{
+		resourcePath: ServiceConfigsResourcePath,
+		unmarshaller: unmarshaller,
+		client:       client,
+	}
+}
+
+type serviceConfigOrderRestResource struct {
+	resourcePath string
+	unmarshaller JSONUnmarshaller[*ServiceConfig]
+	client       RestClient
+}
+
+func (r *serviceConfigOrderRestResource) GetAll() (*[]*ServiceConfigOrder, error) {
+	order, err := r.GetOne(ServiceConfigOrderID)
+	if err != nil {
+		return nil, err
+	}
+	return &[]*ServiceConfigOrder{order}, nil
+}
+
+func (r *serviceConfigOrderRestResource) GetOne(_ string) (*ServiceConfigOrder, error) {
+	data, err := r.client.Get(r.resourcePath)
+	if err != nil {
+		return nil, err
+	}
+	serviceConfigs, err := r.unmarshaller.UnmarshalArray(data)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, len(*serviceConfigs))
+	for i, c := range *serviceConfigs {
+		ids[i] = c.ID
+	}
+	return &ServiceConfigOrder{ServiceConfigIDs: ids}, nil
+}
+
+func (r *serviceConfigOrderRestResource) Create(data *ServiceConfigOrder) (*ServiceConfigOrder, error) {
+	return r.Update(data)
+}
+
+func (r *serviceConfigOrderRestResource) Update(data *ServiceConfigOrder) (*ServiceConfigOrder, error) {
+	_, err := r.client.Put(data, r.resourcePath)
+	if err != nil {
+		return data, err
+	}
+	return r.GetOne(data.GetIDForResourcePath())
+}
+
+func (r *serviceConfigOrderRestResource) Delete(_ *ServiceConfigOrder) error {
+	return nil
+}
+
+func (r *serviceConfigOrderRestResource) DeleteByID(_ string) error {
+	return nil
+}
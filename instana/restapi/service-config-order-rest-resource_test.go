@@ -0,0 +1,94 @@
+package restapi_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestShouldGetServiceConfigOrderFromListOfServiceConfigs(t *testing.T) {
+	serviceConfigs := []*ServiceConfig{{ID: "id1"}, {ID: "id2"}}
+	restResponseData := []byte("server-response")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Get(ServiceConfigsResourcePath).Times(1).Return(restResponseData, nil)
+	jsonUnmarshaller := mocks.NewMockJSONUnmarshaller[*ServiceConfig](ctrl)
+	jsonUnmarshaller.EXPECT().UnmarshalArray(restResponseData).Times(1).Return(&serviceConfigs, nil)
+
+	sut := NewServiceConfigOrderRestResource(jsonUnmarshaller, restClient)
+
+	result, err := sut.GetOne(ServiceConfigOrderID)
+
+	require.NoError(t, err)
+	require.Equal(t, &ServiceConfigOrder{ServiceConfigIDs: []string{"id1", "id2"}}, result)
+}
+
+func TestShouldFailToGetServiceConfigOrderWhenClientReturnsError(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Get(ServiceConfigsResourcePath).Times(1).Return(nil, expectedError)
+	jsonUnmarshaller := mocks.NewMockJSONUnmarshaller[*ServiceConfig](ctrl)
+
+	sut := NewServiceConfigOrderRestResource(jsonUnmarshaller, restClient)
+
+	_, err := sut.GetOne(ServiceConfigOrderID)
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldUpdateServiceConfigOrderViaOrderEndpointAndReadUpdatedOrder(t *testing.T) {
+	order := &ServiceConfigOrder{ServiceConfigIDs: []string{"id2", "id1"}}
+	serviceConfigs := []*ServiceConfig{{ID: "id2"}, {ID: "id1"}}
+	restResponseData := []byte("server-response")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	restClient.EXPECT().Put(order, ServiceConfigsResourcePath).Times(2).Return([]byte{}, nil)
+	restClient.EXPECT().Get(ServiceConfigsResourcePath).Times(2).Return(restResponseData, nil)
+	jsonUnmarshaller := mocks.NewMockJSONUnmarshaller[*ServiceConfig](ctrl)
+	jsonUnmarshaller.EXPECT().UnmarshalArray(restResponseData).Times(2).Return(&serviceConfigs, nil)
+
+	sut := NewServiceConfigOrderRestResource(jsonUnmarshaller, restClient)
+
+	created, err := sut.Create(order)
+	require.NoError(t, err)
+	require.Equal(t, order, created)
+
+	updated, err := sut.Update(order)
+	require.NoError(t, err)
+	require.Equal(t, order, updated)
+}
+
+func TestShouldNotCallApiWhenDeletingServiceConfigOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	restClient := mocks.NewMockRestClient(ctrl)
+	jsonUnmarshaller := mocks.NewMockJSONUnmarshaller[*ServiceConfig](ctrl)
+
+	sut := NewServiceConfigOrderRestResource(jsonUnmarshaller, restClient)
+
+	require.NoError(t, sut.Delete(&ServiceConfigOrder{}))
+	require.NoError(t, sut.DeleteByID(ServiceConfigOrderID))
+}
+
+func TestShouldMarshalServiceConfigOrderAsPlainListOfIDs(t *testing.T) {
+	result, err := json.Marshal(&ServiceConfigOrder{ServiceConfigIDs: []string{"id1", "id2"}})
+
+	require.NoError(t, err)
+	require.Equal(t, `["id1","id2"]`, string(result))
+}
@@ -18,6 +18,26 @@ type SyntheticTestConfig struct {
 	ExpectMatch      *string                `json:"expectMatch"`
 	// HttpScript
 	Script *string `json:"script"`
+	// BrowserScript, WebpageAction and WebpageScript
+	Browser     *string                   `json:"browser"`
+	RecordVideo *bool                     `json:"recordVideo"`
+	ScriptType  *string                   `json:"scriptType"`
+	Scripts     *SyntheticTestScriptFiles `json:"scripts"`
+	// DNSAction
+	Lookup           *string `json:"lookup"`
+	Server           *string `json:"server"`
+	QueryType        *string `json:"queryType"`
+	Port             *int32  `json:"port"`
+	AcceptCNAME      *bool   `json:"acceptCNAME"`
+	LookupServerName *bool   `json:"lookupServerName"`
+	RecursiveLookups *bool   `json:"recursiveLookups"`
+	ServerRetries    *int32  `json:"serverRetries"`
+}
+
+// SyntheticTestScriptFiles data structure of the multiple script files of a synthetic test of type BrowserScript
+type SyntheticTestScriptFiles struct {
+	Bundle     *string `json:"bundle"`
+	ScriptFile *string `json:"scriptFile"`
 }
 
 type SyntheticTest struct {
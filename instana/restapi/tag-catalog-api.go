@@ -0,0 +1,44 @@
+package restapi
+
+const (
+	//catalogTagsPathElement path element to the tag catalog
+	catalogTagsPathElement = "/catalog/tags"
+	//ApplicationTagCatalogResourcePath path to the application monitoring tag catalog of Instana RESTful API
+	ApplicationTagCatalogResourcePath = ApplicationMonitoringBasePath + catalogTagsPathElement
+	//WebsiteTagCatalogResourcePath path to the website monitoring tag catalog of Instana RESTful API
+	WebsiteTagCatalogResourcePath = WebsiteMonitoringResourcePath + catalogTagsPathElement
+)
+
+// TagType custom type for the data type of tag of the Instana tag catalog
+type TagType string
+
+const (
+	//TagTypeBoolean constant value for tags of type BOOLEAN
+	TagTypeBoolean = TagType("BOOLEAN")
+	//TagTypeString constant value for tags of type STRING
+	TagTypeString = TagType("STRING")
+	//TagTypeNumber constant value for tags of type NUMBER
+	TagTypeNumber = TagType("NUMBER")
+	//TagTypeStringSet constant value for tags of type STRING_SET
+	TagTypeStringSet = TagType("STRING_SET")
+	//TagTypeStringList constant value for tags of type STRING_LIST
+	TagTypeStringList = TagType("STRING_LIST")
+	//TagTypeKeyValuePair constant value for tags of type KEY_VALUE_PAIR
+	TagTypeKeyValuePair = TagType("KEY_VALUE_PAIR")
+)
+
+// Tag is the representation of a tag of the Instana tag catalog
+type Tag struct {
+	Name                  string  `json:"name"`
+	Label                 string  `json:"label"`
+	Description           string  `json:"description"`
+	Type                  TagType `json:"type"`
+	CanApplyToSource      bool    `json:"canApplyToSource"`
+	CanApplyToDestination bool    `json:"canApplyToDestination"`
+	IDTag                 bool    `json:"idTag"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (t *Tag) GetIDForResourcePath() string {
+	return t.Name
+}
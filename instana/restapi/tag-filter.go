@@ -1,6 +1,7 @@
 package restapi
 
 import (
+	"encoding/json"
 	"fmt"
 )
 
@@ -85,6 +86,18 @@ func NewNumberTagFilter(entity TagFilterEntity, name string, operator Expression
 	}
 }
 
+// NewFloatTagFilter creates a new TagFilter for comparing floating point values
+func NewFloatTagFilter(entity TagFilterEntity, name string, operator ExpressionOperator, value float64) *TagFilter {
+	return &TagFilter{
+		Entity:     &entity,
+		Name:       &name,
+		Operator:   &operator,
+		FloatValue: &value,
+		Value:      value,
+		Type:       TagFilterType,
+	}
+}
+
 // NewTagTagFilter creates a new TagFilter for comparing tags
 func NewTagTagFilter(entity TagFilterEntity, name string, operator ExpressionOperator, key string, value string) *TagFilter {
 	fullString := fmt.Sprintf("%s=%s", key, value)
@@ -141,12 +154,61 @@ type TagFilter struct {
 	Operator        *ExpressionOperator            `json:"operator"`
 	BooleanValue    *bool                          `json:"booleanValue"`
 	NumberValue     *int64                         `json:"numberValue"`
+	FloatValue      *float64                       `json:"-"`
 	StringValue     *string                        `json:"stringValue"`
 	Key             *string                        `json:"key"`
 	Value           interface{}                    `json:"value"`
 	Type            TagFilterExpressionElementType `json:"type"`
 }
 
+type tagFilterAlias TagFilter
+
+// MarshalJSON custom marshalling of the tag filter. Integer and floating point values are both sent as numberValue
+func (f TagFilter) MarshalJSON() ([]byte, error) {
+	var numberValue interface{}
+	if f.NumberValue != nil {
+		numberValue = *f.NumberValue
+	} else if f.FloatValue != nil {
+		numberValue = *f.FloatValue
+	}
+	return json.Marshal(&struct {
+		tagFilterAlias
+		NumberValue interface{} `json:"numberValue"`
+	}{
+		tagFilterAlias: tagFilterAlias(f),
+		NumberValue:    numberValue,
+	})
+}
+
+// UnmarshalJSON custom unmarshalling of the tag filter. The numberValue is mapped to NumberValue when it is an integer
+// which fits into int64 and to FloatValue otherwise
+func (f *TagFilter) UnmarshalJSON(data []byte) error {
+	payload := struct {
+		*tagFilterAlias
+		NumberValue *json.Number `json:"numberValue"`
+	}{
+		tagFilterAlias: (*tagFilterAlias)(f),
+	}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return err
+	}
+	f.NumberValue = nil
+	f.FloatValue = nil
+	if payload.NumberValue == nil {
+		return nil
+	}
+	if intValue, err := payload.NumberValue.Int64(); err == nil {
+		f.NumberValue = &intValue
+		return nil
+	}
+	floatValue, err := payload.NumberValue.Float64()
+	if err != nil {
+		return fmt.Errorf("invalid numberValue %s of tag filter: %w", payload.NumberValue.String(), err)
+	}
+	f.FloatValue = &floatValue
+	return nil
+}
+
 // GetType Implementation of the TagFilterExpressionElement type
 func (f *TagFilter) GetType() TagFilterExpressionElementType {
 	return f.Type
@@ -1,10 +1,12 @@
 package restapi_test
 
 import (
+	"encoding/json"
 	"go.uber.org/mock/gomock"
 	"testing"
 
 	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
 	"github.com/stretchr/testify/require"
 )
 
@@ -28,3 +30,72 @@ func TestShouldConvertTagFilterEntitiesToStringSlice(t *testing.T) {
 	expectedResult := []string{"SOURCE", "DESTINATION", "NOT_APPLICABLE"}
 	require.Equal(t, expectedResult, SupportedTagFilterEntities.ToStringSlice())
 }
+
+func TestShouldMarshalFloatValueOfTagFilterAsNumberValue(t *testing.T) {
+	sut := NewFloatTagFilter(TagFilterEntityDestination, "call.duration", GreaterThanOperator, 1.5)
+
+	result, err := json.Marshal(sut)
+
+	require.NoError(t, err)
+	require.Contains(t, string(result), `"numberValue":1.5`)
+}
+
+func TestShouldMarshalIntegerValueOfTagFilterAsNumberValue(t *testing.T) {
+	sut := NewNumberTagFilter(TagFilterEntityDestination, "call.http.status", EqualsOperator, 200)
+
+	result, err := json.Marshal(sut)
+
+	require.NoError(t, err)
+	require.Contains(t, string(result), `"numberValue":200`)
+}
+
+func TestShouldUnmarshalIntegerNumberValueOfTagFilterAsNumberValue(t *testing.T) {
+	sut := TagFilter{}
+
+	err := json.Unmarshal([]byte(`{"type":"TAG_FILTER","name":"call.http.status","entity":"DESTINATION","operator":"EQUALS","numberValue":200}`), &sut)
+
+	require.NoError(t, err)
+	require.Equal(t, utils.Int64Ptr(200), sut.NumberValue)
+	require.Nil(t, sut.FloatValue)
+	require.Equal(t, "call.http.status", *sut.Name)
+	require.Equal(t, EqualsOperator, *sut.Operator)
+}
+
+func TestShouldUnmarshalDecimalNumberValueOfTagFilterAsFloatValue(t *testing.T) {
+	sut := TagFilter{}
+
+	err := json.Unmarshal([]byte(`{"type":"TAG_FILTER","name":"call.duration","entity":"DESTINATION","operator":"GREATER_THAN","numberValue":1.5e3}`), &sut)
+
+	require.NoError(t, err)
+	require.Nil(t, sut.NumberValue)
+	require.Equal(t, utils.Float64Ptr(1500), sut.FloatValue)
+}
+
+func TestShouldUnmarshalTagFilterWithoutNumberValue(t *testing.T) {
+	sut := TagFilter{}
+
+	err := json.Unmarshal([]byte(`{"type":"TAG_FILTER","name":"call.type","entity":"DESTINATION","operator":"EQUALS","stringValue":"HTTP","numberValue":null}`), &sut)
+
+	require.NoError(t, err)
+	require.Nil(t, sut.NumberValue)
+	require.Nil(t, sut.FloatValue)
+	require.Equal(t, "HTTP", *sut.StringValue)
+}
+
+func TestShouldRoundTripNestedTagFilterExpressionWithFloatValue(t *testing.T) {
+	sut := NewLogicalAndTagFilter([]*TagFilter{
+		NewFloatTagFilter(TagFilterEntityDestination, "call.duration", GreaterThanOperator, 0.25),
+		NewNumberTagFilter(TagFilterEntityDestination, "call.http.status", EqualsOperator, 200),
+	})
+
+	data, err := json.Marshal(sut)
+	require.NoError(t, err)
+
+	result := TagFilter{}
+	err = json.Unmarshal(data, &result)
+
+	require.NoError(t, err)
+	require.Len(t, result.Elements, 2)
+	require.Equal(t, utils.Float64Ptr(0.25), result.Elements[0].FloatValue)
+	require.Equal(t, utils.Int64Ptr(200), result.Elements[1].NumberValue)
+}
@@ -0,0 +1,16 @@
+package restapi
+
+// UsersResourcePath path to the users resource of Instana RESTful API
+const UsersResourcePath = SettingsBasePath + "/users"
+
+// User is the representation of a user of the Instana tenant
+type User struct {
+	ID       string `json:"id"`
+	Email    string `json:"email"`
+	FullName string `json:"fullName"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (u *User) GetIDForResourcePath() string {
+	return u.ID
+}
@@ -0,0 +1,63 @@
+package restapi
+
+import "encoding/json"
+
+// UserInvitationsResourcePath path to the user invitations resource of Instana RESTful API
+const UserInvitationsResourcePath = SettingsBasePath + "/invitations"
+
+// InvitationStatus custom type for the status of an invitation result
+type InvitationStatus string
+
+const (
+	//InvitationStatusSuccess constant value for the invitation status SUCCESS
+	InvitationStatusSuccess = InvitationStatus("SUCCESS")
+	//InvitationStatusInternalError constant value for the invitation status INTERNAL_ERROR
+	InvitationStatusInternalError = InvitationStatus("INTERNAL_ERROR")
+	//InvitationStatusFailureUserAlreadyExists constant value for the invitation status FAILURE_USER_ALREADY_EXISTS
+	InvitationStatusFailureUserAlreadyExists = InvitationStatus("FAILURE_USER_ALREADY_EXISTS")
+)
+
+// UserInvitation is the representation of an invitation of a user into a set of groups. The invitation is identified
+// by the email address of the invited user. Once the invitation is accepted the user is available in the list of users
+// of the tenant and the invitation is flagged as accepted.
+type UserInvitation struct {
+	Email    string
+	GroupIDs []string
+	Accepted bool
+	UserID   *string
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (i *UserInvitation) GetIDForResourcePath() string {
+	return i.Email
+}
+
+// MarshalJSON custom marshalling of the user invitation. The API expects one invitation per group.
+func (i *UserInvitation) MarshalJSON() ([]byte, error) {
+	invitations := make([]invitation, len(i.GroupIDs))
+	for idx, groupID := range i.GroupIDs {
+		invitations[idx] = invitation{Email: i.Email, GroupID: groupID}
+	}
+	return json.Marshal(invitations)
+}
+
+type invitation struct {
+	Email   string `json:"email"`
+	GroupID string `json:"groupId"`
+}
+
+// InvitationResult is the representation of the status of an invitation of a user
+type InvitationResult struct {
+	InvitationStatus InvitationStatus `json:"invitationStatus"`
+	UserEmail        string           `json:"userEmail"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (r *InvitationResult) GetIDForResourcePath() string {
+	return r.UserEmail
+}
+
+// InvitationResponse is the representation of the response of the API when users are invited
+type InvitationResponse struct {
+	InvitationResults []*InvitationResult `json:"invitationResults"`
+}
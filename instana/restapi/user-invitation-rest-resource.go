@@ -0,0 +1,125 @@
+package restapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// NewUserInvitationRestResource creates a new REST resource for user invitations. Pending invitations are read from
+// the invitations endpoint. Accepted invitations are resolved from the list of users of the tenant. Creating an
+// invitation for a user which is already a member of the tenant results in an accepted invitation. Update is not
+// supported; deleting an accepted invitation is a no-op as the user is not removed from the tenant.
+func NewUserInvitationRestResource(client RestClient) RestResource[*UserInvitation] {
+	return &userInvitationRestResource{
+		resourcePath:            UserInvitationsResourcePath,
+		usersResourcePath:       UsersResourcePath,
+		invitationsUnmarshaller: NewDefaultJSONUnmarshaller(&InvitationResult{}),
+		usersUnmarshaller:       NewDefaultJSONUnmarshaller(&User{}),
+		client:                  client,
+	}
+}
+
+type userInvitationRestResource struct {
+	resourcePath            string
+	usersResourcePath       string
+	invitationsUnmarshaller JSONUnmarshaller[*InvitationResult]
+	usersUnmarshaller       JSONUnmarshaller[*User]
+	client                  RestClient
+}
+
+func (r *userInvitationRestResource) GetAll() (*[]*UserInvitation, error) {
+	pendingInvitations, err := r.getPendingInvitations()
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*UserInvitation, len(*pendingInvitations))
+	for i, p := range *pendingInvitations {
+		result[i] = &UserInvitation{Email: p.UserEmail}
+	}
+	return &result, nil
+}
+
+func (r *userInvitationRestResource) GetOne(email string) (*UserInvitation, error) {
+	data, err := r.client.Get(r.usersResourcePath)
+	if err != nil {
+		return nil, err
+	}
+	users, err := r.usersUnmarshaller.UnmarshalArray(data)
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range *users {
+		if strings.EqualFold(u.Email, email) {
+			userID := u.ID
+			return &UserInvitation{Email: email, Accepted: true, UserID: &userID}, nil
+		}
+	}
+
+	pendingInvitations, err := r.getPendingInvitations()
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range *pendingInvitations {
+		if strings.EqualFold(p.UserEmail, email) {
+			return &UserInvitation{Email: email}, nil
+		}
+	}
+	return nil, ErrEntityNotFound
+}
+
+func (r *userInvitationRestResource) getPendingInvitations() (*[]*InvitationResult, error) {
+	data, err := r.client.Get(r.resourcePath)
+	if err != nil {
+		return nil, err
+	}
+	return r.invitationsUnmarshaller.UnmarshalArray(data)
+}
+
+func (r *userInvitationRestResource) Create(data *UserInvitation) (*UserInvitation, error) {
+	response, err := r.client.Post(data, r.resourcePath)
+	if err != nil {
+		return data, err
+	}
+	invitationResponses := make([]*InvitationResponse, 0)
+	if err := json.Unmarshal(response, &invitationResponses); err != nil {
+		return data, fmt.Errorf("failed to parse json; %s", err)
+	}
+	for _, invitationResponse := range invitationResponses {
+		for _, result := range invitationResponse.InvitationResults {
+			//the user already accepted a previous invitation (e.g. when the invitation is replaced); the invitation is fulfilled
+			if result.InvitationStatus != InvitationStatusSuccess && result.InvitationStatus != InvitationStatusFailureUserAlreadyExists {
+				return data, fmt.Errorf("invitation of user %s failed with status %s", result.UserEmail, result.InvitationStatus)
+			}
+		}
+	}
+
+	invitation, err := r.GetOne(data.Email)
+	if err != nil {
+		return data, err
+	}
+	invitation.GroupIDs = data.GroupIDs
+	return invitation, nil
+}
+
+func (r *userInvitationRestResource) Update(_ *UserInvitation) (*UserInvitation, error) {
+	return nil, fmt.Errorf("update is not supported for %s", r.resourcePath)
+}
+
+func (r *userInvitationRestResource) Delete(data *UserInvitation) error {
+	return r.DeleteByID(data.GetIDForResourcePath())
+}
+
+func (r *userInvitationRestResource) DeleteByID(email string) error {
+	invitation, err := r.GetOne(email)
+	if err == ErrEntityNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if invitation.Accepted {
+		return nil
+	}
+	return r.client.DeleteByQuery(r.resourcePath, map[string]string{"email": email})
+}
@@ -0,0 +1,225 @@
+package restapi_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const userInvitationEmail = "john.doe@example.com"
+
+var emptyUsersResponse = []byte("[]")
+var usersResponseWithInvitedUser = []byte(`[{"id":"user-id","email":"John.Doe@example.com","fullName":"John Doe"}]`)
+var pendingInvitationsResponse = []byte(`[{"invitationStatus":"SUCCESS","userEmail":"john.doe@example.com"}]`)
+
+func TestShouldGetAcceptedUserInvitationFromListOfUsers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Get(UsersResourcePath).Times(1).Return(usersResponseWithInvitedUser, nil)
+	client.EXPECT().Get(UserInvitationsResourcePath).Times(0)
+
+	sut := NewUserInvitationRestResource(client)
+
+	result, err := sut.GetOne(userInvitationEmail)
+
+	require.NoError(t, err)
+	require.Equal(t, userInvitationEmail, result.Email)
+	require.True(t, result.Accepted)
+	require.Equal(t, "user-id", *result.UserID)
+}
+
+func TestShouldGetPendingUserInvitationFromListOfInvitations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Get(UsersResourcePath).Times(1).Return(emptyUsersResponse, nil)
+	client.EXPECT().Get(UserInvitationsResourcePath).Times(1).Return(pendingInvitationsResponse, nil)
+
+	sut := NewUserInvitationRestResource(client)
+
+	result, err := sut.GetOne(userInvitationEmail)
+
+	require.NoError(t, err)
+	require.Equal(t, &UserInvitation{Email: userInvitationEmail}, result)
+}
+
+func TestShouldReturnNotFoundErrorWhenUserInvitationIsNeitherAcceptedNorPending(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Get(UsersResourcePath).Times(1).Return(emptyUsersResponse, nil)
+	client.EXPECT().Get(UserInvitationsResourcePath).Times(1).Return([]byte("[]"), nil)
+
+	sut := NewUserInvitationRestResource(client)
+
+	_, err := sut.GetOne(userInvitationEmail)
+
+	require.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestShouldFailToGetUserInvitationWhenUsersCannotBeRetrieved(t *testing.T) {
+	expectedError := errors.New("test")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Get(UsersResourcePath).Times(1).Return(nil, expectedError)
+
+	sut := NewUserInvitationRestResource(client)
+
+	_, err := sut.GetOne(userInvitationEmail)
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldGetAllPendingUserInvitations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Get(UserInvitationsResourcePath).Times(1).Return(pendingInvitationsResponse, nil)
+
+	sut := NewUserInvitationRestResource(client)
+
+	result, err := sut.GetAll()
+
+	require.NoError(t, err)
+	require.Equal(t, &[]*UserInvitation{{Email: userInvitationEmail}}, result)
+}
+
+func TestShouldCreateUserInvitationAndReadPendingInvitation(t *testing.T) {
+	invitation := &UserInvitation{Email: userInvitationEmail, GroupIDs: []string{"group-1", "group-2"}}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	gomock.InOrder(
+		client.EXPECT().Post(invitation, UserInvitationsResourcePath).Times(1).Return([]byte(`[{"invitationResults":[{"invitationStatus":"SUCCESS","userEmail":"john.doe@example.com"}]}]`), nil),
+		client.EXPECT().Get(UsersResourcePath).Times(1).Return(emptyUsersResponse, nil),
+		client.EXPECT().Get(UserInvitationsResourcePath).Times(1).Return(pendingInvitationsResponse, nil),
+	)
+
+	sut := NewUserInvitationRestResource(client)
+
+	result, err := sut.Create(invitation)
+
+	require.NoError(t, err)
+	require.Equal(t, invitation, result)
+}
+
+func TestShouldCreateAcceptedUserInvitationWhenUserAlreadyExists(t *testing.T) {
+	invitation := &UserInvitation{Email: userInvitationEmail, GroupIDs: []string{"group-1", "group-2"}}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	gomock.InOrder(
+		client.EXPECT().Post(invitation, UserInvitationsResourcePath).Times(1).Return([]byte(`[{"invitationResults":[{"invitationStatus":"FAILURE_USER_ALREADY_EXISTS","userEmail":"john.doe@example.com"}]}]`), nil),
+		client.EXPECT().Get(UsersResourcePath).Times(1).Return(usersResponseWithInvitedUser, nil),
+	)
+	client.EXPECT().Get(UserInvitationsResourcePath).Times(0)
+
+	sut := NewUserInvitationRestResource(client)
+
+	result, err := sut.Create(invitation)
+
+	require.NoError(t, err)
+	userID := "user-id"
+	require.Equal(t, &UserInvitation{Email: userInvitationEmail, GroupIDs: []string{"group-1", "group-2"}, Accepted: true, UserID: &userID}, result)
+}
+
+func TestShouldFailToCreateUserInvitationWhenInvitationIsNotSuccessful(t *testing.T) {
+	invitation := &UserInvitation{Email: userInvitationEmail, GroupIDs: []string{"group-1"}}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Post(invitation, UserInvitationsResourcePath).Times(1).Return([]byte(`[{"invitationResults":[{"invitationStatus":"INTERNAL_ERROR","userEmail":"john.doe@example.com"}]}]`), nil)
+
+	sut := NewUserInvitationRestResource(client)
+
+	_, err := sut.Create(invitation)
+
+	require.ErrorContains(t, err, "invitation of user john.doe@example.com failed with status INTERNAL_ERROR")
+}
+
+func TestShouldFailToCreateUserInvitationWhenResponseIsNotValid(t *testing.T) {
+	invitation := &UserInvitation{Email: userInvitationEmail, GroupIDs: []string{"group-1"}}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Post(invitation, UserInvitationsResourcePath).Times(1).Return([]byte("invalid"), nil)
+
+	sut := NewUserInvitationRestResource(client)
+
+	_, err := sut.Create(invitation)
+
+	require.ErrorContains(t, err, "failed to parse json")
+}
+
+func TestShouldNotSupportUpdateOfUserInvitation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sut := NewUserInvitationRestResource(mocks.NewMockRestClient(ctrl))
+
+	_, err := sut.Update(&UserInvitation{Email: userInvitationEmail})
+
+	require.ErrorContains(t, err, "update is not supported")
+}
+
+func TestShouldRevokePendingUserInvitationOnDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Get(UsersResourcePath).Times(1).Return(emptyUsersResponse, nil)
+	client.EXPECT().Get(UserInvitationsResourcePath).Times(1).Return(pendingInvitationsResponse, nil)
+	client.EXPECT().DeleteByQuery(UserInvitationsResourcePath, map[string]string{"email": userInvitationEmail}).Times(1).Return(nil)
+
+	sut := NewUserInvitationRestResource(client)
+
+	err := sut.Delete(&UserInvitation{Email: userInvitationEmail})
+
+	require.NoError(t, err)
+}
+
+func TestShouldNotRemoveUserWhenAcceptedUserInvitationIsDeleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Get(UsersResourcePath).Times(1).Return(usersResponseWithInvitedUser, nil)
+	client.EXPECT().DeleteByQuery(gomock.Any(), gomock.Any()).Times(0)
+	client.EXPECT().Delete(gomock.Any(), gomock.Any()).Times(0)
+
+	sut := NewUserInvitationRestResource(client)
+
+	err := sut.DeleteByID(userInvitationEmail)
+
+	require.NoError(t, err)
+}
+
+func TestShouldMarshalUserInvitationAsOneInvitationPerGroup(t *testing.T) {
+	invitation := &UserInvitation{Email: userInvitationEmail, GroupIDs: []string{"group-1", "group-2"}}
+
+	data, err := json.Marshal(invitation)
+
+	require.NoError(t, err)
+	require.JSONEq(t, `[{"email":"john.doe@example.com","groupId":"group-1"},{"email":"john.doe@example.com","groupId":"group-2"}]`, string(data))
+}
@@ -18,6 +18,8 @@ type WebsiteAlertConfig struct {
 	Rule                  WebsiteAlertRule          `json:"rule"`
 	Threshold             Threshold                 `json:"threshold"`
 	TimeThreshold         WebsiteTimeThreshold      `json:"timeThreshold"`
+	Enabled               *bool                     `json:"enabled,omitempty"`
+	RefreshBaseline       bool                      `json:"-"`
 }
 
 // GetIDForResourcePath implementation of the interface InstanaDataObject
@@ -34,3 +36,18 @@ func (a *WebsiteAlertConfig) GetCustomerPayloadFields() []CustomPayloadField[any
 func (a *WebsiteAlertConfig) SetCustomerPayloadFields(fields []CustomPayloadField[any]) {
 	a.CustomerPayloadFields = fields
 }
+
+// IsHistoricBaselineRefreshRequested implementation of the interface historicBaselineRefreshAwareInstanaDataObject
+func (a *WebsiteAlertConfig) IsHistoricBaselineRefreshRequested() bool {
+	return a.RefreshBaseline
+}
+
+// GetEnabled implementation of the interface enabledStateAwareInstanaDataObject
+func (a *WebsiteAlertConfig) GetEnabled() *bool {
+	return a.Enabled
+}
+
+// SetEnabled implementation of the interface enabledStateAwareInstanaDataObject
+func (a *WebsiteAlertConfig) SetEnabled(enabled *bool) {
+	a.Enabled = enabled
+}
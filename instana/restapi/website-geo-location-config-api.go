@@ -0,0 +1,77 @@
+package restapi
+
+const websiteGeoLocationConfigPathElement = "geo-location"
+
+// GeoDetailRemovalType custom type for the removal of geo location details of website monitoring beacons
+type GeoDetailRemovalType string
+
+// GeoDetailRemovalTypes custom type for a slice of GeoDetailRemovalType
+type GeoDetailRemovalTypes []GeoDetailRemovalType
+
+// ToStringSlice Returns the corresponding string representations
+func (types GeoDetailRemovalTypes) ToStringSlice() []string {
+	result := make([]string, len(types))
+	for i, v := range types {
+		result[i] = string(v)
+	}
+	return result
+}
+
+const (
+	//GeoDetailRemovalTypeNoRemoval constant value for the geo detail removal type NO_REMOVAL
+	GeoDetailRemovalTypeNoRemoval = GeoDetailRemovalType("NO_REMOVAL")
+	//GeoDetailRemovalTypeRemoveCoordinates constant value for the geo detail removal type REMOVE_COORDINATES
+	GeoDetailRemovalTypeRemoveCoordinates = GeoDetailRemovalType("REMOVE_COORDINATES")
+	//GeoDetailRemovalTypeRemoveCity constant value for the geo detail removal type REMOVE_CITY
+	GeoDetailRemovalTypeRemoveCity = GeoDetailRemovalType("REMOVE_CITY")
+	//GeoDetailRemovalTypeRemoveAll constant value for the geo detail removal type REMOVE_ALL
+	GeoDetailRemovalTypeRemoveAll = GeoDetailRemovalType("REMOVE_ALL")
+)
+
+// SupportedGeoDetailRemovalTypes list of all supported GeoDetailRemovalType
+var SupportedGeoDetailRemovalTypes = GeoDetailRemovalTypes{GeoDetailRemovalTypeNoRemoval, GeoDetailRemovalTypeRemoveCoordinates, GeoDetailRemovalTypeRemoveCity, GeoDetailRemovalTypeRemoveAll}
+
+// GeoSubdivision data structure of a subdivision (e.g. state or province) of a geo mapping rule of the Instana API
+type GeoSubdivision struct {
+	Code *string `json:"code,omitempty"`
+	Name string  `json:"name"`
+}
+
+// GeoMappingRule data structure of a custom geo mapping rule of the Instana API which maps the IPs of a CIDR to a location
+type GeoMappingRule struct {
+	CIDR           string           `json:"cidr"`
+	AccuracyRadius *int64           `json:"accuracyRadius,omitempty"`
+	Latitude       *float64         `json:"latitude,omitempty"`
+	Longitude      *float64         `json:"longitude,omitempty"`
+	City           *string          `json:"city,omitempty"`
+	Country        *string          `json:"country,omitempty"`
+	CountryCode    *string          `json:"countryCode,omitempty"`
+	Continent      *string          `json:"continent,omitempty"`
+	ContinentCode  *string          `json:"continentCode,omitempty"`
+	Subdivisions   []GeoSubdivision `json:"subdivisions"`
+}
+
+// WebsiteGeoLocationConfig data structure of the geo location configuration of a website of the Instana API. The
+// configuration is identified by the ID of the website.
+type WebsiteGeoLocationConfig struct {
+	WebsiteID        string               `json:"-"`
+	GeoDetailRemoval GeoDetailRemovalType `json:"geoDetailRemoval"`
+	GeoMappingRules  []GeoMappingRule     `json:"geoMappingRules"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (c *WebsiteGeoLocationConfig) GetIDForResourcePath() string {
+	return c.WebsiteID
+}
+
+func (c *WebsiteGeoLocationConfig) setWebsiteID(websiteID string) {
+	c.WebsiteID = websiteID
+}
+
+// NewWebsiteGeoLocationConfigRestResource creates a new REST resource for the geo location configuration of websites.
+// Deleting the configuration resets the geo detail removal to NO_REMOVAL and removes all geo mapping rules.
+func NewWebsiteGeoLocationConfigRestResource(unmarshaller JSONUnmarshaller[*WebsiteGeoLocationConfig], client RestClient) RestResource[*WebsiteGeoLocationConfig] {
+	return newWebsiteSettingsRestResource(websiteGeoLocationConfigPathElement, unmarshaller, func(websiteID string) *WebsiteGeoLocationConfig {
+		return &WebsiteGeoLocationConfig{WebsiteID: websiteID, GeoDetailRemoval: GeoDetailRemovalTypeNoRemoval, GeoMappingRules: []GeoMappingRule{}}
+	}, client)
+}
@@ -0,0 +1,54 @@
+package restapi
+
+const websiteIPMaskingConfigPathElement = "ip-masking"
+
+// IPMaskingType custom type for the IP masking of website monitoring beacons
+type IPMaskingType string
+
+// IPMaskingTypes custom type for a slice of IPMaskingType
+type IPMaskingTypes []IPMaskingType
+
+// ToStringSlice Returns the corresponding string representations
+func (types IPMaskingTypes) ToStringSlice() []string {
+	result := make([]string, len(types))
+	for i, v := range types {
+		result[i] = string(v)
+	}
+	return result
+}
+
+const (
+	//IPMaskingTypeDefault constant value for the IP masking type DEFAULT
+	IPMaskingTypeDefault = IPMaskingType("DEFAULT")
+	//IPMaskingTypeStrict constant value for the IP masking type STRICT
+	IPMaskingTypeStrict = IPMaskingType("STRICT")
+	//IPMaskingTypeRemoveAllDetails constant value for the IP masking type REMOVE_ALL_DETAILS
+	IPMaskingTypeRemoveAllDetails = IPMaskingType("REMOVE_ALL_DETAILS")
+)
+
+// SupportedIPMaskingTypes list of all supported IPMaskingType
+var SupportedIPMaskingTypes = IPMaskingTypes{IPMaskingTypeDefault, IPMaskingTypeStrict, IPMaskingTypeRemoveAllDetails}
+
+// WebsiteIPMaskingConfig data structure of the IP masking configuration of a website of the Instana API. The
+// configuration is identified by the ID of the website.
+type WebsiteIPMaskingConfig struct {
+	WebsiteID string        `json:"-"`
+	IPMasking IPMaskingType `json:"ipMasking"`
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (c *WebsiteIPMaskingConfig) GetIDForResourcePath() string {
+	return c.WebsiteID
+}
+
+func (c *WebsiteIPMaskingConfig) setWebsiteID(websiteID string) {
+	c.WebsiteID = websiteID
+}
+
+// NewWebsiteIPMaskingConfigRestResource creates a new REST resource for the IP masking configuration of websites.
+// Deleting the configuration resets the IP masking to DEFAULT.
+func NewWebsiteIPMaskingConfigRestResource(unmarshaller JSONUnmarshaller[*WebsiteIPMaskingConfig], client RestClient) RestResource[*WebsiteIPMaskingConfig] {
+	return newWebsiteSettingsRestResource(websiteIPMaskingConfigPathElement, unmarshaller, func(websiteID string) *WebsiteIPMaskingConfig {
+		return &WebsiteIPMaskingConfig{WebsiteID: websiteID, IPMasking: IPMaskingTypeDefault}
+	}, client)
+}
@@ -0,0 +1,110 @@
+package restapi
+
+import "encoding/json"
+
+// WebsiteSettings is the common interface of the settings of a website which are managed through a sub resource of the
+// website monitoring config. The settings are identified by the ID of the website.
+type WebsiteSettings interface {
+	InstanaDataObject
+	setWebsiteID(websiteID string)
+}
+
+// newWebsiteSettingsRestResource creates a new REST resource for the settings of a website which are read and updated
+// through the sub resource <website_config_path>/<website_id>/<pathElement>. Deleting the settings resets them to the
+// provided default settings.
+func newWebsiteSettingsRestResource[T WebsiteSettings](pathElement string, unmarshaller JSONUnmarshaller[T], defaultSettings func(websiteID string) T, client RestClient) RestResource[T] {
+	return &websiteSettingsRestResource[T]{
+		pathElement:     pathElement,
+		unmarshaller:    unmarshaller,
+		defaultSettings: defaultSettings,
+		client:          client,
+	}
+}
+
+type websiteSettingsRestResource[T WebsiteSettings] struct {
+	pathElement     string
+	unmarshaller    JSONUnmarshaller[T]
+	defaultSettings func(websiteID string) T
+	client          RestClient
+}
+
+func (r *websiteSettingsRestResource[T]) GetAll() (*[]T, error) {
+	data, err := r.client.Get(WebsiteMonitoringConfigResourcePath)
+	if err != nil {
+		return nil, err
+	}
+	websites, err := NewDefaultJSONUnmarshaller(&WebsiteMonitoringConfig{}).UnmarshalArray(data)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]T, 0, len(*websites))
+	for _, w := range *websites {
+		settings, err := r.GetOne(w.ID)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, settings)
+	}
+	return &result, nil
+}
+
+func (r *websiteSettingsRestResource[T]) GetOne(websiteID string) (T, error) {
+	data, err := r.client.GetOne(r.pathElement, r.websitePath(websiteID))
+	if err != nil {
+		var empty T
+		return empty, err
+	}
+	return r.unmarshalAndSetWebsiteID(websiteID, data)
+}
+
+func (r *websiteSettingsRestResource[T]) Create(data T) (T, error) {
+	return r.Update(data)
+}
+
+func (r *websiteSettingsRestResource[T]) Update(data T) (T, error) {
+	websiteID := data.GetIDForResourcePath()
+	response, err := r.client.Put(&websiteSettingsPayload{pathElement: r.pathElement, settings: data}, r.websitePath(websiteID))
+	if err != nil {
+		return data, err
+	}
+	return r.unmarshalAndSetWebsiteID(websiteID, response)
+}
+
+func (r *websiteSettingsRestResource[T]) unmarshalAndSetWebsiteID(websiteID string, data []byte) (T, error) {
+	settings, err := r.unmarshaller.Unmarshal(data)
+	if err != nil {
+		return settings, err
+	}
+	settings.setWebsiteID(websiteID)
+	return settings, nil
+}
+
+func (r *websiteSettingsRestResource[T]) Delete(data T) error {
+	return r.DeleteByID(data.GetIDForResourcePath())
+}
+
+func (r *websiteSettingsRestResource[T]) DeleteByID(websiteID string) error {
+	_, err := r.client.Put(&websiteSettingsPayload{pathElement: r.pathElement, settings: r.defaultSettings(websiteID)}, r.websitePath(websiteID))
+	return err
+}
+
+func (r *websiteSettingsRestResource[T]) websitePath(websiteID string) string {
+	return WebsiteMonitoringConfigResourcePath + "/" + websiteID
+}
+
+// websiteSettingsPayload is the payload to update the settings of a website. The settings are updated through the
+// resource path <website_path>/<pathElement>; therefore the ID for the resource path is the path element.
+type websiteSettingsPayload struct {
+	pathElement string
+	settings    InstanaDataObject
+}
+
+// GetIDForResourcePath implementation of the interface InstanaDataObject
+func (p *websiteSettingsPayload) GetIDForResourcePath() string {
+	return p.pathElement
+}
+
+// MarshalJSON custom marshalling of the payload as plain settings object
+func (p *websiteSettingsPayload) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.settings)
+}
@@ -0,0 +1,171 @@
+package restapi_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const websiteSettingsWebsiteID = "website-id"
+const websiteSettingsWebsitePath = WebsiteMonitoringConfigResourcePath + "/" + websiteSettingsWebsiteID
+
+var websiteSettingsResponse = []byte("settings-response")
+
+func TestShouldGetWebsiteSettingsFromSubResourceOfWebsite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().GetOne("ip-masking", websiteSettingsWebsitePath).Times(1).Return(websiteSettingsResponse, nil)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*WebsiteIPMaskingConfig](ctrl)
+	unmarshaller.EXPECT().Unmarshal(websiteSettingsResponse).Times(1).Return(&WebsiteIPMaskingConfig{IPMasking: IPMaskingTypeStrict}, nil)
+
+	sut := NewWebsiteIPMaskingConfigRestResource(unmarshaller, client)
+
+	result, err := sut.GetOne(websiteSettingsWebsiteID)
+
+	require.NoError(t, err)
+	require.Equal(t, &WebsiteIPMaskingConfig{WebsiteID: websiteSettingsWebsiteID, IPMasking: IPMaskingTypeStrict}, result)
+}
+
+func TestShouldFailToGetWebsiteSettingsWhenSubResourceCannotBeRetrieved(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().GetOne("geo-location", websiteSettingsWebsitePath).Times(1).Return(nil, ErrEntityNotFound)
+
+	sut := NewWebsiteGeoLocationConfigRestResource(mocks.NewMockJSONUnmarshaller[*WebsiteGeoLocationConfig](ctrl), client)
+
+	_, err := sut.GetOne(websiteSettingsWebsiteID)
+
+	require.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestShouldFailToGetWebsiteSettingsWhenResponseCannotBeUnmarshalled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedError := errors.New("test")
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().GetOne("ip-masking", websiteSettingsWebsitePath).Times(1).Return(websiteSettingsResponse, nil)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*WebsiteIPMaskingConfig](ctrl)
+	unmarshaller.EXPECT().Unmarshal(websiteSettingsResponse).Times(1).Return(nil, expectedError)
+
+	sut := NewWebsiteIPMaskingConfigRestResource(unmarshaller, client)
+
+	_, err := sut.GetOne(websiteSettingsWebsiteID)
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldGetAllWebsiteSettingsForAllWebsites(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Get(WebsiteMonitoringConfigResourcePath).Times(1).Return([]byte(`[{"id":"`+websiteSettingsWebsiteID+`","name":"website"}]`), nil)
+	client.EXPECT().GetOne("ip-masking", websiteSettingsWebsitePath).Times(1).Return(websiteSettingsResponse, nil)
+	unmarshaller := mocks.NewMockJSONUnmarshaller[*WebsiteIPMaskingConfig](ctrl)
+	unmarshaller.EXPECT().Unmarshal(websiteSettingsResponse).Times(1).Return(&WebsiteIPMaskingConfig{IPMasking: IPMaskingTypeStrict}, nil)
+
+	sut := NewWebsiteIPMaskingConfigRestResource(unmarshaller, client)
+
+	result, err := sut.GetAll()
+
+	require.NoError(t, err)
+	require.Equal(t, &[]*WebsiteIPMaskingConfig{{WebsiteID: websiteSettingsWebsiteID, IPMasking: IPMaskingTypeStrict}}, result)
+}
+
+func TestShouldPutWebsiteSettingsToSubResourceOfWebsiteOnCreateAndUpdate(t *testing.T) {
+	for name, action := range map[string]func(RestResource[*WebsiteIPMaskingConfig], *WebsiteIPMaskingConfig) (*WebsiteIPMaskingConfig, error){
+		"create": func(r RestResource[*WebsiteIPMaskingConfig], c *WebsiteIPMaskingConfig) (*WebsiteIPMaskingConfig, error) {
+			return r.Create(c)
+		},
+		"update": func(r RestResource[*WebsiteIPMaskingConfig], c *WebsiteIPMaskingConfig) (*WebsiteIPMaskingConfig, error) {
+			return r.Update(c)
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			client := mocks.NewMockRestClient(ctrl)
+			client.EXPECT().Put(gomock.Any(), websiteSettingsWebsitePath).Times(1).DoAndReturn(func(payload InstanaDataObject, _ string) ([]byte, error) {
+				require.Equal(t, "ip-masking", payload.GetIDForResourcePath())
+				serialized, err := json.Marshal(payload)
+				require.NoError(t, err)
+				require.JSONEq(t, `{"ipMasking":"STRICT"}`, string(serialized))
+				return websiteSettingsResponse, nil
+			})
+			unmarshaller := mocks.NewMockJSONUnmarshaller[*WebsiteIPMaskingConfig](ctrl)
+			unmarshaller.EXPECT().Unmarshal(websiteSettingsResponse).Times(1).Return(&WebsiteIPMaskingConfig{IPMasking: IPMaskingTypeStrict}, nil)
+
+			sut := NewWebsiteIPMaskingConfigRestResource(unmarshaller, client)
+
+			result, err := action(sut, &WebsiteIPMaskingConfig{WebsiteID: websiteSettingsWebsiteID, IPMasking: IPMaskingTypeStrict})
+
+			require.NoError(t, err)
+			require.Equal(t, &WebsiteIPMaskingConfig{WebsiteID: websiteSettingsWebsiteID, IPMasking: IPMaskingTypeStrict}, result)
+		})
+	}
+}
+
+func TestShouldReturnErrorWhenWebsiteSettingsCannotBeUpdated(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedError := errors.New("test")
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Put(gomock.Any(), websiteSettingsWebsitePath).Times(1).Return(nil, expectedError)
+
+	sut := NewWebsiteIPMaskingConfigRestResource(mocks.NewMockJSONUnmarshaller[*WebsiteIPMaskingConfig](ctrl), client)
+
+	_, err := sut.Update(&WebsiteIPMaskingConfig{WebsiteID: websiteSettingsWebsiteID, IPMasking: IPMaskingTypeStrict})
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldResetIPMaskingToDefaultWhenWebsiteIPMaskingConfigIsDeleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Put(gomock.Any(), websiteSettingsWebsitePath).Times(1).DoAndReturn(func(payload InstanaDataObject, _ string) ([]byte, error) {
+		serialized, err := json.Marshal(payload)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"ipMasking":"DEFAULT"}`, string(serialized))
+		return websiteSettingsResponse, nil
+	})
+
+	sut := NewWebsiteIPMaskingConfigRestResource(mocks.NewMockJSONUnmarshaller[*WebsiteIPMaskingConfig](ctrl), client)
+
+	err := sut.Delete(&WebsiteIPMaskingConfig{WebsiteID: websiteSettingsWebsiteID, IPMasking: IPMaskingTypeStrict})
+
+	require.NoError(t, err)
+}
+
+func TestShouldResetGeoLocationWhenWebsiteGeoLocationConfigIsDeleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockRestClient(ctrl)
+	client.EXPECT().Put(gomock.Any(), websiteSettingsWebsitePath).Times(1).DoAndReturn(func(payload InstanaDataObject, _ string) ([]byte, error) {
+		require.Equal(t, "geo-location", payload.GetIDForResourcePath())
+		serialized, err := json.Marshal(payload)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"geoDetailRemoval":"NO_REMOVAL","geoMappingRules":[]}`, string(serialized))
+		return websiteSettingsResponse, nil
+	})
+
+	sut := NewWebsiteGeoLocationConfigRestResource(mocks.NewMockJSONUnmarshaller[*WebsiteGeoLocationConfig](ctrl), client)
+
+	err := sut.DeleteByID(websiteSettingsWebsiteID)
+
+	require.NoError(t, err)
+}
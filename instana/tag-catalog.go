@@ -0,0 +1,75 @@
+package instana
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+)
+
+// TagCatalogType custom type for the type of the Instana tag catalog
+type TagCatalogType string
+
+const (
+	//ApplicationTagCatalog constant value for the tag catalog of application monitoring
+	ApplicationTagCatalog = TagCatalogType("application")
+	//WebsiteTagCatalog constant value for the tag catalog of website monitoring
+	WebsiteTagCatalog = TagCatalogType("website")
+)
+
+// TagCatalogValidation defines the top level tag filter fields of a resource which are validated against the given tag catalog
+type TagCatalogValidation struct {
+	Catalog         TagCatalogType
+	TagFilterFields []string
+}
+
+// TagCatalogs provides access to the tags of the Instana tag catalogs
+type TagCatalogs interface {
+	//Tags returns the tags of the given tag catalog
+	Tags(catalog TagCatalogType) ([]*restapi.Tag, error)
+}
+
+// NewTagCatalogs creates a new instance of TagCatalogs which loads each tag catalog once from the Instana API and caches it
+func NewTagCatalogs(api restapi.InstanaAPI) TagCatalogs {
+	return &cachedTagCatalogs{
+		api:  api,
+		tags: make(map[TagCatalogType][]*restapi.Tag),
+	}
+}
+
+type cachedTagCatalogs struct {
+	api   restapi.InstanaAPI
+	mutex sync.Mutex
+	tags  map[TagCatalogType][]*restapi.Tag
+}
+
+// Tags implementation of TagCatalogs interface. Failed requests are not cached so that they are retried on the next call
+func (c *cachedTagCatalogs) Tags(catalog TagCatalogType) ([]*restapi.Tag, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if tags, ok := c.tags[catalog]; ok {
+		return tags, nil
+	}
+
+	resource, err := c.getRestResource(catalog)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := resource.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s tag catalog: %w", catalog, err)
+	}
+	c.tags[catalog] = *tags
+	return *tags, nil
+}
+
+func (c *cachedTagCatalogs) getRestResource(catalog TagCatalogType) (restapi.ReadOnlyRestResource[*restapi.Tag], error) {
+	switch catalog {
+	case ApplicationTagCatalog:
+		return c.api.ApplicationTagCatalog(), nil
+	case WebsiteTagCatalog:
+		return c.api.WebsiteTagCatalog(), nil
+	}
+	return nil, fmt.Errorf("tag catalog %s is not supported", catalog)
+}
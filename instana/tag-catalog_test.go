@@ -0,0 +1,228 @@
+package instana_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTagCatalogs(t *testing.T) {
+	ut := &tagCatalogsUnitTest{}
+	t.Run("should load application tag catalog only once", ut.shouldLoadApplicationTagCatalogOnlyOnce)
+	t.Run("should load website tag catalog independently of application tag catalog", ut.shouldLoadWebsiteTagCatalogIndependentlyOfApplicationTagCatalog)
+	t.Run("should not cache failed requests", ut.shouldNotCacheFailedRequests)
+	t.Run("should fail to load unsupported tag catalog", ut.shouldFailToLoadUnsupportedTagCatalog)
+}
+
+type tagCatalogsUnitTest struct{}
+
+func (r *tagCatalogsUnitTest) shouldLoadApplicationTagCatalogOnlyOnce(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	tags := []*restapi.Tag{{Name: "service.name", Type: restapi.TagTypeString}}
+	mockInstanaAPI := mocks.NewMockInstanaAPI(ctrl)
+	mockTagCatalog := mocks.NewMockReadOnlyRestResource[*restapi.Tag](ctrl)
+	mockInstanaAPI.EXPECT().ApplicationTagCatalog().Return(mockTagCatalog).Times(1)
+	mockTagCatalog.EXPECT().GetAll().Return(&tags, nil).Times(1)
+
+	sut := NewTagCatalogs(mockInstanaAPI)
+
+	for i := 0; i < 3; i++ {
+		result, err := sut.Tags(ApplicationTagCatalog)
+
+		require.NoError(t, err)
+		require.Equal(t, tags, result)
+	}
+}
+
+func (r *tagCatalogsUnitTest) shouldLoadWebsiteTagCatalogIndependentlyOfApplicationTagCatalog(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	applicationTags := []*restapi.Tag{{Name: "service.name", Type: restapi.TagTypeString}}
+	websiteTags := []*restapi.Tag{{Name: "beacon.website.name", Type: restapi.TagTypeString}}
+	mockInstanaAPI := mocks.NewMockInstanaAPI(ctrl)
+	mockApplicationTagCatalog := mocks.NewMockReadOnlyRestResource[*restapi.Tag](ctrl)
+	mockWebsiteTagCatalog := mocks.NewMockReadOnlyRestResource[*restapi.Tag](ctrl)
+	mockInstanaAPI.EXPECT().ApplicationTagCatalog().Return(mockApplicationTagCatalog).Times(1)
+	mockInstanaAPI.EXPECT().WebsiteTagCatalog().Return(mockWebsiteTagCatalog).Times(1)
+	mockApplicationTagCatalog.EXPECT().GetAll().Return(&applicationTags, nil).Times(1)
+	mockWebsiteTagCatalog.EXPECT().GetAll().Return(&websiteTags, nil).Times(1)
+
+	sut := NewTagCatalogs(mockInstanaAPI)
+
+	result, err := sut.Tags(ApplicationTagCatalog)
+	require.NoError(t, err)
+	require.Equal(t, applicationTags, result)
+
+	result, err = sut.Tags(WebsiteTagCatalog)
+	require.NoError(t, err)
+	require.Equal(t, websiteTags, result)
+}
+
+func (r *tagCatalogsUnitTest) shouldNotCacheFailedRequests(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	tags := []*restapi.Tag{{Name: "service.name", Type: restapi.TagTypeString}}
+	expectedError := errors.New("test")
+	mockInstanaAPI := mocks.NewMockInstanaAPI(ctrl)
+	mockTagCatalog := mocks.NewMockReadOnlyRestResource[*restapi.Tag](ctrl)
+	mockInstanaAPI.EXPECT().ApplicationTagCatalog().Return(mockTagCatalog).Times(2)
+	gomock.InOrder(
+		mockTagCatalog.EXPECT().GetAll().Return(nil, expectedError).Times(1),
+		mockTagCatalog.EXPECT().GetAll().Return(&tags, nil).Times(1),
+	)
+
+	sut := NewTagCatalogs(mockInstanaAPI)
+
+	_, err := sut.Tags(ApplicationTagCatalog)
+	require.ErrorIs(t, err, expectedError)
+	require.ErrorContains(t, err, "failed to load application tag catalog")
+
+	result, err := sut.Tags(ApplicationTagCatalog)
+	require.NoError(t, err)
+	require.Equal(t, tags, result)
+}
+
+func (r *tagCatalogsUnitTest) shouldFailToLoadUnsupportedTagCatalog(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockInstanaAPI := mocks.NewMockInstanaAPI(ctrl)
+
+	sut := NewTagCatalogs(mockInstanaAPI)
+
+	_, err := sut.Tags(TagCatalogType("invalid"))
+	require.ErrorContains(t, err, "tag catalog invalid is not supported")
+}
+
+func TestTagCatalogValidationOfTagFilters(t *testing.T) {
+	ut := &tagCatalogValidationUnitTest{}
+	t.Run("should accept tag filter with known tags and supported operators", ut.shouldAcceptTagFilterWithKnownTagsAndSupportedOperators)
+	t.Run("should reject tag filter with unknown tag and suggest similar tag", ut.shouldRejectTagFilterWithUnknownTagAndSuggestSimilarTag)
+	t.Run("should reject tag filter with unsupported operator for tag type", ut.shouldRejectTagFilterWithUnsupportedOperatorForTagType)
+	t.Run("should skip validation when tag catalog validation is disabled", ut.shouldSkipValidationWhenTagCatalogValidationIsDisabled)
+	t.Run("should skip validation when tag filter is not provided", ut.shouldSkipValidationWhenTagFilterIsNotProvided)
+	t.Run("should skip validation when tag filter is not changed", ut.shouldSkipValidationWhenTagFilterIsNotChanged)
+	t.Run("should validate tag filter when tag filter is changed", ut.shouldValidateTagFilterWhenTagFilterIsChanged)
+	t.Run("should return error when tag catalog cannot be loaded", ut.shouldReturnErrorWhenTagCatalogCannotBeLoaded)
+}
+
+type tagCatalogValidationUnitTest struct{}
+
+func (r *tagCatalogValidationUnitTest) shouldAcceptTagFilterWithKnownTagsAndSupportedOperators(t *testing.T) {
+	r.withTagCatalogMock(t, 1, nil, func(meta *ProviderMeta) {
+		err := r.planApplicationConfig(meta, nil, "service.name EQUALS 'foo' AND call.http.status GREATER_THAN 200")
+
+		require.NoError(t, err)
+	})
+}
+
+func (r *tagCatalogValidationUnitTest) shouldRejectTagFilterWithUnknownTagAndSuggestSimilarTag(t *testing.T) {
+	r.withTagCatalogMock(t, 1, nil, func(meta *ProviderMeta) {
+		err := r.planApplicationConfig(meta, nil, "servce.name EQUALS 'foo'")
+
+		require.ErrorContains(t, err, "invalid tag_filter: unknown tag 'servce.name'; did you mean 'service.name'?")
+	})
+}
+
+func (r *tagCatalogValidationUnitTest) shouldRejectTagFilterWithUnsupportedOperatorForTagType(t *testing.T) {
+	r.withTagCatalogMock(t, 1, nil, func(meta *ProviderMeta) {
+		err := r.planApplicationConfig(meta, nil, "service.name GREATER_THAN 'foo' OR call.http.status CONTAINS '20'")
+
+		require.ErrorContains(t, err, "invalid tag_filter: operator GREATER_THAN is not supported for tag 'service.name' of type STRING")
+		require.ErrorContains(t, err, "invalid tag_filter: operator CONTAINS is not supported for tag 'call.http.status' of type NUMBER")
+	})
+}
+
+func (r *tagCatalogValidationUnitTest) shouldSkipValidationWhenTagCatalogValidationIsDisabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	meta := &ProviderMeta{InstanaAPI: mocks.NewMockInstanaAPI(ctrl)}
+
+	err := r.planApplicationConfig(meta, nil, "servce.name EQUALS 'foo'")
+
+	require.NoError(t, err)
+}
+
+func (r *tagCatalogValidationUnitTest) shouldSkipValidationWhenTagFilterIsNotProvided(t *testing.T) {
+	r.withTagCatalogMock(t, 0, nil, func(meta *ProviderMeta) {
+		err := r.planApplicationConfig(meta, nil, "")
+
+		require.NoError(t, err)
+	})
+}
+
+func (r *tagCatalogValidationUnitTest) shouldSkipValidationWhenTagFilterIsNotChanged(t *testing.T) {
+	r.withTagCatalogMock(t, 0, nil, func(meta *ProviderMeta) {
+		err := r.planApplicationConfig(meta, r.createApplicationConfigState("servce.name@dest EQUALS 'foo'"), "servce.name EQUALS 'foo'")
+
+		require.NoError(t, err)
+	})
+}
+
+func (r *tagCatalogValidationUnitTest) shouldValidateTagFilterWhenTagFilterIsChanged(t *testing.T) {
+	r.withTagCatalogMock(t, 1, nil, func(meta *ProviderMeta) {
+		err := r.planApplicationConfig(meta, r.createApplicationConfigState("service.name@dest EQUALS 'foo'"), "servce.name EQUALS 'bar'")
+
+		require.ErrorContains(t, err, "unknown tag 'servce.name'")
+	})
+}
+
+func (r *tagCatalogValidationUnitTest) shouldReturnErrorWhenTagCatalogCannotBeLoaded(t *testing.T) {
+	expectedError := errors.New("test")
+	r.withTagCatalogMock(t, 1, expectedError, func(meta *ProviderMeta) {
+		err := r.planApplicationConfig(meta, nil, "service.name EQUALS 'foo'")
+
+		require.ErrorIs(t, err, expectedError)
+	})
+}
+
+func (r *tagCatalogValidationUnitTest) withTagCatalogMock(t *testing.T, times int, err error, testFunction func(meta *ProviderMeta)) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	tags := []*restapi.Tag{
+		{Name: "service.name", Type: restapi.TagTypeString},
+		{Name: "call.http.status", Type: restapi.TagTypeNumber},
+	}
+	mockInstanaAPI := mocks.NewMockInstanaAPI(ctrl)
+	mockTagCatalog := mocks.NewMockReadOnlyRestResource[*restapi.Tag](ctrl)
+	mockInstanaAPI.EXPECT().ApplicationTagCatalog().Return(mockTagCatalog).Times(times)
+	if err != nil {
+		mockTagCatalog.EXPECT().GetAll().Return(nil, err).Times(times)
+	} else {
+		mockTagCatalog.EXPECT().GetAll().Return(&tags, nil).Times(times)
+	}
+
+	testFunction(&ProviderMeta{InstanaAPI: mockInstanaAPI, TagCatalogs: NewTagCatalogs(mockInstanaAPI)})
+}
+
+func (r *tagCatalogValidationUnitTest) planApplicationConfig(meta *ProviderMeta, state *terraform.InstanceState, tagFilter string) error {
+	config := map[string]interface{}{
+		ApplicationConfigFieldLabel: "label",
+	}
+	if tagFilter != "" {
+		config[ApplicationConfigFieldTagFilter] = tagFilter
+	}
+	resource := NewTerraformResource(NewApplicationConfigResourceHandle()).ToSchemaResource()
+	_, err := resource.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), meta)
+	return err
+}
+
+func (r *tagCatalogValidationUnitTest) createApplicationConfigState(tagFilter string) *terraform.InstanceState {
+	return &terraform.InstanceState{
+		ID: "id",
+		Attributes: map[string]string{
+			"id":                                "id",
+			ApplicationConfigFieldLabel:         "label",
+			ApplicationConfigFieldScope:         string(restapi.ApplicationConfigScopeIncludeNoDownstream),
+			ApplicationConfigFieldBoundaryScope: string(restapi.BoundaryScopeDefault),
+			ApplicationConfigFieldTagFilter:     tagFilter,
+		},
+	}
+}
@@ -7,17 +7,21 @@ import (
 )
 
 var tagFilterDiffSuppressFunc = func(k, old, new string, d *schema.ResourceData) bool {
-	normalized, err := tagfilter.Normalize(new)
-	if err == nil {
-		return normalized == old
+	canonicalNew, err := tagfilter.Canonicalize(new)
+	if err != nil {
+		return old == new
+	}
+	canonicalOld, err := tagfilter.Canonicalize(old)
+	if err != nil {
+		return canonicalNew == old
 	}
-	return old == new
+	return canonicalNew == canonicalOld
 }
 
 var tagFilterStateFunc = func(val interface{}) string {
-	normalized, err := tagfilter.Normalize(val.(string))
+	canonical, err := tagfilter.Canonicalize(val.(string))
 	if err == nil {
-		return normalized
+		return canonical
 	}
 	return val.(string)
 }
@@ -18,7 +18,10 @@ func TestTagFilterSchema(t *testing.T) {
 		t.Run(fmt.Sprintf("DiffSuppressFunc of %s TagFilterExpression Schema should return false when value can be normalized and old and new normalized value are not equal", k), createTestOfDiffSuppressFuncOfTagFilterShouldReturnFalseWhenValueCanBeNormalizedAndOldAndNewNormalizedValueAreNotEqual(tagFilterExpressionSchema))
 		t.Run(fmt.Sprintf("DiffSuppressFunc of %s TagFilterExpression Schema should return true when value can be normalized and old and new value are equal", k), createTestOfDiffSuppressFuncOfTagFilterShouldReturnTrueWhenValueCannotBeNormalizedAndOldAndNewValueAreEqual(tagFilterExpressionSchema))
 		t.Run(fmt.Sprintf("DiffSuppressFunc of %s TagFilterExpression Schema should return false when value cannot be normalized and old and new value are not equal", k), createTestOfDiffSuppressFuncOfTagFilterShouldReturnFalseWhenValueCannotBeNormalizedAndOldAndNewValueAreNotEqual(tagFilterExpressionSchema))
+		t.Run(fmt.Sprintf("DiffSuppressFunc of %s TagFilterExpression Schema should return true when old and new value only differ in the order of operands", k), createTestOfDiffSuppressFuncOfTagFilterShouldReturnTrueWhenOldAndNewValueOnlyDifferInTheOrderOfOperands(tagFilterExpressionSchema))
+		t.Run(fmt.Sprintf("DiffSuppressFunc of %s TagFilterExpression Schema should return false when old value cannot be normalized and new value can be normalized", k), createTestOfDiffSuppressFuncOfTagFilterShouldReturnFalseWhenOldValueCannotBeNormalizedAndNewValueCanBeNormalized(tagFilterExpressionSchema))
 		t.Run(fmt.Sprintf("StateFunc of %s TagFilterExpression Schema should return normalized value when value can be normalized", k), createTestOfStateFuncOfTagFilterShouldReturnNormalizedValueWhenValueCanBeNormalized(tagFilterExpressionSchema))
+		t.Run(fmt.Sprintf("StateFunc of %s TagFilterExpression Schema should return canonical value with sorted operands", k), createTestOfStateFuncOfTagFilterShouldReturnCanonicalValueWithSortedOperands(tagFilterExpressionSchema))
 		t.Run(fmt.Sprintf("StateFunc of %s TagFilterExpression Schema should return provided value when value cannot be normalized", k), createTestOfStateFuncOfTagFilterShouldReturnProvidedValueWhenValueCannotBeNormalized(tagFilterExpressionSchema))
 		t.Run(fmt.Sprintf("ValidateFunc of %s TagFilterExpression Schema should return no errors and warnings when value can be parsed", k), createTestOfValidateFuncOfTagFilterShouldReturnNoErrorsAndWarningsWhenValueCanBeParsed(tagFilterExpressionSchema))
 		t.Run(fmt.Sprintf("ValidateFunc of %s TagFilterExpression Schema should return one error and no warnings when value can be parsed", k), createTestOfValidateFuncOfTagFilterShouldReturnOneErrorAndNoWarningsWhenValueCannotBeParsed(tagFilterExpressionSchema))
@@ -43,6 +46,24 @@ func createTestOfDiffSuppressFuncOfTagFilterShouldReturnFalseWhenValueCanBeNorma
 	}
 }
 
+func createTestOfDiffSuppressFuncOfTagFilterShouldReturnTrueWhenOldAndNewValueOnlyDifferInTheOrderOfOperands(tagFilterSchema *schema.Schema) func(t *testing.T) {
+	return func(t *testing.T) {
+		oldValue := "(entity.type@dest EQUALS 'foo' AND entity.name@dest EQUALS 'bar')"
+		newValue := "entity.name EQUALS 'bar' AND entity.type EQUALS 'foo' AND entity.name EQUALS 'bar'"
+
+		require.True(t, tagFilterSchema.DiffSuppressFunc(tagFilterExpression, oldValue, newValue, nil))
+	}
+}
+
+func createTestOfDiffSuppressFuncOfTagFilterShouldReturnFalseWhenOldValueCannotBeNormalizedAndNewValueCanBeNormalized(tagFilterSchema *schema.Schema) func(t *testing.T) {
+	return func(t *testing.T) {
+		oldValue := invalidTagFilterExpressionString
+		newValue := validTagFilterExpressionString
+
+		require.False(t, tagFilterSchema.DiffSuppressFunc(tagFilterExpression, oldValue, newValue, nil))
+	}
+}
+
 func createTestOfDiffSuppressFuncOfTagFilterShouldReturnTrueWhenValueCannotBeNormalizedAndOldAndNewValueAreEqual(tagFilterSchema *schema.Schema) func(t *testing.T) {
 	return func(t *testing.T) {
 		invalidValue := invalidTagFilterExpressionString
@@ -69,6 +90,14 @@ func createTestOfStateFuncOfTagFilterShouldReturnNormalizedValueWhenValueCanBeNo
 	}
 }
 
+func createTestOfStateFuncOfTagFilterShouldReturnCanonicalValueWithSortedOperands(tagFilterSchema *schema.Schema) func(t *testing.T) {
+	return func(t *testing.T) {
+		value := "entity.type EQUALS 'foo' OR (entity.name EQUALS 'bar' OR entity.name IS_EMPTY)"
+
+		require.Equal(t, "(entity.name@dest EQUALS 'bar' OR entity.name@dest IS_EMPTY OR entity.type@dest EQUALS 'foo')", tagFilterSchema.StateFunc(value))
+	}
+}
+
 func createTestOfStateFuncOfTagFilterShouldReturnProvidedValueWhenValueCannotBeNormalized(tagFilterSchema *schema.Schema) func(t *testing.T) {
 	return func(t *testing.T) {
 		value := invalidTagFilterExpressionString
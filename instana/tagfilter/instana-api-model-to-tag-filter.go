@@ -51,13 +51,23 @@ func (m *tagFilterMapper) mapExpressionElement(input *restapi.TagFilter) (*expre
 }
 
 func (m *tagFilterMapper) mapExpression(tagFilter *restapi.TagFilter) (*expressionHandle, error) {
-	elements := make([]*expressionHandle, len(tagFilter.Elements))
-	var err error
-	for i := 0; i < len(tagFilter.Elements); i++ {
-		elements[i], err = m.mapExpressionElement(tagFilter.Elements[i])
+	elements := make([]*expressionHandle, 0, len(tagFilter.Elements))
+	for i := 0; i < len(tagFilter.Elements); {
+		if listComparison, size := m.collapseListComparison(tagFilter, i); listComparison != nil {
+			elements = append(elements, &expressionHandle{primary: listComparison})
+			i += size
+			continue
+		}
+		element, err := m.mapExpressionElement(tagFilter.Elements[i])
 		if err != nil {
 			return nil, err
 		}
+		elements = append(elements, element)
+		i++
+	}
+	if len(elements) == 1 && len(tagFilter.Elements) > 1 {
+		//all elements are collapsed into a single list comparison
+		return elements[0], nil
 	}
 
 	if *tagFilter.LogicalOperator == restapi.LogicalAnd {
@@ -70,6 +80,63 @@ func (m *tagFilterMapper) mapExpression(tagFilter *restapi.TagFilter) (*expressi
 
 }
 
+// collapseListComparison collapses consecutive string comparisons of the same entity starting at the given index into
+// a list comparison. EQUALS comparisons of a logical OR are collapsed to IN and NOT_EQUAL comparisons of a logical AND
+// are collapsed to NOT_IN. Returns nil when less than two elements can be collapsed.
+func (m *tagFilterMapper) collapseListComparison(tagFilter *restapi.TagFilter, start int) (*PrimaryExpression, int) {
+	operator := restapi.EqualsOperator
+	listOperator := InOperator
+	if *tagFilter.LogicalOperator == restapi.LogicalAnd {
+		operator = restapi.NotEqualOperator
+		listOperator = NotInOperator
+	}
+
+	first := tagFilter.Elements[start]
+	if !m.isCollapsibleStringComparison(first, operator) {
+		return nil, 0
+	}
+	values := []string{*m.mapStringOrTagValue(first)}
+	for _, element := range tagFilter.Elements[start+1:] {
+		if !m.isCollapsibleStringComparison(element, operator) || !m.hasSameEntity(first, element) {
+			break
+		}
+		values = append(values, *m.mapStringOrTagValue(element))
+	}
+	if len(values) < 2 {
+		return nil, 0
+	}
+
+	origin := SupportedEntityOrigins.ForInstanaAPIEntity(*first.Entity)
+	return &PrimaryExpression{
+		Comparison: &ComparisonExpression{
+			Entity:    &EntitySpec{Identifier: *first.Name, TagKey: first.Key, Origin: utils.StringPtr(origin.Key())},
+			Operator:  listOperator,
+			ListValue: values,
+		},
+	}, len(values)
+}
+
+func (m *tagFilterMapper) isCollapsibleStringComparison(tagFilter *restapi.TagFilter, operator restapi.ExpressionOperator) bool {
+	if tagFilter.GetType() != restapi.TagFilterType || tagFilter.Operator == nil || *tagFilter.Operator != operator || tagFilter.Entity == nil || tagFilter.Name == nil {
+		return false
+	}
+	if tagFilter.Key != nil {
+		_, ok := tagFilter.Value.(string)
+		return ok
+	}
+	return tagFilter.StringValue != nil
+}
+
+func (m *tagFilterMapper) hasSameEntity(a *restapi.TagFilter, b *restapi.TagFilter) bool {
+	if *a.Entity != *b.Entity || *a.Name != *b.Name {
+		return false
+	}
+	if a.Key == nil || b.Key == nil {
+		return a.Key == b.Key
+	}
+	return *a.Key == *b.Key
+}
+
 func (m *tagFilterMapper) mapLogicalOr(elements []*expressionHandle) (*expressionHandle, error) {
 	total := len(elements)
 	if total < 2 {
@@ -195,6 +262,7 @@ func (m *tagFilterMapper) mapTagFilter(tagFilter *restapi.TagFilter) (*PrimaryEx
 			StringValue:  m.mapStringOrTagValue(tagFilter),
 			BooleanValue: tagFilter.BooleanValue,
 			NumberValue:  tagFilter.NumberValue,
+			FloatValue:   tagFilter.FloatValue,
 		},
 	}, nil
 }
@@ -57,6 +57,19 @@ func TestShouldMapNumberTagFilterFromInstanaAPI(t *testing.T) {
 	testMappingOfTagFilterFromInstanaApi(input, comparison, t)
 }
 
+func TestShouldMapFloatTagFilterFromInstanaAPI(t *testing.T) {
+	value := 12.34
+	input := restapi.NewFloatTagFilter(restapi.TagFilterEntityDestination, tagFilterName, restapi.GreaterThanOperator, value)
+
+	comparison := &ComparisonExpression{
+		Entity:     &EntitySpec{Identifier: tagFilterName, Origin: utils.StringPtr(EntityOriginDestination.Key())},
+		Operator:   Operator(restapi.GreaterThanOperator),
+		FloatValue: &value,
+	}
+
+	testMappingOfTagFilterFromInstanaApi(input, comparison, t)
+}
+
 func TestShouldMapBooleanTagFilterFromInstanaAPI(t *testing.T) {
 	value := true
 	input := restapi.NewBooleanTagFilter(restapi.TagFilterEntityDestination, tagFilterName, restapi.EqualsOperator, value)
@@ -792,6 +805,112 @@ func TestShouldReturnMappingErrorWhenAnyElementOfTagFilterExpressionIsNotValid(t
 	}
 }
 
+func TestShouldCollapseLogicalOrOfEqualsComparisonsOfTheSameEntityToInListFromInstanaAPI(t *testing.T) {
+	input := restapi.NewLogicalOrTagFilter([]*restapi.TagFilter{
+		restapi.NewStringTagFilter(restapi.TagFilterEntityDestination, tagFilterName, restapi.EqualsOperator, "a"),
+		restapi.NewStringTagFilter(restapi.TagFilterEntityDestination, tagFilterName, restapi.EqualsOperator, "b"),
+		restapi.NewStringTagFilter(restapi.TagFilterEntityDestination, tagFilterName, restapi.EqualsOperator, "c"),
+	})
+
+	expectedResult := &FilterExpression{
+		Expression: &LogicalOrExpression{
+			Left: &LogicalAndExpression{
+				Left: &BracketExpression{
+					Primary: &PrimaryExpression{
+						Comparison: &ComparisonExpression{
+							Entity:    &EntitySpec{Identifier: tagFilterName, Origin: utils.StringPtr(EntityOriginDestination.Key())},
+							Operator:  InOperator,
+							ListValue: []string{"a", "b", "c"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	runTestCaseForMappingFromAPI(input, expectedResult, t)
+}
+
+func TestShouldCollapseListComparisonsFromInstanaAPI(t *testing.T) {
+	testSets := []struct {
+		name     string
+		input    *restapi.TagFilter
+		expected string
+	}{
+		{
+			name: "LogicalAndOfNotEqualComparisons",
+			input: restapi.NewLogicalAndTagFilter([]*restapi.TagFilter{
+				restapi.NewStringTagFilter(restapi.TagFilterEntitySource, tagFilterName, restapi.NotEqualOperator, "a"),
+				restapi.NewStringTagFilter(restapi.TagFilterEntitySource, tagFilterName, restapi.NotEqualOperator, "b"),
+			}),
+			expected: "name@src NOT_IN ('a', 'b')",
+		},
+		{
+			name: "LogicalOrOfTagComparisons",
+			input: restapi.NewLogicalOrTagFilter([]*restapi.TagFilter{
+				restapi.NewTagTagFilter(restapi.TagFilterEntityDestination, tagFilterName, restapi.EqualsOperator, "key", "a"),
+				restapi.NewTagTagFilter(restapi.TagFilterEntityDestination, tagFilterName, restapi.EqualsOperator, "key", "b"),
+				restapi.NewTagTagFilter(restapi.TagFilterEntityDestination, tagFilterName, restapi.EqualsOperator, "other", "c"),
+			}),
+			expected: "(name:'key'@dest IN ('a', 'b') OR name:'other'@dest EQUALS 'c')",
+		},
+		{
+			name: "ConsecutiveComparisonsOnly",
+			input: restapi.NewLogicalOrTagFilter([]*restapi.TagFilter{
+				restapi.NewStringTagFilter(restapi.TagFilterEntityDestination, tagFilterName, restapi.EqualsOperator, "a"),
+				restapi.NewStringTagFilter(restapi.TagFilterEntityDestination, "other", restapi.EqualsOperator, "b"),
+				restapi.NewStringTagFilter(restapi.TagFilterEntityDestination, tagFilterName, restapi.EqualsOperator, "c"),
+				restapi.NewStringTagFilter(restapi.TagFilterEntityDestination, tagFilterName, restapi.EqualsOperator, "d"),
+			}),
+			expected: "(name@dest EQUALS 'a' OR other@dest EQUALS 'b' OR name@dest IN ('c', 'd'))",
+		},
+		{
+			name: "DifferentEntityOrigins",
+			input: restapi.NewLogicalOrTagFilter([]*restapi.TagFilter{
+				restapi.NewStringTagFilter(restapi.TagFilterEntitySource, tagFilterName, restapi.EqualsOperator, "a"),
+				restapi.NewStringTagFilter(restapi.TagFilterEntityDestination, tagFilterName, restapi.EqualsOperator, "b"),
+			}),
+			expected: "(name@src EQUALS 'a' OR name@dest EQUALS 'b')",
+		},
+		{
+			name: "NotEqualComparisonsOfLogicalOr",
+			input: restapi.NewLogicalOrTagFilter([]*restapi.TagFilter{
+				restapi.NewStringTagFilter(restapi.TagFilterEntityDestination, tagFilterName, restapi.NotEqualOperator, "a"),
+				restapi.NewStringTagFilter(restapi.TagFilterEntityDestination, tagFilterName, restapi.NotEqualOperator, "b"),
+			}),
+			expected: "(name@dest NOT_EQUAL 'a' OR name@dest NOT_EQUAL 'b')",
+		},
+		{
+			name: "NumberComparisons",
+			input: restapi.NewLogicalOrTagFilter([]*restapi.TagFilter{
+				restapi.NewNumberTagFilter(restapi.TagFilterEntityDestination, tagFilterName, restapi.EqualsOperator, 1),
+				restapi.NewNumberTagFilter(restapi.TagFilterEntityDestination, tagFilterName, restapi.EqualsOperator, 2),
+			}),
+			expected: "(name@dest EQUALS 1 OR name@dest EQUALS 2)",
+		},
+		{
+			name: "NestedLogicalOr",
+			input: restapi.NewLogicalAndTagFilter([]*restapi.TagFilter{
+				restapi.NewStringTagFilter(restapi.TagFilterEntityDestination, "other", restapi.EqualsOperator, "x"),
+				restapi.NewLogicalOrTagFilter([]*restapi.TagFilter{
+					restapi.NewStringTagFilter(restapi.TagFilterEntityDestination, tagFilterName, restapi.EqualsOperator, "a"),
+					restapi.NewStringTagFilter(restapi.TagFilterEntityDestination, tagFilterName, restapi.EqualsOperator, "b"),
+				}),
+			}),
+			expected: "(other@dest EQUALS 'x' AND name@dest IN ('a', 'b'))",
+		},
+	}
+
+	for _, testSet := range testSets {
+		t.Run(testSet.name, func(t *testing.T) {
+			result, err := MapTagFilterToNormalizedString(testSet.input)
+
+			require.NoError(t, err)
+			require.Equal(t, testSet.expected, *result)
+		})
+	}
+}
+
 func runTestCaseForMappingFromAPI(input *restapi.TagFilter, expectedResult *FilterExpression, t *testing.T) {
 	mapper := NewMapper()
 	result, err := mapper.FromAPIModel(input)
@@ -0,0 +1,104 @@
+package tagfilter
+
+import (
+	"sort"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+)
+
+// Canonicalize parses the input and returns the canonical representation of the input string. In addition to the
+// normalization, nested AND and OR chains are flattened, the operands of AND and OR are sorted and duplicate operands are
+// removed. Therefore, expressions which only differ in the order of their operands result in the same canonical string.
+func Canonicalize(input string) (string, error) {
+	parser := NewParser()
+	mapper := &tagFilterMapper{}
+
+	parsed, err := parser.Parse(input)
+	if err != nil {
+		return input, err
+	}
+
+	canonical, err := mapper.canonicalize(mapper.ToAPIModel(parsed))
+	if err != nil {
+		return input, err
+	}
+	mapped, err := mapper.FromAPIModel(canonical)
+	if err != nil {
+		return input, err
+	}
+
+	return mapped.Render(), nil
+}
+
+// canonicalize returns the canonical form of the given tag filter of the Instana API model
+func (m *tagFilterMapper) canonicalize(input *restapi.TagFilter) (*restapi.TagFilter, error) {
+	if input.GetType() != restapi.TagFilterExpressionType || input.LogicalOperator == nil || len(input.Elements) == 0 {
+		return input, nil
+	}
+
+	elements, err := m.flattenCanonicalElements(input)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make(map[*restapi.TagFilter]string, len(elements))
+	uniqueElements := make([]*restapi.TagFilter, 0, len(elements))
+	seen := make(map[string]bool, len(elements))
+	for _, element := range elements {
+		key, err := m.canonicalKey(element)
+		if err != nil {
+			return nil, err
+		}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		keys[element] = key
+		uniqueElements = append(uniqueElements, element)
+	}
+	sort.SliceStable(uniqueElements, func(i, j int) bool {
+		return keys[uniqueElements[i]] < keys[uniqueElements[j]]
+	})
+
+	if len(uniqueElements) == 1 {
+		return uniqueElements[0], nil
+	}
+	if *input.LogicalOperator == restapi.LogicalAnd {
+		return restapi.NewLogicalAndTagFilter(uniqueElements), nil
+	}
+	return restapi.NewLogicalOrTagFilter(uniqueElements), nil
+}
+
+// flattenCanonicalElements canonicalizes the elements of the given logical expression and inlines the elements of nested
+// expressions which use the same logical operator
+func (m *tagFilterMapper) flattenCanonicalElements(input *restapi.TagFilter) ([]*restapi.TagFilter, error) {
+	elements := make([]*restapi.TagFilter, 0, len(input.Elements))
+	for _, element := range input.Elements {
+		canonicalElement, err := m.canonicalize(element)
+		if err != nil {
+			return nil, err
+		}
+		if m.isLogicalExpressionWithOperator(canonicalElement, *input.LogicalOperator) {
+			elements = append(elements, canonicalElement.Elements...)
+		} else {
+			elements = append(elements, canonicalElement)
+		}
+	}
+	return elements, nil
+}
+
+func (m *tagFilterMapper) isLogicalExpressionWithOperator(input *restapi.TagFilter, operator restapi.LogicalOperatorType) bool {
+	return input.GetType() == restapi.TagFilterExpressionType && input.LogicalOperator != nil && *input.LogicalOperator == operator
+}
+
+// canonicalKey returns the rendered string of the given canonical element which is used to sort and deduplicate operands
+func (m *tagFilterMapper) canonicalKey(input *restapi.TagFilter) (string, error) {
+	expr, err := m.FromAPIModel(input)
+	if err != nil {
+		return "", err
+	}
+	if expr == nil {
+		return "", nil
+	}
+	return expr.Render(), nil
+}
@@ -0,0 +1,101 @@
+package tagfilter_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/tagfilter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldCanonicalizeExpressionAndProduceTheSameResultWhenPerformedMultipleTimesOnOutput(t *testing.T) {
+	testSets := []normalizationTestSet{
+		{
+			name:     "SingleComparison",
+			input:    "entity.name    EQUALS 'foo'",
+			expected: "entity.name@dest EQUALS 'foo'",
+		},
+		{
+			name:     "SortedAndOperands",
+			input:    "entity.type EQUALS 'y' AND entity.name EQUALS 'x'",
+			expected: "(entity.name@dest EQUALS 'x' AND entity.type@dest EQUALS 'y')",
+		},
+		{
+			name:     "SortedOrOperands",
+			input:    "entity.type EQUALS 'y' OR entity.name IS_EMPTY",
+			expected: "(entity.name@dest IS_EMPTY OR entity.type@dest EQUALS 'y')",
+		},
+		{
+			name:     "FlattenedNestedAnd",
+			input:    "entity.c IS_EMPTY AND (entity.b IS_EMPTY AND entity.a IS_EMPTY)",
+			expected: "(entity.a@dest IS_EMPTY AND entity.b@dest IS_EMPTY AND entity.c@dest IS_EMPTY)",
+		},
+		{
+			name:     "FlattenedNestedOr",
+			input:    "(entity.c IS_EMPTY OR entity.b IS_EMPTY) OR entity.a IS_EMPTY",
+			expected: "(entity.a@dest IS_EMPTY OR entity.b@dest IS_EMPTY OR entity.c@dest IS_EMPTY)",
+		},
+		{
+			name:     "DeduplicatedOperands",
+			input:    "entity.a IS_EMPTY AND entity.b IS_EMPTY AND entity.a IS_EMPTY",
+			expected: "(entity.a@dest IS_EMPTY AND entity.b@dest IS_EMPTY)",
+		},
+		{
+			name:     "DeduplicatedToSingleOperand",
+			input:    "entity.a IS_EMPTY OR entity.a IS_EMPTY",
+			expected: "entity.a@dest IS_EMPTY",
+		},
+		{
+			name:     "SortedNestedExpressions",
+			input:    "(entity.d IS_EMPTY AND entity.c IS_EMPTY) OR (entity.b IS_EMPTY AND entity.a IS_EMPTY)",
+			expected: "((entity.a@dest IS_EMPTY AND entity.b@dest IS_EMPTY) OR (entity.c@dest IS_EMPTY AND entity.d@dest IS_EMPTY))",
+		},
+		{
+			name:     "DeduplicatedNestedExpressions",
+			input:    "(entity.b IS_EMPTY AND entity.a IS_EMPTY) OR entity.c IS_EMPTY OR (entity.a IS_EMPTY AND entity.b IS_EMPTY)",
+			expected: "((entity.a@dest IS_EMPTY AND entity.b@dest IS_EMPTY) OR entity.c@dest IS_EMPTY)",
+		},
+		{
+			name:     "SortedListValues",
+			input:    "entity.name EQUALS 'b' OR entity.type EQUALS 'z' OR entity.name EQUALS 'a'",
+			expected: "(entity.name@dest IN ('a', 'b') OR entity.type@dest EQUALS 'z')",
+		},
+		{
+			name:     "SortedNegatedList",
+			input:    "NOT (entity.name IN ('b', 'a', 'b'))",
+			expected: "entity.name@dest NOT_IN ('a', 'b')",
+		},
+	}
+
+	for _, s := range testSets {
+		input := s.input
+		for i := 0; i < 3; i++ {
+			t.Run(fmt.Sprintf("TestShouldCanonicalizeExpression%s%d", s.name, i), func(t *testing.T) {
+
+				result, err := Canonicalize(input)
+				require.NoError(t, err)
+				require.Equal(t, s.expected, result)
+
+				input = result
+			})
+		}
+	}
+}
+
+func TestShouldProduceTheSameCanonicalFormForExpressionsWhichOnlyDifferInTheOrderOfOperands(t *testing.T) {
+	first, err := Canonicalize("entity.a EQUALS 'x' AND (entity.b EQUALS 'y' OR entity.c EQUALS 'z')")
+	require.NoError(t, err)
+
+	second, err := Canonicalize("(entity.c EQUALS 'z' OR entity.b EQUALS 'y') AND entity.a EQUALS 'x'")
+	require.NoError(t, err)
+
+	require.Equal(t, first, second)
+}
+
+func TestShouldFailToCanonicalizeExpressionWhenExpressionIsNotValid(t *testing.T) {
+	input := "entity.name    bla bla bla"
+
+	result, err := Canonicalize(input)
+	require.Error(t, err)
+	require.Equal(t, input, result)
+}
@@ -0,0 +1,194 @@
+package tagfilter
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+)
+
+const (
+	maxTagSuggestions        = 3
+	maxTagSuggestionDistance = 3
+)
+
+var stringTagOperators = restapi.ExpressionOperators{
+	restapi.EqualsOperator,
+	restapi.NotEqualOperator,
+	restapi.ContainsOperator,
+	restapi.NotContainOperator,
+	restapi.StartsWithOperator,
+	restapi.EndsWithOperator,
+	restapi.NotStartsWithOperator,
+	restapi.NotEndsWithOperator,
+	restapi.IsEmptyOperator,
+	restapi.NotEmptyOperator,
+	restapi.IsBlankOperator,
+	restapi.NotBlankOperator,
+}
+
+// supportedOperatorsByTagType defines the operators which are supported for the tag types of the Instana tag catalog.
+// Tag types which are not listed are not validated.
+var supportedOperatorsByTagType = map[restapi.TagType]restapi.ExpressionOperators{
+	restapi.TagTypeString:       stringTagOperators,
+	restapi.TagTypeStringSet:    stringTagOperators,
+	restapi.TagTypeStringList:   stringTagOperators,
+	restapi.TagTypeKeyValuePair: stringTagOperators,
+	restapi.TagTypeNumber: {
+		restapi.EqualsOperator,
+		restapi.NotEqualOperator,
+		restapi.GreaterOrEqualThanOperator,
+		restapi.LessOrEqualThanOperator,
+		restapi.GreaterThanOperator,
+		restapi.LessThanOperator,
+		restapi.IsEmptyOperator,
+		restapi.NotEmptyOperator,
+	},
+	restapi.TagTypeBoolean: {
+		restapi.EqualsOperator,
+		restapi.NotEqualOperator,
+		restapi.IsEmptyOperator,
+		restapi.NotEmptyOperator,
+	},
+}
+
+// NewCatalogValidator creates a new CatalogValidator for the given tags of the Instana tag catalog
+func NewCatalogValidator(tags []*restapi.Tag) CatalogValidator {
+	tagsByName := make(map[string]*restapi.Tag, len(tags))
+	for _, t := range tags {
+		tagsByName[t.Name] = t
+	}
+	return &catalogValidatorImpl{tags: tagsByName}
+}
+
+// CatalogValidator validates tag filter expressions against the tags of the Instana tag catalog
+type CatalogValidator interface {
+	// Validate returns one error for each unknown tag and each operator which is not supported for the type of the tag
+	Validate(expression *FilterExpression) []error
+}
+
+type catalogValidatorImpl struct {
+	tags map[string]*restapi.Tag
+}
+
+// Validate implementation of CatalogValidator.Validate
+func (v *catalogValidatorImpl) Validate(expression *FilterExpression) []error {
+	if expression == nil {
+		return nil
+	}
+	return v.validateLogicalOr(expression.Expression)
+}
+
+func (v *catalogValidatorImpl) validateLogicalOr(expression *LogicalOrExpression) []error {
+	if expression == nil {
+		return nil
+	}
+	return append(v.validateLogicalAnd(expression.Left), v.validateLogicalOr(expression.Right)...)
+}
+
+func (v *catalogValidatorImpl) validateLogicalAnd(expression *LogicalAndExpression) []error {
+	if expression == nil {
+		return nil
+	}
+	return append(v.validateBracket(expression.Left), v.validateLogicalAnd(expression.Right)...)
+}
+
+func (v *catalogValidatorImpl) validateBracket(expression *BracketExpression) []error {
+	if expression.Negation != nil {
+		return v.validateLogicalOr(expression.Negation)
+	}
+	if expression.Bracket != nil {
+		return v.validateLogicalOr(expression.Bracket)
+	}
+	if expression.Primary.Comparison != nil {
+		return v.validateEntity(expression.Primary.Comparison.Entity, v.toAPIOperator(expression.Primary.Comparison.Operator))
+	}
+	return v.validateEntity(expression.Primary.UnaryOperation.Entity, restapi.ExpressionOperator(expression.Primary.UnaryOperation.Operator))
+}
+
+// toAPIOperator maps the list operators to the operators they are expanded to for the Instana API
+func (v *catalogValidatorImpl) toAPIOperator(operator Operator) restapi.ExpressionOperator {
+	if operator == InOperator {
+		return restapi.EqualsOperator
+	} else if operator == NotInOperator {
+		return restapi.NotEqualOperator
+	}
+	return restapi.ExpressionOperator(operator)
+}
+
+func (v *catalogValidatorImpl) validateEntity(entity *EntitySpec, operator restapi.ExpressionOperator) []error {
+	tag, ok := v.tags[entity.Identifier]
+	if !ok {
+		return []error{v.unknownTagError(entity.Identifier)}
+	}
+	supportedOperators, ok := supportedOperatorsByTagType[tag.Type]
+	if ok && !supportedOperators.IsSupported(operator) {
+		return []error{fmt.Errorf("operator %s is not supported for tag '%s' of type %s; supported operators are %s", operator, tag.Name, tag.Type, strings.Join(supportedOperators.ToStringSlice(), ", "))}
+	}
+	return nil
+}
+
+func (v *catalogValidatorImpl) unknownTagError(name string) error {
+	suggestions := v.suggestTags(name)
+	if len(suggestions) == 0 {
+		return fmt.Errorf("unknown tag '%s'", name)
+	} else if len(suggestions) == 1 {
+		return fmt.Errorf("unknown tag '%s'; did you mean '%s'?", name, suggestions[0])
+	}
+	return fmt.Errorf("unknown tag '%s'; did you mean one of '%s'?", name, strings.Join(suggestions, "', '"))
+}
+
+// suggestTags returns the names of the most similar tags of the catalog ordered by their similarity
+func (v *catalogValidatorImpl) suggestTags(name string) []string {
+	distances := make(map[string]int)
+	candidates := make([]string, 0)
+	for tagName := range v.tags {
+		distance := levenshteinDistance(strings.ToLower(name), strings.ToLower(tagName))
+		if distance <= maxTagSuggestionDistance {
+			distances[tagName] = distance
+			candidates = append(candidates, tagName)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if distances[candidates[i]] != distances[candidates[j]] {
+			return distances[candidates[i]] < distances[candidates[j]]
+		}
+		return candidates[i] < candidates[j]
+	})
+	if len(candidates) > maxTagSuggestions {
+		return candidates[:maxTagSuggestions]
+	}
+	return candidates
+}
+
+// levenshteinDistance calculates the minimum number of single character insertions, deletions and substitutions which
+// are required to change a into b
+func levenshteinDistance(a string, b string) int {
+	source := []rune(a)
+	target := []rune(b)
+	previous := make([]int, len(target)+1)
+	current := make([]int, len(target)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(source); i++ {
+		current[0] = i
+		for j := 1; j <= len(target); j++ {
+			cost := 1
+			if source[i-1] == target[j-1] {
+				cost = 0
+			}
+			current[j] = minInt(minInt(previous[j]+1, current[j-1]+1), previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(target)]
+}
+
+func minInt(a int, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
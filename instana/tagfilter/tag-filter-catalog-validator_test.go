@@ -0,0 +1,109 @@
+package tagfilter_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	. "github.com/gessnerfl/terraform-provider-instana/instana/tagfilter"
+	"github.com/stretchr/testify/require"
+)
+
+var catalogTags = []*restapi.Tag{
+	{Name: "service.name", Type: restapi.TagTypeString},
+	{Name: "service.id", Type: restapi.TagTypeString},
+	{Name: "call.http.status", Type: restapi.TagTypeNumber},
+	{Name: "call.erroneous", Type: restapi.TagTypeBoolean},
+	{Name: "call.tag", Type: restapi.TagTypeKeyValuePair},
+	{Name: "call.custom", Type: restapi.TagType("UNKNOWN")},
+}
+
+func TestShouldAcceptExpressionsWithKnownTagsAndSupportedOperators(t *testing.T) {
+	expressions := []string{
+		"service.name EQUALS 'foo'",
+		"service.name IN ('foo', 'bar') AND service.id NOT_IN ('a')",
+		"service.name IS_BLANK OR call.http.status NOT_EMPTY",
+		"call.http.status GREATER_OR_EQUAL_THAN 500 AND call.http.status LESS_THAN 599.5",
+		"call.erroneous EQUALS true",
+		"call.tag:key CONTAINS 'value'",
+		"call.custom GREATER_THAN 1",
+		"NOT (service.name STARTS_WITH 'a' OR (call.erroneous NOT_EQUAL false))",
+	}
+	for _, expression := range expressions {
+		t.Run(expression, func(t *testing.T) {
+			errs := validateAgainstCatalog(expression, t)
+
+			require.Empty(t, errs)
+		})
+	}
+}
+
+func TestShouldRejectUnsupportedOperatorsForTagTypes(t *testing.T) {
+	testCases := map[string]string{
+		"service.name GREATER_THAN 'foo'":  "operator GREATER_THAN is not supported for tag 'service.name' of type STRING",
+		"call.http.status CONTAINS '20'":   "operator CONTAINS is not supported for tag 'call.http.status' of type NUMBER",
+		"call.http.status IS_BLANK":        "operator IS_BLANK is not supported for tag 'call.http.status' of type NUMBER",
+		"call.erroneous LESS_THAN 1":       "operator LESS_THAN is not supported for tag 'call.erroneous' of type BOOLEAN",
+		"call.tag:key GREATER_THAN 'v'":    "operator GREATER_THAN is not supported for tag 'call.tag' of type KEY_VALUE_PAIR",
+		"call.erroneous STARTS_WITH 'tru'": "operator STARTS_WITH is not supported for tag 'call.erroneous' of type BOOLEAN",
+	}
+	for expression, expectedError := range testCases {
+		t.Run(expression, func(t *testing.T) {
+			errs := validateAgainstCatalog(expression, t)
+
+			require.Len(t, errs, 1)
+			require.ErrorContains(t, errs[0], expectedError)
+		})
+	}
+}
+
+func TestShouldReportUnknownTagsWithSuggestions(t *testing.T) {
+	testCases := map[string]string{
+		"servce.name EQUALS 'foo'":         "unknown tag 'servce.name'; did you mean 'service.name'?",
+		"Service.Name EQUALS 'foo'":        "unknown tag 'Service.Name'; did you mean 'service.name'?",
+		"service.nam EQUALS 'foo'":         "unknown tag 'service.nam'; did you mean one of 'service.name', 'service.id'?",
+		"application.name EQUALS 'foo'":    "unknown tag 'application.name'",
+		"call.http.statu GREATER_THAN 200": "unknown tag 'call.http.statu'; did you mean 'call.http.status'?",
+	}
+	for expression, expectedError := range testCases {
+		t.Run(expression, func(t *testing.T) {
+			errs := validateAgainstCatalog(expression, t)
+
+			require.Len(t, errs, 1)
+			require.EqualError(t, errs[0], expectedError)
+		})
+	}
+}
+
+func TestShouldLimitNumberOfTagSuggestions(t *testing.T) {
+	tags := make([]*restapi.Tag, 0)
+	for i := 0; i < 5; i++ {
+		tags = append(tags, &restapi.Tag{Name: fmt.Sprintf("tag.%d", i), Type: restapi.TagTypeString})
+	}
+	expression, err := NewParser().Parse("tag.x EQUALS 'foo'")
+	require.NoError(t, err)
+
+	errs := NewCatalogValidator(tags).Validate(expression)
+
+	require.Len(t, errs, 1)
+	require.EqualError(t, errs[0], "unknown tag 'tag.x'; did you mean one of 'tag.0', 'tag.1', 'tag.2'?")
+}
+
+func TestShouldReportAllViolationsOfExpression(t *testing.T) {
+	errs := validateAgainstCatalog("servce.name EQUALS 'foo' AND (call.http.status CONTAINS '5' OR NOT (service.name LESS_THAN 'x'))", t)
+
+	require.Len(t, errs, 3)
+	require.ErrorContains(t, errs[0], "unknown tag 'servce.name'")
+	require.ErrorContains(t, errs[1], "operator CONTAINS is not supported for tag 'call.http.status'")
+	require.ErrorContains(t, errs[2], "operator LESS_THAN is not supported for tag 'service.name'")
+}
+
+func TestShouldAcceptNilExpressionWhenValidatingAgainstCatalog(t *testing.T) {
+	require.Empty(t, NewCatalogValidator(catalogTags).Validate(nil))
+}
+
+func validateAgainstCatalog(input string, t *testing.T) []error {
+	expression, err := NewParser().Parse(input)
+	require.NoError(t, err)
+	return NewCatalogValidator(catalogTags).Validate(expression)
+}
@@ -3,6 +3,8 @@ package tagfilter
 import (
 	"fmt"
 	"log"
+	"math"
+	"strconv"
 	"strings"
 
 	"github.com/alecthomas/participle"
@@ -91,7 +93,7 @@ func (o *EntitySpec) Render() string {
 	origin := EntityOriginDestination.Key()
 	tagKey := ""
 	if o.TagKey != nil {
-		tagKey = ":'" + *o.TagKey + "'"
+		tagKey = ":" + quote(*o.TagKey)
 	}
 	if o.Origin != nil {
 		origin = SupportedEntityOrigins.ForKey(*o.Origin).Key()
@@ -148,14 +150,18 @@ func (e *LogicalAndExpression) Render() string {
 	return e.Left.Render()
 }
 
-// BracketExpression representation of a bracket expression
+// BracketExpression representation of a bracket expression or a negated bracket expression
 type BracketExpression struct {
-	Bracket *LogicalOrExpression `parser:"\"(\" @@ \")\""`
-	Primary *PrimaryExpression   `parser:"| @@"`
+	Negation *LogicalOrExpression `parser:"  \"NOT\" \"(\" @@ \")\""`
+	Bracket  *LogicalOrExpression `parser:"| \"(\" @@ \")\""`
+	Primary  *PrimaryExpression   `parser:"| @@"`
 }
 
 // Render implementation of ExpressionRenderer.Render
 func (e *BracketExpression) Render() string {
+	if e.Negation != nil {
+		return "NOT (" + e.Negation.Render() + ")"
+	}
 	if e.Bracket != nil {
 		return "(" + e.Bracket.Render() + ")"
 	}
@@ -176,23 +182,72 @@ func (e *PrimaryExpression) Render() string {
 	return e.UnaryOperation.Render()
 }
 
+const (
+	//InOperator constant for the IN list operator of the tag filter expression. The operator is not supported by the Instana API and is expanded to a logical OR of EQUALS comparisons
+	InOperator = Operator("IN")
+	//NotInOperator constant for the NOT_IN list operator of the tag filter expression. The operator is not supported by the Instana API and is expanded to a logical AND of NOT_EQUAL comparisons
+	NotInOperator = Operator("NOT_IN")
+)
+
 // ComparisonExpression representation of a comparison expression.
 type ComparisonExpression struct {
 	Entity       *EntitySpec `parser:"@@"`
-	Operator     Operator    `parser:"@( \"EQUALS\" | \"NOT_EQUAL\" | \"CONTAINS\" | \"NOT_CONTAIN\" | \"STARTS_WITH\" | \"ENDS_WITH\" | \"NOT_STARTS_WITH\" | \"NOT_ENDS_WITH\" | \"GREATER_OR_EQUAL_THAN\" | \"LESS_OR_EQUAL_THAN\" | \"LESS_THAN\" | \"GREATER_THAN\" )"`
+	Operator     Operator    `parser:"@( \"EQUALS\" | \"NOT_EQUAL\" | \"CONTAINS\" | \"NOT_CONTAIN\" | \"STARTS_WITH\" | \"ENDS_WITH\" | \"NOT_STARTS_WITH\" | \"NOT_ENDS_WITH\" | \"GREATER_OR_EQUAL_THAN\" | \"LESS_OR_EQUAL_THAN\" | \"LESS_THAN\" | \"GREATER_THAN\" | \"IN\" | \"NOT_IN\" )"`
 	NumberValue  *int64      `parser:"( @Number"`
+	FloatValue   *float64    `parser:"| @Float"`
 	BooleanValue *bool       `parser:"| @( \"FALSE\" | \"TRUE\" )"`
-	StringValue  *string     `parser:"| @String )"`
+	StringValue  *string     `parser:"| @String"`
+	ListValue    []string    `parser:"| \"(\" @String ( \",\" @String )* \")\" )"`
+}
+
+// IsListComparison returns true when the comparison uses one of the list operators IN or NOT_IN
+func (e *ComparisonExpression) IsListComparison() bool {
+	return e.Operator == InOperator || e.Operator == NotInOperator
+}
+
+func (e *ComparisonExpression) validate() error {
+	if e.IsListComparison() && len(e.ListValue) == 0 {
+		return fmt.Errorf("operator %s requires a list of string values", e.Operator)
+	}
+	if !e.IsListComparison() && len(e.ListValue) > 0 {
+		return fmt.Errorf("operator %s does not support a list of values; use %s or %s instead", e.Operator, InOperator, NotInOperator)
+	}
+	return nil
 }
 
 // Render implementation of ExpressionRenderer.Render
 func (e *ComparisonExpression) Render() string {
-	if e.NumberValue != nil {
+	if len(e.ListValue) > 0 {
+		values := make([]string, len(e.ListValue))
+		for i, v := range e.ListValue {
+			values[i] = quote(v)
+		}
+		return fmt.Sprintf("%s %s (%s)", e.Entity.Render(), e.Operator, strings.Join(values, ", "))
+	} else if e.NumberValue != nil {
 		return fmt.Sprintf("%s %s %d", e.Entity.Render(), e.Operator, *e.NumberValue)
+	} else if e.FloatValue != nil {
+		return fmt.Sprintf("%s %s %s", e.Entity.Render(), e.Operator, formatFloat(*e.FloatValue))
 	} else if e.BooleanValue != nil {
 		return fmt.Sprintf("%s %s %t", e.Entity.Render(), e.Operator, *e.BooleanValue)
 	}
-	return fmt.Sprintf("%s %s '%s'", e.Entity.Render(), e.Operator, *e.StringValue)
+	return fmt.Sprintf("%s %s %s", e.Entity.Render(), e.Operator, quote(*e.StringValue))
+}
+
+var quoteEscaper = strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+
+// quote renders the given value as single quoted string. Backslashes and single quotes are escaped by a backslash.
+func quote(value string) string {
+	return "'" + quoteEscaper.Replace(value) + "'"
+}
+
+// formatFloat renders the given floating point value in its shortest representation which can be parsed again. Values
+// within the int64 range are rendered without exponent so that integral values are rendered in the same way as the
+// integer value returned by the Instana API.
+func formatFloat(value float64) string {
+	if value >= math.MinInt64 && value < math.MaxInt64 {
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64)
 }
 
 // UnaryOperationExpression representation of a unary expression
@@ -207,25 +262,40 @@ func (e *UnaryOperationExpression) Render() string {
 }
 
 var (
+	// The negation keyword NOT and the list operators IN and NOT_IN are lexed as Ident and matched case-insensitively by
+	// the grammar in the position of a negation or an operator. Therefore, tag names starting with one of the keywords
+	// like not.x or in.x are still valid identifiers.
 	filterLexer = lexer.Must(lexer.Regexp(`(\s+)` +
 		`|(?P<Keyword>(?i)OR|AND|TRUE|FALSE|IS_EMPTY|NOT_EMPTY|IS_BLANK|NOT_BLANK|EQUALS|NOT_EQUAL|CONTAINS|NOT_CONTAIN|STARTS_WITH|ENDS_WITH|NOT_STARTS_WITH|NOT_ENDS_WITH|GREATER_OR_EQUAL_THAN|LESS_OR_EQUAL_THAN|LESS_THAN|GREATER_THAN)` +
 		`|(?P<EntityOrigin>(?i)src|dest|na)` +
 		`|(?P<EntityOriginOperator>(?i)@)` +
 		`|(?P<Bracket>[\(\)])` +
+		`|(?P<Separator>,)` +
 		`|(?P<TagKeySeparator>(?i):)` +
 		`|(?P<Ident>[a-zA-Z_][\.a-zA-Z0-9_\-/]*)` +
+		`|(?P<Float>[-+]?(\d+\.\d*|\.\d+)([eE][-+]?\d+)?|[-+]?\d+[eE][-+]?\d+)` +
 		`|(?P<Number>[-+]?\d+)` +
-		`|(?P<String>'[^']*'|"[^"]*")`,
+		`|(?P<String>'(\\.|[^'\\])*'|"(\\.|[^"\\])*")`,
 	))
 	filterParser = participle.MustBuild(
 		&FilterExpression{},
 		participle.Lexer(filterLexer),
 		participle.Unquote("String"),
-		participle.CaseInsensitive("Keyword"),
+		participle.Map(validateFloat, "Float"),
+		participle.CaseInsensitive("Keyword", "Ident"),
 		participle.UseLookahead(5),
 	)
 )
 
+// validateFloat rejects float literals which are out of the range of 64-bit floating point numbers. The syntax of the
+// literal is already ensured by the lexer.
+func validateFloat(token lexer.Token) (lexer.Token, error) {
+	if _, err := strconv.ParseFloat(token.Value, 64); err != nil {
+		return token, lexer.ErrorWithTokenf(token, "invalid float %s: value out of range", token.Value)
+	}
+	return token, nil
+}
+
 // Normalize parses the input and returns the normalized representation of the input string
 func Normalize(input string) (string, error) {
 	parser := NewParser()
@@ -264,5 +334,42 @@ func (f *parserImpl) Parse(expression string) (*FilterExpression, error) {
 	if err != nil {
 		return &FilterExpression{}, err
 	}
+	if err = f.validateLogicalOr(parsedExpression.Expression); err != nil {
+		return &FilterExpression{}, err
+	}
 	return parsedExpression, nil
 }
+
+// validateLogicalOr validates the semantic constraints of the parsed expression which cannot be expressed by the grammar
+func (f *parserImpl) validateLogicalOr(e *LogicalOrExpression) error {
+	if err := f.validateLogicalAnd(e.Left); err != nil {
+		return err
+	}
+	if e.Right != nil {
+		return f.validateLogicalOr(e.Right)
+	}
+	return nil
+}
+
+func (f *parserImpl) validateLogicalAnd(e *LogicalAndExpression) error {
+	if err := f.validateBracket(e.Left); err != nil {
+		return err
+	}
+	if e.Right != nil {
+		return f.validateLogicalAnd(e.Right)
+	}
+	return nil
+}
+
+func (f *parserImpl) validateBracket(e *BracketExpression) error {
+	if e.Negation != nil {
+		return f.validateLogicalOr(e.Negation)
+	}
+	if e.Bracket != nil {
+		return f.validateLogicalOr(e.Bracket)
+	}
+	if e.Primary.Comparison != nil {
+		return e.Primary.Comparison.validate()
+	}
+	return nil
+}
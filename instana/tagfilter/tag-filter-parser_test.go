@@ -3,6 +3,7 @@ package tagfilter_test
 import (
 	"fmt"
 	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"strings"
 	"testing"
 
 	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
@@ -64,6 +65,84 @@ func TestShouldParseNumberComparisonExpression(t *testing.T) {
 	shouldSuccessfullyParseExpression(expression, expectedResult, t)
 }
 
+func TestShouldParseFloatComparisonExpressions(t *testing.T) {
+	testCases := map[string]float64{
+		"1.5":     1.5,
+		"-0.25":   -0.25,
+		".5":      0.5,
+		"12.":     12,
+		"1.5e3":   1500,
+		"2E-2":    0.02,
+		"+1e6":    1000000,
+		"-3.1e+2": -310,
+	}
+	for value, expectedValue := range testCases {
+		t.Run(fmt.Sprintf("test parsing of float value %s", value), func(t *testing.T) {
+			expression := "call.duration GREATER_THAN " + value
+			expectedResult := &FilterExpression{
+				Expression: &LogicalOrExpression{
+					Left: &LogicalAndExpression{
+						Left: &BracketExpression{
+							Primary: &PrimaryExpression{
+								Comparison: &ComparisonExpression{
+									Entity:     &EntitySpec{Identifier: "call.duration"},
+									Operator:   Operator(restapi.GreaterThanOperator),
+									FloatValue: utils.Float64Ptr(expectedValue),
+								},
+							},
+						},
+					},
+				},
+			}
+
+			shouldSuccessfullyParseExpression(expression, expectedResult, t)
+		})
+	}
+}
+
+func TestShouldFailToParseFloatComparisonExpressionsWhenValueIsOutOfRange(t *testing.T) {
+	for _, value := range []string{"1e400", "-1e400", "1.5e400"} {
+		t.Run(fmt.Sprintf("test parsing of out of range float value %s", value), func(t *testing.T) {
+			_, err := NewParser().Parse("call.duration GREATER_THAN " + value)
+
+			require.Error(t, err)
+			require.Contains(t, err.Error(), fmt.Sprintf("invalid float %s: value out of range", value))
+			require.NotContains(t, err.Error(), "invalid integer")
+		})
+	}
+}
+
+func TestShouldParseStringComparisonExpressionWithEscapedCharacters(t *testing.T) {
+	testCases := map[string]string{
+		`'it\'s'`:             "it's",
+		`'back\\slash'`:       `back\slash`,
+		`"double \"quoted\""`: `double "quoted"`,
+		`'tab\tseparated'`:    "tab\tseparated",
+	}
+	for value, expectedValue := range testCases {
+		t.Run(fmt.Sprintf("test parsing of escaped string %s", value), func(t *testing.T) {
+			expression := "entity.name EQUALS " + value
+			expectedResult := &FilterExpression{
+				Expression: &LogicalOrExpression{
+					Left: &LogicalAndExpression{
+						Left: &BracketExpression{
+							Primary: &PrimaryExpression{
+								Comparison: &ComparisonExpression{
+									Entity:      &EntitySpec{Identifier: keyEntityName},
+									Operator:    Operator(restapi.EqualsOperator),
+									StringValue: utils.StringPtr(expectedValue),
+								},
+							},
+						},
+					},
+				},
+			}
+
+			shouldSuccessfullyParseExpression(expression, expectedResult, t)
+		})
+	}
+}
+
 func TestShouldParseBoolComparisonExpression(t *testing.T) {
 	expression := "entity.name EQUALS TRUE"
 	expectedResult := &FilterExpression{
@@ -655,6 +734,154 @@ func TestShouldParseEntityOriginFromUnaryExpression(t *testing.T) {
 	shouldSuccessfullyParseExpression(expression, expectedResult, t)
 }
 
+func TestShouldParseNegatedBracketExpression(t *testing.T) {
+	expression := "NOT (entity.name EQUALS 'foo')"
+	expectedResult := &FilterExpression{
+		Expression: &LogicalOrExpression{
+			Left: &LogicalAndExpression{
+				Left: &BracketExpression{
+					Negation: &LogicalOrExpression{
+						Left: &LogicalAndExpression{
+							Left: &BracketExpression{
+								Primary: &PrimaryExpression{
+									Comparison: &ComparisonExpression{
+										Entity:      &EntitySpec{Identifier: keyEntityName},
+										Operator:    Operator(restapi.EqualsOperator),
+										StringValue: utils.StringPtr("foo"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	shouldSuccessfullyParseExpression(expression, expectedResult, t)
+	shouldSuccessfullyParseExpression(strings.ToLower(expression[:3])+expression[3:], expectedResult, t)
+}
+
+func TestShouldParseIdentifierStartingWithKeyword(t *testing.T) {
+	for _, identifier := range []string{"notification.id", "not.x", "NOT.x", "not", "in.x", "IN.x", "in", "not_in.x", "NOT_IN.x", "not_in"} {
+		t.Run(fmt.Sprintf("test parsing of identifier %s", identifier), func(t *testing.T) {
+			expression := identifier + " EQUALS 'a'"
+			expectedResult := &FilterExpression{
+				Expression: &LogicalOrExpression{
+					Left: &LogicalAndExpression{
+						Left: &BracketExpression{
+							Primary: &PrimaryExpression{
+								Comparison: &ComparisonExpression{
+									Entity:      &EntitySpec{Identifier: identifier},
+									Operator:    Operator(restapi.EqualsOperator),
+									StringValue: utils.StringPtr("a"),
+								},
+							},
+						},
+					},
+				},
+			}
+
+			shouldSuccessfullyParseExpression(expression, expectedResult, t)
+		})
+	}
+}
+
+func TestShouldFailToParseNegationWithoutBrackets(t *testing.T) {
+	_, err := NewParser().Parse("NOT entity.name EQUALS 'foo'")
+
+	require.Error(t, err)
+}
+
+func TestShouldParseListComparisonExpressions(t *testing.T) {
+	for _, operator := range []Operator{InOperator, NotInOperator} {
+		t.Run(fmt.Sprintf("test parsing of list operator %s", operator), func(t *testing.T) {
+			expression := fmt.Sprintf("agent.tag:key@src %s ('a', \"b\",'c')", strings.ToLower(string(operator)))
+			expectedResult := &FilterExpression{
+				Expression: &LogicalOrExpression{
+					Left: &LogicalAndExpression{
+						Left: &BracketExpression{
+							Primary: &PrimaryExpression{
+								Comparison: &ComparisonExpression{
+									Entity:    &EntitySpec{Identifier: keyAgentTags, TagKey: utils.StringPtr("key"), Origin: utils.StringPtr(EntityOriginSource.Key())},
+									Operator:  operator,
+									ListValue: []string{"a", "b", "c"},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			shouldSuccessfullyParseExpression(expression, expectedResult, t)
+		})
+	}
+}
+
+func TestShouldParseIdentifierStartingWithIn(t *testing.T) {
+	expression := "infra.host IN ('foo')"
+	expectedResult := &FilterExpression{
+		Expression: &LogicalOrExpression{
+			Left: &LogicalAndExpression{
+				Left: &BracketExpression{
+					Primary: &PrimaryExpression{
+						Comparison: &ComparisonExpression{
+							Entity:    &EntitySpec{Identifier: "infra.host"},
+							Operator:  InOperator,
+							ListValue: []string{"foo"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	shouldSuccessfullyParseExpression(expression, expectedResult, t)
+}
+
+func TestShouldParseListComparisonExpressionsOfIdentifiersStartingWithListOperator(t *testing.T) {
+	for _, operator := range []Operator{InOperator, NotInOperator} {
+		identifier := strings.ToLower(string(operator)) + ".x"
+		t.Run(fmt.Sprintf("test parsing of list operator %s for identifier %s", operator, identifier), func(t *testing.T) {
+			expression := fmt.Sprintf("%s %s ('a')", identifier, operator)
+			expectedResult := &FilterExpression{
+				Expression: &LogicalOrExpression{
+					Left: &LogicalAndExpression{
+						Left: &BracketExpression{
+							Primary: &PrimaryExpression{
+								Comparison: &ComparisonExpression{
+									Entity:    &EntitySpec{Identifier: identifier},
+									Operator:  operator,
+									ListValue: []string{"a"},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			shouldSuccessfullyParseExpression(expression, expectedResult, t)
+		})
+	}
+}
+
+func TestShouldFailToParseInvalidListComparisons(t *testing.T) {
+	for _, expression := range []string{
+		"entity.name IN 'foo'",
+		"entity.name NOT_IN 123",
+		"entity.name IN ()",
+		"entity.name IN (1, 2)",
+		"entity.name EQUALS ('foo', 'bar')",
+		"entity.type EQUALS 'foo' AND NOT (entity.name CONTAINS ('foo'))",
+	} {
+		t.Run(expression, func(t *testing.T) {
+			_, err := NewParser().Parse(expression)
+
+			require.Error(t, err)
+		})
+	}
+}
+
 func shouldSuccessfullyParseExpression(input string, expectedResult *FilterExpression, t *testing.T) {
 	sut := NewParser()
 	result, err := sut.Parse(input)
@@ -684,6 +911,25 @@ func TestShouldRenderComplexExpressionInNormalizedForm(t *testing.T) {
 	require.Equal(t, normalizedExpression, rendered)
 }
 
+func TestShouldRenderNegatedBracketExpression(t *testing.T) {
+	expression := "not ( entity.name EQUALS 'foo'   OR entity.kind NOT_EMPTY )"
+
+	result, err := NewParser().Parse(expression)
+	require.NoError(t, err)
+
+	require.Equal(t, "NOT (entity.name@dest EQUALS 'foo' OR entity.kind@dest NOT_EMPTY)", result.Render())
+}
+
+func TestShouldRenderListComparisonExpression(t *testing.T) {
+	sut := &ComparisonExpression{
+		Entity:    &EntitySpec{Identifier: "foo", Origin: utils.StringPtr(EntityOriginDestination.Key())},
+		Operator:  NotInOperator,
+		ListValue: []string{"a", "b"},
+	}
+
+	require.Equal(t, "foo@dest NOT_IN ('a', 'b')", sut.Render())
+}
+
 func TestShouldRenderLogicalOrExpression(t *testing.T) {
 	expectedResult := "foo@dest EQUALS 'bar' OR foo@dest CONTAINS 'bar'"
 
@@ -860,6 +1106,48 @@ func TestShouldRenderPrimaryNumberComparisonExpression(t *testing.T) {
 	require.Equal(t, "entity.name@dest EQUALS 1234", rendered)
 }
 
+func TestShouldRenderPrimaryFloatComparisonExpression(t *testing.T) {
+	testCases := map[float64]string{
+		1.5:    "1.5",
+		-0.25:  "-0.25",
+		1e-7:   "0.0000001",
+		1500:   "1500",
+		1e20:   "1e+20",
+		-1e300: "-1e+300",
+	}
+	for value, expectedValue := range testCases {
+		t.Run(fmt.Sprintf("test rendering of float value %s", expectedValue), func(t *testing.T) {
+			sut := &ComparisonExpression{
+				Entity:     &EntitySpec{Identifier: "call.duration", Origin: utils.StringPtr(EntityOriginDestination.Key())},
+				Operator:   Operator(restapi.GreaterThanOperator),
+				FloatValue: utils.Float64Ptr(value),
+			}
+
+			require.Equal(t, "call.duration@dest GREATER_THAN "+expectedValue, sut.Render())
+		})
+	}
+}
+
+func TestShouldRenderStringValuesWithEscapedQuotesAndBackslashes(t *testing.T) {
+	sut := &ComparisonExpression{
+		Entity:      &EntitySpec{Identifier: "agent.tag", TagKey: utils.StringPtr(`it's`), Origin: utils.StringPtr(EntityOriginDestination.Key())},
+		Operator:    Operator(restapi.EqualsOperator),
+		StringValue: utils.StringPtr(`C:\temp\'x'`),
+	}
+
+	require.Equal(t, `agent.tag:'it\'s'@dest EQUALS 'C:\\temp\\\'x\''`, sut.Render())
+}
+
+func TestShouldRenderListValuesWithEscapedQuotesAndBackslashes(t *testing.T) {
+	sut := &ComparisonExpression{
+		Entity:    &EntitySpec{Identifier: keyEntityName, Origin: utils.StringPtr(EntityOriginDestination.Key())},
+		Operator:  InOperator,
+		ListValue: []string{"it's", `a\b`},
+	}
+
+	require.Equal(t, `entity.name@dest IN ('it\'s', 'a\\b')`, sut.Render())
+}
+
 func TestShouldRenderPrimaryBooleanComparisonExpression(t *testing.T) {
 	sut := &FilterExpression{
 		Expression: &LogicalOrExpression{
@@ -983,11 +1271,11 @@ func TestShouldNormalizeExpressionAndProduceTheSameResultWhenPerformedMultipleTi
 		{
 			name:     "AndWithBracketedOr",
 			input:    "agent.tag:key EQUALS 'value' AND ( entity.name EQUALS 'foo' OR entity.name EQUALS 'bar' )",
-			expected: "(agent.tag:'key'@dest EQUALS 'value' AND (entity.name@dest EQUALS 'foo' OR entity.name@dest EQUALS 'bar'))"},
+			expected: "(agent.tag:'key'@dest EQUALS 'value' AND entity.name@dest IN ('foo', 'bar'))"},
 		{
 			name:     "BracketedOrWithAnd",
 			input:    "(entity.name EQUALS 'foo' OR entity.name EQUALS 'bar') AND agent.tag:key EQUALS 'value'",
-			expected: "((entity.name@dest EQUALS 'foo' OR entity.name@dest EQUALS 'bar') AND agent.tag:'key'@dest EQUALS 'value')",
+			expected: "(entity.name@dest IN ('foo', 'bar') AND agent.tag:'key'@dest EQUALS 'value')",
 		},
 		{
 			name:     "OrWithBracketedAnd",
@@ -1012,12 +1300,87 @@ func TestShouldNormalizeExpressionAndProduceTheSameResultWhenPerformedMultipleTi
 		{
 			name:     "OrWithBracketedOr",
 			input:    "agent.tag:key EQUALS 'value' OR ( entity.name EQUALS 'foo' OR entity.name EQUALS 'bar' )",
-			expected: "(agent.tag:'key'@dest EQUALS 'value' OR entity.name@dest EQUALS 'foo' OR entity.name@dest EQUALS 'bar')",
+			expected: "(agent.tag:'key'@dest EQUALS 'value' OR entity.name@dest IN ('foo', 'bar'))",
 		},
 		{
 			name:     "BracketedOrWithOr",
 			input:    "( entity.name EQUALS 'foo' OR entity.name EQUALS 'bar' ) OR agent.tag:key EQUALS 'value'",
-			expected: "(entity.name@dest EQUALS 'foo' OR entity.name@dest EQUALS 'bar' OR agent.tag:'key'@dest EQUALS 'value')",
+			expected: "(entity.name@dest IN ('foo', 'bar') OR agent.tag:'key'@dest EQUALS 'value')",
+		},
+		{
+			name:     "NegatedComparison",
+			input:    "NOT (entity.name EQUALS 'foo')",
+			expected: "entity.name@dest NOT_EQUAL 'foo'",
+		},
+		{
+			name:     "NegatedOr",
+			input:    "NOT (entity.name IS_EMPTY OR span.duration GREATER_THAN 100)",
+			expected: "(entity.name@dest NOT_EMPTY AND span.duration@dest LESS_OR_EQUAL_THAN 100)",
+		},
+		{
+			name:     "AndWithNegatedAnd",
+			input:    "agent.tag:key EQUALS 'value' AND NOT (entity.name EQUALS 'foo' AND entity.name CONTAINS 'bar')",
+			expected: "(agent.tag:'key'@dest EQUALS 'value' AND (entity.name@dest NOT_EQUAL 'foo' OR entity.name@dest NOT_CONTAIN 'bar'))",
+		},
+		{
+			name:     "OrWithNegatedAnd",
+			input:    "agent.tag:key EQUALS 'value' OR NOT (entity.name STARTS_WITH 'foo' AND entity.name ENDS_WITH 'bar')",
+			expected: "(agent.tag:'key'@dest EQUALS 'value' OR entity.name@dest NOT_STARTS_WITH 'foo' OR entity.name@dest NOT_ENDS_WITH 'bar')",
+		},
+		{
+			name:     "InList",
+			input:    "entity.name IN ('foo', 'bar','baz')",
+			expected: "entity.name@dest IN ('foo', 'bar', 'baz')",
+		},
+		{
+			name:     "InListWithSingleValue",
+			input:    "entity.name IN ('foo')",
+			expected: "entity.name@dest EQUALS 'foo'",
+		},
+		{
+			name:     "NotInListCombinedWithOr",
+			input:    "entity.name NOT_IN ('foo', 'bar') OR entity.type EQUALS 'baz'",
+			expected: "(entity.name@dest NOT_IN ('foo', 'bar') OR entity.type@dest EQUALS 'baz')",
+		},
+		{
+			name:     "InListCombinedWithOr",
+			input:    "entity.name IN ('foo', 'bar') OR entity.type EQUALS 'baz'",
+			expected: "(entity.name@dest IN ('foo', 'bar') OR entity.type@dest EQUALS 'baz')",
+		},
+		{
+			name:     "OrOfEqualsComparisons",
+			input:    "entity.name EQUALS 'foo' OR entity.name EQUALS 'bar'",
+			expected: "entity.name@dest IN ('foo', 'bar')",
+		},
+		{
+			name:     "NegatedInList",
+			input:    "NOT (agent.tag:key IN ('foo', 'bar'))",
+			expected: "agent.tag:'key'@dest NOT_IN ('foo', 'bar')",
+		},
+		{
+			name:     "FloatComparison",
+			input:    "call.duration GREATER_THAN 1.5e3 AND call.error_rate LESS_THAN .25",
+			expected: "(call.duration@dest GREATER_THAN 1500 AND call.error_rate@dest LESS_THAN 0.25)",
+		},
+		{
+			name:     "FloatTagComparison",
+			input:    "agent.tag:version EQUALS 1.50",
+			expected: "agent.tag:'version'@dest EQUALS '1.5'",
+		},
+		{
+			name:     "EscapedSingleQuote",
+			input:    `entity.name EQUALS 'it\'s' OR entity.name EQUALS "say \"hi\""`,
+			expected: `entity.name@dest IN ('it\'s', 'say "hi"')`,
+		},
+		{
+			name:     "EscapedBackslashInTagKey",
+			input:    `agent.tag:'a\\b' EQUALS 'c\\d'`,
+			expected: `agent.tag:'a\\b'@dest EQUALS 'c\\d'`,
+		},
+		{
+			name:     "DoubleNegation",
+			input:    "NOT (NOT (entity.name EQUALS 'foo' OR entity.name IS_BLANK))",
+			expected: "(entity.name@dest EQUALS 'foo' OR entity.name@dest IS_BLANK)",
 		},
 	}
 
@@ -40,12 +40,53 @@ func (m *tagFilterMapper) unwrapExpressionElements(element *restapi.TagFilter, o
 }
 
 func (m *tagFilterMapper) mapBracketExpressionToAPIModel(input *BracketExpression) *restapi.TagFilter {
+	if input.Negation != nil {
+		return m.negate(m.mapLogicalOrToAPIModel(input.Negation))
+	}
 	if input.Bracket != nil {
 		return m.mapLogicalOrToAPIModel(input.Bracket)
 	}
 	return m.mapPrimaryExpressionToAPIModel(input.Primary)
 }
 
+// negate pushes the negation down to the leaf tag filters as the Instana API does not support a NOT node. Logical
+// expressions are negated by applying De Morgan's laws and leaf tag filters by using the inverse operator.
+func (m *tagFilterMapper) negate(input *restapi.TagFilter) *restapi.TagFilter {
+	if input.GetType() == restapi.TagFilterExpressionType {
+		elements := make([]*restapi.TagFilter, len(input.Elements))
+		for i, element := range input.Elements {
+			elements[i] = m.negate(element)
+		}
+		if *input.LogicalOperator == restapi.LogicalAnd {
+			return restapi.NewLogicalOrTagFilter(elements)
+		}
+		return restapi.NewLogicalAndTagFilter(elements)
+	}
+	negated := *input
+	operator := negatedOperators[*input.Operator]
+	negated.Operator = &operator
+	return &negated
+}
+
+var negatedOperators = map[restapi.ExpressionOperator]restapi.ExpressionOperator{
+	restapi.EqualsOperator:             restapi.NotEqualOperator,
+	restapi.NotEqualOperator:           restapi.EqualsOperator,
+	restapi.ContainsOperator:           restapi.NotContainOperator,
+	restapi.NotContainOperator:         restapi.ContainsOperator,
+	restapi.StartsWithOperator:         restapi.NotStartsWithOperator,
+	restapi.NotStartsWithOperator:      restapi.StartsWithOperator,
+	restapi.EndsWithOperator:           restapi.NotEndsWithOperator,
+	restapi.NotEndsWithOperator:        restapi.EndsWithOperator,
+	restapi.GreaterOrEqualThanOperator: restapi.LessThanOperator,
+	restapi.LessThanOperator:           restapi.GreaterOrEqualThanOperator,
+	restapi.LessOrEqualThanOperator:    restapi.GreaterThanOperator,
+	restapi.GreaterThanOperator:        restapi.LessOrEqualThanOperator,
+	restapi.IsEmptyOperator:            restapi.NotEmptyOperator,
+	restapi.NotEmptyOperator:           restapi.IsEmptyOperator,
+	restapi.IsBlankOperator:            restapi.NotBlankOperator,
+	restapi.NotBlankOperator:           restapi.IsBlankOperator,
+}
+
 func (m *tagFilterMapper) mapPrimaryExpressionToAPIModel(input *PrimaryExpression) *restapi.TagFilter {
 	if input.UnaryOperation != nil {
 		return m.mapUnaryOperatorExpressionToAPIModel(input.UnaryOperation)
@@ -62,6 +103,9 @@ func (m *tagFilterMapper) mapUnaryOperatorExpressionToAPIModel(input *UnaryOpera
 }
 
 func (m *tagFilterMapper) mapComparisonExpressionToAPIModel(input *ComparisonExpression) *restapi.TagFilter {
+	if input.IsListComparison() {
+		return m.mapListComparisonExpressionToAPIModel(input)
+	}
 	origin := EntityOriginDestination.TagFilterEntity()
 	if input.Entity.Origin != nil {
 		origin = SupportedEntityOrigins.ForKey(*input.Entity.Origin).TagFilterEntity()
@@ -70,15 +114,43 @@ func (m *tagFilterMapper) mapComparisonExpressionToAPIModel(input *ComparisonExp
 		return restapi.NewTagTagFilter(origin, input.Entity.Identifier, restapi.ExpressionOperator(input.Operator), *input.Entity.TagKey, m.mapValueAsString(input))
 	} else if input.NumberValue != nil {
 		return restapi.NewNumberTagFilter(origin, input.Entity.Identifier, restapi.ExpressionOperator(input.Operator), *input.NumberValue)
+	} else if input.FloatValue != nil {
+		return restapi.NewFloatTagFilter(origin, input.Entity.Identifier, restapi.ExpressionOperator(input.Operator), *input.FloatValue)
 	} else if input.BooleanValue != nil {
 		return restapi.NewBooleanTagFilter(origin, input.Entity.Identifier, restapi.ExpressionOperator(input.Operator), *input.BooleanValue)
 	}
 	return restapi.NewStringTagFilter(origin, input.Entity.Identifier, restapi.ExpressionOperator(input.Operator), *input.StringValue)
 }
 
+// mapListComparisonExpressionToAPIModel expands the list operators IN and NOT_IN, which are not supported by the Instana
+// API, to a logical OR of EQUALS comparisons respectively a logical AND of NOT_EQUAL comparisons
+func (m *tagFilterMapper) mapListComparisonExpressionToAPIModel(input *ComparisonExpression) *restapi.TagFilter {
+	operator := Operator(restapi.EqualsOperator)
+	if input.Operator == NotInOperator {
+		operator = Operator(restapi.NotEqualOperator)
+	}
+	elements := make([]*restapi.TagFilter, len(input.ListValue))
+	for i := range input.ListValue {
+		elements[i] = m.mapComparisonExpressionToAPIModel(&ComparisonExpression{
+			Entity:      input.Entity,
+			Operator:    operator,
+			StringValue: &input.ListValue[i],
+		})
+	}
+	if len(elements) == 1 {
+		return elements[0]
+	}
+	if input.Operator == NotInOperator {
+		return restapi.NewLogicalAndTagFilter(elements)
+	}
+	return restapi.NewLogicalOrTagFilter(elements)
+}
+
 func (m *tagFilterMapper) mapValueAsString(input *ComparisonExpression) string {
 	if input.NumberValue != nil {
 		return fmt.Sprintf("%d", *input.NumberValue)
+	} else if input.FloatValue != nil {
+		return formatFloat(*input.FloatValue)
 	} else if input.BooleanValue != nil {
 		return fmt.Sprintf("%t", *input.BooleanValue)
 	}
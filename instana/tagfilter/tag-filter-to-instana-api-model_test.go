@@ -18,6 +18,7 @@ func TestShouldMapComparisonToRepresentationOfInstanaAPI(t *testing.T) {
 	for _, v := range restapi.SupportedComparisonOperators {
 		t.Run(fmt.Sprintf("test comparison of string value using operatore %s", v), createTestShouldMapStringComparisonToRepresentationOfInstanaAPI(v))
 		t.Run(fmt.Sprintf("test comparison of number value using operatore of %s", v), createTestShouldMapNumberComparisonToRepresentationOfInstanaAPI(v))
+		t.Run(fmt.Sprintf("test comparison of float value using operatore of %s", v), createTestShouldMapFloatComparisonToRepresentationOfInstanaAPI(v))
 		t.Run(fmt.Sprintf("test comparison of boolean value using operatore of %s", v), createTestShouldMapBooleanComparisonToRepresentationOfInstanaAPI(v))
 		t.Run(fmt.Sprintf("test comparison of tag using operatore of %s", v), createTestShouldMapTagComparisonToRepresentationOfInstanaAPI(v))
 	}
@@ -70,6 +71,30 @@ func createTestShouldMapNumberComparisonToRepresentationOfInstanaAPI(operator re
 	}
 }
 
+func createTestShouldMapFloatComparisonToRepresentationOfInstanaAPI(operator restapi.ExpressionOperator) func(*testing.T) {
+	floatValue := 12.34
+	return func(t *testing.T) {
+		expr := &FilterExpression{
+			Expression: &LogicalOrExpression{
+				Left: &LogicalAndExpression{
+					Left: &BracketExpression{
+						Primary: &PrimaryExpression{
+							Comparison: &ComparisonExpression{
+								Entity:     &EntitySpec{Identifier: entitySpecKey, Origin: utils.StringPtr(EntityOriginDestination.Key())},
+								Operator:   Operator(operator),
+								FloatValue: &floatValue,
+							},
+						},
+					},
+				},
+			},
+		}
+
+		expectedResult := restapi.NewFloatTagFilter(restapi.TagFilterEntityDestination, entitySpecKey, operator, floatValue)
+		runTestCaseForMappingToAPI(expr, expectedResult, t)
+	}
+}
+
 func createTestShouldMapBooleanComparisonToRepresentationOfInstanaAPI(operator restapi.ExpressionOperator) func(*testing.T) {
 	boolValue := true
 	return func(t *testing.T) {
@@ -165,6 +190,29 @@ func TestShouldMapTagComparisonToRepresentationOfInstanaAPIUsingANumberValue(t *
 	runTestCaseForMappingToAPI(expr, expectedResult, t)
 }
 
+func TestShouldMapTagComparisonToRepresentationOfInstanaAPIUsingAFloatValue(t *testing.T) {
+	key := "key"
+	value := 12.5
+	expr := &FilterExpression{
+		Expression: &LogicalOrExpression{
+			Left: &LogicalAndExpression{
+				Left: &BracketExpression{
+					Primary: &PrimaryExpression{
+						Comparison: &ComparisonExpression{
+							Entity:     &EntitySpec{Identifier: entitySpecKey, TagKey: &key, Origin: utils.StringPtr(EntityOriginDestination.Key())},
+							Operator:   Operator(restapi.EqualsOperator),
+							FloatValue: &value,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expectedResult := restapi.NewTagTagFilter(restapi.TagFilterEntityDestination, entitySpecKey, restapi.EqualsOperator, key, "12.5")
+	runTestCaseForMappingToAPI(expr, expectedResult, t)
+}
+
 func TestShouldMapTagComparisonToRepresentationOfInstanaAPIUsingABooleanValue(t *testing.T) {
 	key := "key"
 	value := true
@@ -404,6 +452,209 @@ func TestShouldMapLogicalOrExpressionWithNestedAndInBrackets(t *testing.T) {
 	runTestCaseForMappingToAPI(expr, expectedResult, t)
 }
 
+func TestShouldPushNegationDownToLeafOperators(t *testing.T) {
+	negations := map[restapi.ExpressionOperator]restapi.ExpressionOperator{
+		restapi.EqualsOperator:             restapi.NotEqualOperator,
+		restapi.NotEqualOperator:           restapi.EqualsOperator,
+		restapi.ContainsOperator:           restapi.NotContainOperator,
+		restapi.NotContainOperator:         restapi.ContainsOperator,
+		restapi.StartsWithOperator:         restapi.NotStartsWithOperator,
+		restapi.NotStartsWithOperator:      restapi.StartsWithOperator,
+		restapi.EndsWithOperator:           restapi.NotEndsWithOperator,
+		restapi.NotEndsWithOperator:        restapi.EndsWithOperator,
+		restapi.GreaterOrEqualThanOperator: restapi.LessThanOperator,
+		restapi.LessThanOperator:           restapi.GreaterOrEqualThanOperator,
+		restapi.LessOrEqualThanOperator:    restapi.GreaterThanOperator,
+		restapi.GreaterThanOperator:        restapi.LessOrEqualThanOperator,
+	}
+	require.Len(t, negations, len(restapi.SupportedComparisonOperators))
+
+	for operator, negated := range negations {
+		t.Run(fmt.Sprintf("test negation of operator %s", operator), func(t *testing.T) {
+			expr := &FilterExpression{
+				Expression: &LogicalOrExpression{
+					Left: &LogicalAndExpression{
+						Left: &BracketExpression{
+							Negation: &LogicalOrExpression{
+								Left: &LogicalAndExpression{
+									Left: &BracketExpression{
+										Primary: &PrimaryExpression{
+											Comparison: &ComparisonExpression{
+												Entity:      &EntitySpec{Identifier: entitySpecKey, Origin: utils.StringPtr(EntityOriginSource.Key())},
+												Operator:    Operator(operator),
+												StringValue: utils.StringPtr("value"),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			expectedResult := restapi.NewStringTagFilter(restapi.TagFilterEntitySource, entitySpecKey, negated, "value")
+			runTestCaseForMappingToAPI(expr, expectedResult, t)
+		})
+	}
+}
+
+func TestShouldPushNegationDownToUnaryOperators(t *testing.T) {
+	negations := map[restapi.ExpressionOperator]restapi.ExpressionOperator{
+		restapi.IsEmptyOperator:  restapi.NotEmptyOperator,
+		restapi.NotEmptyOperator: restapi.IsEmptyOperator,
+		restapi.IsBlankOperator:  restapi.NotBlankOperator,
+		restapi.NotBlankOperator: restapi.IsBlankOperator,
+	}
+	require.Len(t, negations, len(restapi.SupportedUnaryExpressionOperators))
+
+	for operator, negated := range negations {
+		t.Run(fmt.Sprintf("test negation of operator %s", operator), func(t *testing.T) {
+			expr := &FilterExpression{
+				Expression: &LogicalOrExpression{
+					Left: &LogicalAndExpression{
+						Left: &BracketExpression{
+							Negation: &LogicalOrExpression{
+								Left: &LogicalAndExpression{
+									Left: &BracketExpression{
+										Primary: &PrimaryExpression{
+											UnaryOperation: &UnaryOperationExpression{
+												Entity:   &EntitySpec{Identifier: entitySpecKey, Origin: utils.StringPtr(EntityOriginDestination.Key())},
+												Operator: Operator(operator),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			expectedResult := restapi.NewUnaryTagFilter(restapi.TagFilterEntityDestination, entitySpecKey, negated)
+			runTestCaseForMappingToAPI(expr, expectedResult, t)
+		})
+	}
+}
+
+func TestShouldMapNegatedLogicalOrExpressionToLogicalAndOfNegatedElements(t *testing.T) {
+	logicalOr := Operator(restapi.LogicalOr)
+	logicalAnd := Operator(restapi.LogicalAnd)
+	isEmpty := PrimaryExpression{
+		UnaryOperation: &UnaryOperationExpression{
+			Entity:   &EntitySpec{Identifier: entitySpecKey, Origin: utils.StringPtr(EntityOriginDestination.Key())},
+			Operator: Operator(restapi.IsEmptyOperator),
+		},
+	}
+	isBlank := PrimaryExpression{
+		UnaryOperation: &UnaryOperationExpression{
+			Entity:   &EntitySpec{Identifier: entitySpecKey, Origin: utils.StringPtr(EntityOriginDestination.Key())},
+			Operator: Operator(restapi.IsBlankOperator),
+		},
+	}
+	expr := &FilterExpression{
+		Expression: &LogicalOrExpression{
+			Left: &LogicalAndExpression{
+				Left: &BracketExpression{
+					Negation: &LogicalOrExpression{
+						Left: &LogicalAndExpression{
+							Left: &BracketExpression{Primary: &isEmpty},
+						},
+						Operator: &logicalOr,
+						Right: &LogicalOrExpression{
+							Left: &LogicalAndExpression{
+								Left:     &BracketExpression{Primary: &isEmpty},
+								Operator: &logicalAnd,
+								Right: &LogicalAndExpression{
+									Left: &BracketExpression{Primary: &isBlank},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	notEmpty := restapi.NewUnaryTagFilter(restapi.TagFilterEntityDestination, entitySpecKey, restapi.NotEmptyOperator)
+	notBlank := restapi.NewUnaryTagFilter(restapi.TagFilterEntityDestination, entitySpecKey, restapi.NotBlankOperator)
+	expectedResult := restapi.NewLogicalAndTagFilter([]*restapi.TagFilter{
+		notEmpty,
+		restapi.NewLogicalOrTagFilter([]*restapi.TagFilter{notEmpty, notBlank}),
+	})
+	runTestCaseForMappingToAPI(expr, expectedResult, t)
+}
+
+func TestShouldExpandInListToLogicalOrOfEqualsComparisons(t *testing.T) {
+	expr := &FilterExpression{
+		Expression: &LogicalOrExpression{
+			Left: &LogicalAndExpression{
+				Left: &BracketExpression{
+					Primary: &PrimaryExpression{
+						Comparison: &ComparisonExpression{
+							Entity:    &EntitySpec{Identifier: entitySpecKey, Origin: utils.StringPtr(EntityOriginDestination.Key())},
+							Operator:  InOperator,
+							ListValue: []string{"a", "b", "c"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expectedResult := restapi.NewLogicalOrTagFilter([]*restapi.TagFilter{
+		restapi.NewStringTagFilter(restapi.TagFilterEntityDestination, entitySpecKey, restapi.EqualsOperator, "a"),
+		restapi.NewStringTagFilter(restapi.TagFilterEntityDestination, entitySpecKey, restapi.EqualsOperator, "b"),
+		restapi.NewStringTagFilter(restapi.TagFilterEntityDestination, entitySpecKey, restapi.EqualsOperator, "c"),
+	})
+	runTestCaseForMappingToAPI(expr, expectedResult, t)
+}
+
+func TestShouldExpandNotInListOfTagToLogicalAndOfNotEqualComparisons(t *testing.T) {
+	expr := &FilterExpression{
+		Expression: &LogicalOrExpression{
+			Left: &LogicalAndExpression{
+				Left: &BracketExpression{
+					Primary: &PrimaryExpression{
+						Comparison: &ComparisonExpression{
+							Entity:    &EntitySpec{Identifier: entitySpecKey, TagKey: utils.StringPtr("tag"), Origin: utils.StringPtr(EntityOriginSource.Key())},
+							Operator:  NotInOperator,
+							ListValue: []string{"a", "b"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expectedResult := restapi.NewLogicalAndTagFilter([]*restapi.TagFilter{
+		restapi.NewTagTagFilter(restapi.TagFilterEntitySource, entitySpecKey, restapi.NotEqualOperator, "tag", "a"),
+		restapi.NewTagTagFilter(restapi.TagFilterEntitySource, entitySpecKey, restapi.NotEqualOperator, "tag", "b"),
+	})
+	runTestCaseForMappingToAPI(expr, expectedResult, t)
+}
+
+func TestShouldMapInListWithSingleValueToEqualsComparison(t *testing.T) {
+	expr := &FilterExpression{
+		Expression: &LogicalOrExpression{
+			Left: &LogicalAndExpression{
+				Left: &BracketExpression{
+					Primary: &PrimaryExpression{
+						Comparison: &ComparisonExpression{
+							Entity:    &EntitySpec{Identifier: entitySpecKey, Origin: utils.StringPtr(EntityOriginDestination.Key())},
+							Operator:  InOperator,
+							ListValue: []string{"a"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expectedResult := restapi.NewStringTagFilter(restapi.TagFilterEntityDestination, entitySpecKey, restapi.EqualsOperator, "a")
+	runTestCaseForMappingToAPI(expr, expectedResult, t)
+}
+
 func runTestCaseForMappingToAPI(input *FilterExpression, expectedResult *restapi.TagFilter, t *testing.T) {
 	mapper := NewMapper()
 	result := mapper.ToAPIModel(input)
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/instana/tagfilter"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -18,7 +19,10 @@ type ResourceMetaData struct {
 	SkipIDGeneration   bool
 	ResourceIDField    *string
 	CreateOnly         bool
+	WriteOnly          bool
 	DeprecationMessage string
+	//TagCatalogValidation optional definition of the tag filter fields which are validated against the Instana tag catalog
+	TagCatalogValidation *TagCatalogValidation
 }
 
 // ResourceHandle resource specific implementation which provides metadata and maps data from/to terraform state. Together with TerraformResource terraform schema resources can be created
@@ -86,8 +90,11 @@ func (r *terraformResourceImpl[T]) Create(_ context.Context, d *schema.ResourceD
 	return nil
 }
 
-// Read defines the read operation for the terraform resource
+// Read defines the read operation for the terraform resource. Write only resources cannot be read from the Instana API; the current state is kept as is.
 func (r *terraformResourceImpl[T]) Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if r.resourceHandle.MetaData().WriteOnly {
+		return nil
+	}
 	providerMeta := meta.(*ProviderMeta)
 	instanaAPI := providerMeta.InstanaAPI
 	resourceID := r.getResourceID(d)
@@ -150,7 +157,7 @@ func (r *terraformResourceImpl[T]) Delete(_ context.Context, d *schema.ResourceD
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	err = r.resourceHandle.GetRestResource(instanaAPI).DeleteByID(object.GetIDForResourcePath())
+	err = r.resourceHandle.GetRestResource(instanaAPI).Delete(object)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -162,23 +169,79 @@ func (r *terraformResourceImpl[T]) ToSchemaResource() *schema.Resource {
 	metaData := r.resourceHandle.MetaData()
 	var updateOperation schema.UpdateContextFunc
 	if r.resourceHandle.MetaData().CreateOnly {
-		updateOperation = r.NoUpdateSupported
+		if r.hasUpdatableFields(metaData.Schema) {
+			updateOperation = r.NoUpdateSupported
+		}
 	} else {
 		updateOperation = r.Update
 	}
-	return &schema.Resource{
-		CreateContext: r.Create,
-		ReadContext:   r.Read,
-		Importer: &schema.ResourceImporter{
+	var customizeDiff schema.CustomizeDiffFunc
+	if metaData.TagCatalogValidation != nil {
+		customizeDiff = r.validateTagFiltersAgainstTagCatalog
+	}
+	var importer *schema.ResourceImporter
+	if !metaData.WriteOnly {
+		importer = &schema.ResourceImporter{
 			StateContext: r.importState,
-		},
+		}
+	}
+	return &schema.Resource{
+		CreateContext:      r.Create,
+		ReadContext:        r.Read,
+		Importer:           importer,
 		UpdateContext:      updateOperation,
 		DeleteContext:      r.Delete,
 		Schema:             metaData.Schema,
 		SchemaVersion:      metaData.SchemaVersion,
 		StateUpgraders:     r.resourceHandle.StateUpgraders(),
 		DeprecationMessage: metaData.DeprecationMessage,
+		CustomizeDiff:      customizeDiff,
+	}
+}
+
+// hasUpdatableFields returns true when at least one field is configurable and not marked as ForceNew. Terraform does
+// not allow to define an update operation for resources where all fields require a replacement.
+func (r *terraformResourceImpl[T]) hasUpdatableFields(schemaMap map[string]*schema.Schema) bool {
+	for _, s := range schemaMap {
+		if !s.ForceNew && (s.Required || s.Optional) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateTagFiltersAgainstTagCatalog validates the changed tag filter fields against the Instana tag catalog at plan time
+// when the tag catalog validation is enabled for the provider. Unchanged tag filters are not validated, so that existing
+// resources are not blocked by changes of the catalog. Syntax errors are ignored as they are reported by the validation
+// function of the tag filter schema.
+func (r *terraformResourceImpl[T]) validateTagFiltersAgainstTagCatalog(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	providerMeta, ok := meta.(*ProviderMeta)
+	if !ok || providerMeta.TagCatalogs == nil {
+		return nil
+	}
+	validation := r.resourceHandle.MetaData().TagCatalogValidation
+	errs := make([]error, 0)
+	for _, field := range validation.TagFilterFields {
+		if !d.NewValueKnown(field) {
+			continue
+		}
+		oldValue, newValue := d.GetChange(field)
+		if len(newValue.(string)) == 0 || tagFilterDiffSuppressFunc(field, oldValue.(string), newValue.(string), nil) {
+			continue
+		}
+		expression, err := tagfilter.NewParser().Parse(newValue.(string))
+		if err != nil {
+			continue
+		}
+		tags, err := providerMeta.TagCatalogs.Tags(validation.Catalog)
+		if err != nil {
+			return err
+		}
+		for _, validationError := range tagfilter.NewCatalogValidator(tags).Validate(expression) {
+			errs = append(errs, fmt.Errorf("invalid %s: %w", field, validationError))
+		}
 	}
+	return errors.Join(errs...)
 }
 
 func (r *terraformResourceImpl[T]) importState(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
@@ -28,10 +28,28 @@ func TestTerraformProviderInstanaResource(t *testing.T) {
 	t.Run("should return error when update test object fails through Instana API", ut.shouldReturnErrorWhenUpdateTestObjectFailsThroughInstanaAPI)
 	t.Run("should delete test object through Instana API", ut.shouldDeleteTestObjectThroughInstanaAPI)
 	t.Run("should return error when delete test object fails through Instana API", ut.shouldReturnErrorWhenDeleteTestObjectFailsThroughInstanaAPI)
+	t.Run("should not define update operation for create only resources when all fields force a replacement", ut.shouldNotDefineUpdateOperationForCreateOnlyResourcesWhenAllFieldsForceReplacement)
+	t.Run("should define failing update operation for create only resources with updatable fields", ut.shouldDefineFailingUpdateOperationForCreateOnlyResourcesWithUpdatableFields)
 }
 
 type terraformProviderInstanaResourceUnitTest struct{}
 
+func (r *terraformProviderInstanaResourceUnitTest) shouldNotDefineUpdateOperationForCreateOnlyResourcesWhenAllFieldsForceReplacement(t *testing.T) {
+	schemaResource := NewTerraformResource(NewGroupMembershipResourceHandle()).ToSchemaResource()
+
+	assert.Nil(t, schemaResource.UpdateContext)
+	assert.NoError(t, schemaResource.InternalValidate(nil, true))
+}
+
+func (r *terraformProviderInstanaResourceUnitTest) shouldDefineFailingUpdateOperationForCreateOnlyResourcesWithUpdatableFields(t *testing.T) {
+	schemaResource := NewTerraformResource(NewSliConfigResourceHandle()).ToSchemaResource()
+
+	assert.NotNil(t, schemaResource.UpdateContext)
+	diag := schemaResource.UpdateContext(context.TODO(), schemaResource.TestResourceData(), &ProviderMeta{})
+	assert.True(t, diag.HasError())
+	assert.Contains(t, diag[0].Summary, "update operations not supported")
+}
+
 func (r *terraformProviderInstanaResourceUnitTest) shouldSuccessfullyReadTestObjectFromInstanaAPIWhenBaseDataIsReturned(t *testing.T) {
 	expectedModel := r.createTestAlertingChannelEmailObject()
 	testHelper := NewTestHelper[*restapi.AlertingChannel](t)
@@ -192,7 +210,7 @@ func (r *terraformProviderInstanaResourceUnitTest) shouldDeleteTestObjectThrough
 		mockTestObjectApi := mocks.NewMockRestResource[*restapi.AlertingChannel](ctrl)
 
 		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
-		mockTestObjectApi.EXPECT().DeleteByID(gomock.Eq(id)).Return(nil).Times(1)
+		mockTestObjectApi.EXPECT().Delete(gomock.Cond(func(x any) bool { return x.(*restapi.AlertingChannel).ID == id })).Return(nil).Times(1)
 
 		resourceHandle := NewAlertingChannelResourceHandle()
 		diag := NewTerraformResource(resourceHandle).Delete(context.TODO(), resourceData, providerMeta)
@@ -213,7 +231,7 @@ func (r *terraformProviderInstanaResourceUnitTest) shouldReturnErrorWhenDeleteTe
 		mockTestObjectApi := mocks.NewMockRestResource[*restapi.AlertingChannel](ctrl)
 
 		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
-		mockTestObjectApi.EXPECT().DeleteByID(gomock.Eq(id)).Return(expectedError).Times(1)
+		mockTestObjectApi.EXPECT().Delete(gomock.Cond(func(x any) bool { return x.(*restapi.AlertingChannel).ID == id })).Return(expectedError).Times(1)
 
 		resourceHandle := NewAlertingChannelResourceHandle()
 		diag := NewTerraformResource(resourceHandle).Delete(context.TODO(), resourceData, providerMeta)
@@ -1,12 +1,16 @@
 package instana
 
 import (
+	"strings"
+
 	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const (
+	//ResourceFieldBaselineRefreshTrigger constant value for field baseline_refresh_trigger
+	ResourceFieldBaselineRefreshTrigger = "baseline_refresh_trigger"
 	//ResourceFieldThreshold constant value for field threshold
 	ResourceFieldThreshold = "threshold"
 	//ResourceFieldThresholdLastUpdated constant value for field threshold.*.last_updated
@@ -41,13 +45,41 @@ var (
 	}
 
 	resourceSchemaOptionalThresholdLastUpdated = &schema.Schema{
-		Type:         schema.TypeInt,
-		Optional:     true,
-		ValidateFunc: validation.IntAtLeast(0),
-		Description:  "The last updated value of the threshold",
+		Type:             schema.TypeInt,
+		Optional:         true,
+		Computed:         true,
+		ValidateFunc:     validation.IntAtLeast(0),
+		DiffSuppressFunc: thresholdServerManagedValueDiffSuppressFunc,
+		Description:      "The last updated value of the threshold. The value is managed by the Instana backend",
 	}
 )
 
+// thresholdServerManagedValueDiffSuppressFunc suppresses differences of threshold values which are managed by the
+// Instana backend as soon as the backend provided a value
+var thresholdServerManagedValueDiffSuppressFunc = func(k, old, new string, d *schema.ResourceData) bool {
+	return old != ""
+}
+
+// thresholdServerManagedSetDiffSuppressFunc suppresses differences of threshold sets which are managed by the Instana
+// backend as soon as the backend provided a value. Differences of sets are reported per element key. Therefore, the
+// complete set stored in the state is evaluated instead of the old value of the single element
+var thresholdServerManagedSetDiffSuppressFunc = func(k, old, new string, d *schema.ResourceData) bool {
+	setKeySuffix := "." + ResourceFieldThresholdHistoricBaselineBaseline
+	index := strings.LastIndex(k, setKeySuffix)
+	if index < 0 {
+		return thresholdServerManagedValueDiffSuppressFunc(k, old, new, d)
+	}
+	oldValue, _ := d.GetChange(k[:index+len(setKeySuffix)])
+	oldSet, ok := oldValue.(*schema.Set)
+	return ok && oldSet.Len() > 0
+}
+
+var baselineRefreshTriggerSchema = &schema.Schema{
+	Type:        schema.TypeString,
+	Optional:    true,
+	Description: "Arbitrary value which triggers a refresh of the historic baseline when it is changed",
+}
+
 var thresholdSchema = &schema.Schema{
 	Type:        schema.TypeList,
 	MinItems:    1,
@@ -67,8 +99,10 @@ var thresholdSchema = &schema.Schema{
 						ResourceFieldThresholdOperator:    resourceSchemaRequiredThresholdOperator,
 						ResourceFieldThresholdLastUpdated: resourceSchemaOptionalThresholdLastUpdated,
 						ResourceFieldThresholdHistoricBaselineBaseline: {
-							Type:     schema.TypeSet,
-							Optional: true,
+							Type:             schema.TypeSet,
+							Optional:         true,
+							Computed:         true,
+							DiffSuppressFunc: thresholdServerManagedSetDiffSuppressFunc,
 							Elem: &schema.Schema{
 								Type:     schema.TypeSet,
 								Optional: false,
@@ -76,7 +110,7 @@ var thresholdSchema = &schema.Schema{
 									Type: schema.TypeFloat,
 								},
 							},
-							Description: "The baseline of the historic baseline threshold",
+							Description: "The baseline of the historic baseline threshold. The baseline is computed by the Instana backend",
 						},
 						ResourceFieldThresholdHistoricBaselineDeviationFactor: {
 							Type:         schema.TypeFloat,
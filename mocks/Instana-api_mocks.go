@@ -76,6 +76,62 @@ func (mr *MockInstanaAPIMockRecorder) AlertingConfigurations() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AlertingConfigurations", reflect.TypeOf((*MockInstanaAPI)(nil).AlertingConfigurations))
 }
 
+// ApdexConfigs mocks base method.
+func (m *MockInstanaAPI) ApdexConfigs() restapi.RestResource[*restapi.ApdexConfig] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApdexConfigs")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.ApdexConfig])
+	return ret0
+}
+
+// ApdexConfigs indicates an expected call of ApdexConfigs.
+func (mr *MockInstanaAPIMockRecorder) ApdexConfigs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApdexConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).ApdexConfigs))
+}
+
+// ApdexReports mocks base method.
+func (m *MockInstanaAPI) ApdexReports() restapi.ApdexReportRestResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApdexReports")
+	ret0, _ := ret[0].(restapi.ApdexReportRestResource)
+	return ret0
+}
+
+// ApdexReports indicates an expected call of ApdexReports.
+func (mr *MockInstanaAPIMockRecorder) ApdexReports() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApdexReports", reflect.TypeOf((*MockInstanaAPI)(nil).ApdexReports))
+}
+
+// ApplicationAlertConfigRestores mocks base method.
+func (m *MockInstanaAPI) ApplicationAlertConfigRestores() restapi.RestResource[*restapi.AlertConfigRestore] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplicationAlertConfigRestores")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.AlertConfigRestore])
+	return ret0
+}
+
+// ApplicationAlertConfigRestores indicates an expected call of ApplicationAlertConfigRestores.
+func (mr *MockInstanaAPIMockRecorder) ApplicationAlertConfigRestores() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplicationAlertConfigRestores", reflect.TypeOf((*MockInstanaAPI)(nil).ApplicationAlertConfigRestores))
+}
+
+// ApplicationAlertConfigVersions mocks base method.
+func (m *MockInstanaAPI) ApplicationAlertConfigVersions() restapi.AlertConfigVersionRestResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplicationAlertConfigVersions")
+	ret0, _ := ret[0].(restapi.AlertConfigVersionRestResource)
+	return ret0
+}
+
+// ApplicationAlertConfigVersions indicates an expected call of ApplicationAlertConfigVersions.
+func (mr *MockInstanaAPIMockRecorder) ApplicationAlertConfigVersions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplicationAlertConfigVersions", reflect.TypeOf((*MockInstanaAPI)(nil).ApplicationAlertConfigVersions))
+}
+
 // ApplicationAlertConfigs mocks base method.
 func (m *MockInstanaAPI) ApplicationAlertConfigs() restapi.RestResource[*restapi.ApplicationAlertConfig] {
 	m.ctrl.T.Helper()
@@ -104,6 +160,34 @@ func (mr *MockInstanaAPIMockRecorder) ApplicationConfigs() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplicationConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).ApplicationConfigs))
 }
 
+// ApplicationTagCatalog mocks base method.
+func (m *MockInstanaAPI) ApplicationTagCatalog() restapi.ReadOnlyRestResource[*restapi.Tag] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplicationTagCatalog")
+	ret0, _ := ret[0].(restapi.ReadOnlyRestResource[*restapi.Tag])
+	return ret0
+}
+
+// ApplicationTagCatalog indicates an expected call of ApplicationTagCatalog.
+func (mr *MockInstanaAPIMockRecorder) ApplicationTagCatalog() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplicationTagCatalog", reflect.TypeOf((*MockInstanaAPI)(nil).ApplicationTagCatalog))
+}
+
+// BuiltinEventSpecificationStates mocks base method.
+func (m *MockInstanaAPI) BuiltinEventSpecificationStates() restapi.RestResource[*restapi.BuiltinEventSpecificationState] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuiltinEventSpecificationStates")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.BuiltinEventSpecificationState])
+	return ret0
+}
+
+// BuiltinEventSpecificationStates indicates an expected call of BuiltinEventSpecificationStates.
+func (mr *MockInstanaAPIMockRecorder) BuiltinEventSpecificationStates() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuiltinEventSpecificationStates", reflect.TypeOf((*MockInstanaAPI)(nil).BuiltinEventSpecificationStates))
+}
+
 // BuiltinEventSpecifications mocks base method.
 func (m *MockInstanaAPI) BuiltinEventSpecifications() restapi.ReadOnlyRestResource[*restapi.BuiltinEventSpecification] {
 	m.ctrl.T.Helper()
@@ -160,6 +244,48 @@ func (mr *MockInstanaAPIMockRecorder) GlobalApplicationAlertConfigs() *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GlobalApplicationAlertConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).GlobalApplicationAlertConfigs))
 }
 
+// GroupMappings mocks base method.
+func (m *MockInstanaAPI) GroupMappings() restapi.RestResource[*restapi.GroupMapping] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GroupMappings")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.GroupMapping])
+	return ret0
+}
+
+// GroupMappings indicates an expected call of GroupMappings.
+func (mr *MockInstanaAPIMockRecorder) GroupMappings() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GroupMappings", reflect.TypeOf((*MockInstanaAPI)(nil).GroupMappings))
+}
+
+// GroupMemberships mocks base method.
+func (m *MockInstanaAPI) GroupMemberships() restapi.RestResource[*restapi.GroupMembership] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GroupMemberships")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.GroupMembership])
+	return ret0
+}
+
+// GroupMemberships indicates an expected call of GroupMemberships.
+func (mr *MockInstanaAPIMockRecorder) GroupMemberships() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GroupMemberships", reflect.TypeOf((*MockInstanaAPI)(nil).GroupMemberships))
+}
+
+// GroupPermissions mocks base method.
+func (m *MockInstanaAPI) GroupPermissions() restapi.RestResource[*restapi.GroupPermissions] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GroupPermissions")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.GroupPermissions])
+	return ret0
+}
+
+// GroupPermissions indicates an expected call of GroupPermissions.
+func (mr *MockInstanaAPIMockRecorder) GroupPermissions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GroupPermissions", reflect.TypeOf((*MockInstanaAPI)(nil).GroupPermissions))
+}
+
 // Groups mocks base method.
 func (m *MockInstanaAPI) Groups() restapi.RestResource[*restapi.Group] {
 	m.ctrl.T.Helper()
@@ -174,6 +300,90 @@ func (mr *MockInstanaAPIMockRecorder) Groups() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Groups", reflect.TypeOf((*MockInstanaAPI)(nil).Groups))
 }
 
+// HostAgentConfigurations mocks base method.
+func (m *MockInstanaAPI) HostAgentConfigurations() restapi.RestResource[*restapi.HostAgentConfiguration] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HostAgentConfigurations")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.HostAgentConfiguration])
+	return ret0
+}
+
+// HostAgentConfigurations indicates an expected call of HostAgentConfigurations.
+func (mr *MockInstanaAPIMockRecorder) HostAgentConfigurations() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HostAgentConfigurations", reflect.TypeOf((*MockInstanaAPI)(nil).HostAgentConfigurations))
+}
+
+// HttpEndpointConfigs mocks base method.
+func (m *MockInstanaAPI) HttpEndpointConfigs() restapi.RestResource[*restapi.HttpEndpointConfig] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HttpEndpointConfigs")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.HttpEndpointConfig])
+	return ret0
+}
+
+// HttpEndpointConfigs indicates an expected call of HttpEndpointConfigs.
+func (mr *MockInstanaAPIMockRecorder) HttpEndpointConfigs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HttpEndpointConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).HttpEndpointConfigs))
+}
+
+// ManualServiceConfigs mocks base method.
+func (m *MockInstanaAPI) ManualServiceConfigs() restapi.RestResource[*restapi.ManualServiceConfig] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ManualServiceConfigs")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.ManualServiceConfig])
+	return ret0
+}
+
+// ManualServiceConfigs indicates an expected call of ManualServiceConfigs.
+func (mr *MockInstanaAPIMockRecorder) ManualServiceConfigs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ManualServiceConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).ManualServiceConfigs))
+}
+
+// Releases mocks base method.
+func (m *MockInstanaAPI) Releases() restapi.RestResource[*restapi.Release] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Releases")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.Release])
+	return ret0
+}
+
+// Releases indicates an expected call of Releases.
+func (mr *MockInstanaAPIMockRecorder) Releases() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Releases", reflect.TypeOf((*MockInstanaAPI)(nil).Releases))
+}
+
+// ServiceConfigOrder mocks base method.
+func (m *MockInstanaAPI) ServiceConfigOrder() restapi.RestResource[*restapi.ServiceConfigOrder] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceConfigOrder")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.ServiceConfigOrder])
+	return ret0
+}
+
+// ServiceConfigOrder indicates an expected call of ServiceConfigOrder.
+func (mr *MockInstanaAPIMockRecorder) ServiceConfigOrder() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceConfigOrder", reflect.TypeOf((*MockInstanaAPI)(nil).ServiceConfigOrder))
+}
+
+// ServiceConfigs mocks base method.
+func (m *MockInstanaAPI) ServiceConfigs() restapi.RestResource[*restapi.ServiceConfig] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceConfigs")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.ServiceConfig])
+	return ret0
+}
+
+// ServiceConfigs indicates an expected call of ServiceConfigs.
+func (mr *MockInstanaAPIMockRecorder) ServiceConfigs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).ServiceConfigs))
+}
+
 // SliConfigs mocks base method.
 func (m *MockInstanaAPI) SliConfigs() restapi.RestResource[*restapi.SliConfig] {
 	m.ctrl.T.Helper()
@@ -216,6 +426,34 @@ func (mr *MockInstanaAPIMockRecorder) SyntheticTest() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyntheticTest", reflect.TypeOf((*MockInstanaAPI)(nil).SyntheticTest))
 }
 
+// UserInvitations mocks base method.
+func (m *MockInstanaAPI) UserInvitations() restapi.RestResource[*restapi.UserInvitation] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserInvitations")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.UserInvitation])
+	return ret0
+}
+
+// UserInvitations indicates an expected call of UserInvitations.
+func (mr *MockInstanaAPIMockRecorder) UserInvitations() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserInvitations", reflect.TypeOf((*MockInstanaAPI)(nil).UserInvitations))
+}
+
+// Users mocks base method.
+func (m *MockInstanaAPI) Users() restapi.ReadOnlyRestResource[*restapi.User] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Users")
+	ret0, _ := ret[0].(restapi.ReadOnlyRestResource[*restapi.User])
+	return ret0
+}
+
+// Users indicates an expected call of Users.
+func (mr *MockInstanaAPIMockRecorder) Users() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Users", reflect.TypeOf((*MockInstanaAPI)(nil).Users))
+}
+
 // WebsiteAlertConfig mocks base method.
 func (m *MockInstanaAPI) WebsiteAlertConfig() restapi.RestResource[*restapi.WebsiteAlertConfig] {
 	m.ctrl.T.Helper()
@@ -230,6 +468,34 @@ func (mr *MockInstanaAPIMockRecorder) WebsiteAlertConfig() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WebsiteAlertConfig", reflect.TypeOf((*MockInstanaAPI)(nil).WebsiteAlertConfig))
 }
 
+// WebsiteGeoLocationConfigs mocks base method.
+func (m *MockInstanaAPI) WebsiteGeoLocationConfigs() restapi.RestResource[*restapi.WebsiteGeoLocationConfig] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WebsiteGeoLocationConfigs")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.WebsiteGeoLocationConfig])
+	return ret0
+}
+
+// WebsiteGeoLocationConfigs indicates an expected call of WebsiteGeoLocationConfigs.
+func (mr *MockInstanaAPIMockRecorder) WebsiteGeoLocationConfigs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WebsiteGeoLocationConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).WebsiteGeoLocationConfigs))
+}
+
+// WebsiteIPMaskingConfigs mocks base method.
+func (m *MockInstanaAPI) WebsiteIPMaskingConfigs() restapi.RestResource[*restapi.WebsiteIPMaskingConfig] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WebsiteIPMaskingConfigs")
+	ret0, _ := ret[0].(restapi.RestResource[*restapi.WebsiteIPMaskingConfig])
+	return ret0
+}
+
+// WebsiteIPMaskingConfigs indicates an expected call of WebsiteIPMaskingConfigs.
+func (mr *MockInstanaAPIMockRecorder) WebsiteIPMaskingConfigs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WebsiteIPMaskingConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).WebsiteIPMaskingConfigs))
+}
+
 // WebsiteMonitoringConfig mocks base method.
 func (m *MockInstanaAPI) WebsiteMonitoringConfig() restapi.RestResource[*restapi.WebsiteMonitoringConfig] {
 	m.ctrl.T.Helper()
@@ -243,3 +509,17 @@ func (mr *MockInstanaAPIMockRecorder) WebsiteMonitoringConfig() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WebsiteMonitoringConfig", reflect.TypeOf((*MockInstanaAPI)(nil).WebsiteMonitoringConfig))
 }
+
+// WebsiteTagCatalog mocks base method.
+func (m *MockInstanaAPI) WebsiteTagCatalog() restapi.ReadOnlyRestResource[*restapi.Tag] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WebsiteTagCatalog")
+	ret0, _ := ret[0].(restapi.ReadOnlyRestResource[*restapi.Tag])
+	return ret0
+}
+
+// WebsiteTagCatalog indicates an expected call of WebsiteTagCatalog.
+func (mr *MockInstanaAPIMockRecorder) WebsiteTagCatalog() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WebsiteTagCatalog", reflect.TypeOf((*MockInstanaAPI)(nil).WebsiteTagCatalog))
+}
@@ -212,6 +212,82 @@ func (mr *MockReadOnlyRestResourceMockRecorder[T]) GetOne(id interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOne", reflect.TypeOf((*MockReadOnlyRestResource[T])(nil).GetOne), id)
 }
 
+// MockApdexReportRestResource is a mock of ApdexReportRestResource interface.
+type MockApdexReportRestResource struct {
+	ctrl     *gomock.Controller
+	recorder *MockApdexReportRestResourceMockRecorder
+}
+
+// MockApdexReportRestResourceMockRecorder is the mock recorder for MockApdexReportRestResource.
+type MockApdexReportRestResourceMockRecorder struct {
+	mock *MockApdexReportRestResource
+}
+
+// NewMockApdexReportRestResource creates a new mock instance.
+func NewMockApdexReportRestResource(ctrl *gomock.Controller) *MockApdexReportRestResource {
+	mock := &MockApdexReportRestResource{ctrl: ctrl}
+	mock.recorder = &MockApdexReportRestResourceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockApdexReportRestResource) EXPECT() *MockApdexReportRestResourceMockRecorder {
+	return m.recorder
+}
+
+// GetReport mocks base method.
+func (m *MockApdexReportRestResource) GetReport(apdexID string, from, to int64) (*[]*restapi.ApdexReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReport", apdexID, from, to)
+	ret0, _ := ret[0].(*[]*restapi.ApdexReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReport indicates an expected call of GetReport.
+func (mr *MockApdexReportRestResourceMockRecorder) GetReport(apdexID, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReport", reflect.TypeOf((*MockApdexReportRestResource)(nil).GetReport), apdexID, from, to)
+}
+
+// MockAlertConfigVersionRestResource is a mock of AlertConfigVersionRestResource interface.
+type MockAlertConfigVersionRestResource struct {
+	ctrl     *gomock.Controller
+	recorder *MockAlertConfigVersionRestResourceMockRecorder
+}
+
+// MockAlertConfigVersionRestResourceMockRecorder is the mock recorder for MockAlertConfigVersionRestResource.
+type MockAlertConfigVersionRestResourceMockRecorder struct {
+	mock *MockAlertConfigVersionRestResource
+}
+
+// NewMockAlertConfigVersionRestResource creates a new mock instance.
+func NewMockAlertConfigVersionRestResource(ctrl *gomock.Controller) *MockAlertConfigVersionRestResource {
+	mock := &MockAlertConfigVersionRestResource{ctrl: ctrl}
+	mock.recorder = &MockAlertConfigVersionRestResourceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAlertConfigVersionRestResource) EXPECT() *MockAlertConfigVersionRestResourceMockRecorder {
+	return m.recorder
+}
+
+// GetVersions mocks base method.
+func (m *MockAlertConfigVersionRestResource) GetVersions(alertConfigID string) (*[]*restapi.AlertConfigVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVersions", alertConfigID)
+	ret0, _ := ret[0].(*[]*restapi.AlertConfigVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVersions indicates an expected call of GetVersions.
+func (mr *MockAlertConfigVersionRestResourceMockRecorder) GetVersions(alertConfigID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersions", reflect.TypeOf((*MockAlertConfigVersionRestResource)(nil).GetVersions), alertConfigID)
+}
+
 // MockJSONUnmarshaller is a mock of JSONUnmarshaller interface.
 type MockJSONUnmarshaller[T any] struct {
 	ctrl     *gomock.Controller
@@ -48,6 +48,20 @@ func (mr *MockRestClientMockRecorder) Delete(resourceID, resourceBasePath interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRestClient)(nil).Delete), resourceID, resourceBasePath)
 }
 
+// DeleteByQuery mocks base method.
+func (m *MockRestClient) DeleteByQuery(resourcePath string, queryParams map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByQuery", resourcePath, queryParams)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByQuery indicates an expected call of DeleteByQuery.
+func (mr *MockRestClientMockRecorder) DeleteByQuery(resourcePath, queryParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByQuery", reflect.TypeOf((*MockRestClient)(nil).DeleteByQuery), resourcePath, queryParams)
+}
+
 // Get mocks base method.
 func (m *MockRestClient) Get(resourcePath string) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -63,6 +77,21 @@ func (mr *MockRestClientMockRecorder) Get(resourcePath interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRestClient)(nil).Get), resourcePath)
 }
 
+// GetByQuery mocks base method.
+func (m *MockRestClient) GetByQuery(resourcePath string, queryParams map[string]string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByQuery", resourcePath, queryParams)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByQuery indicates an expected call of GetByQuery.
+func (mr *MockRestClientMockRecorder) GetByQuery(resourcePath, queryParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByQuery", reflect.TypeOf((*MockRestClient)(nil).GetByQuery), resourcePath, queryParams)
+}
+
 // GetOne mocks base method.
 func (m *MockRestClient) GetOne(id, resourcePath string) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -123,6 +152,21 @@ func (mr *MockRestClientMockRecorder) PostWithID(data, resourcePath interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostWithID", reflect.TypeOf((*MockRestClient)(nil).PostWithID), data, resourcePath)
 }
 
+// PostWithQuery mocks base method.
+func (m *MockRestClient) PostWithQuery(data restapi.InstanaDataObject, resourcePath string, queryParams map[string]string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostWithQuery", data, resourcePath, queryParams)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostWithQuery indicates an expected call of PostWithQuery.
+func (mr *MockRestClientMockRecorder) PostWithQuery(data, resourcePath, queryParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostWithQuery", reflect.TypeOf((*MockRestClient)(nil).PostWithQuery), data, resourcePath, queryParams)
+}
+
 // Put mocks base method.
 func (m *MockRestClient) Put(data restapi.InstanaDataObject, resourcePath string) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -26,6 +26,8 @@ type TerraformSchemaAssert interface {
 	AssertSchemaIsOptionalAndOfTypeStringWithDefault(fieldName string, defaultValue string)
 	//AssertSchemaIsOptionalAndOfTypeInt checks if the given schema field is optional and of type int
 	AssertSchemaIsOptionalAndOfTypeInt(fieldName string)
+	//AssertSchemaIsOptionalAndOfTypeIntWithDefault checks if the given schema field is optional and of type int and has the given default value
+	AssertSchemaIsOptionalAndOfTypeIntWithDefault(fieldName string, defaultValue int)
 	//AssertSchemaIsOptionalAndOfTypeFloat checks if the given schema field is required and of type float
 	AssertSchemaIsOptionalAndOfTypeFloat(fieldName string)
 	//AssertSchemaIsOfTypeBooleanWithDefault checks if the given schema field is an optional boolean field with an expected default value
@@ -100,6 +102,13 @@ func (inst *terraformSchemaAssertImpl) AssertSchemaIsOptionalAndOfTypeInt(schema
 	inst.assertSchemaIsOptionalAndOfType(schemaField, schema.TypeInt)
 }
 
+func (inst *terraformSchemaAssertImpl) AssertSchemaIsOptionalAndOfTypeIntWithDefault(schemaField string, defaultValue int) {
+	inst.assertSchemaIsOptionalAndOfType(schemaField, schema.TypeInt)
+	s := inst.schemaMap[schemaField]
+
+	require.Equal(inst.t, defaultValue, s.Default)
+}
+
 func (inst *terraformSchemaAssertImpl) AssertSchemaIsOptionalAndOfTypeFloat(schemaField string) {
 	inst.assertSchemaIsOptionalAndOfType(schemaField, schema.TypeFloat)
 }
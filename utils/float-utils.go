@@ -0,0 +1,6 @@
+package utils
+
+// Float64Ptr converts a float64 to a float64 pointer
+func Float64Ptr(input float64) *float64 {
+	return &input
+}
@@ -0,0 +1,14 @@
+package utils_test
+
+import (
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldCreateFloat64PointerFromFloat64(t *testing.T) {
+	value := 123.45
+
+	require.Equal(t, &value, Float64Ptr(value))
+}